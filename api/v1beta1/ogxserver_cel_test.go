@@ -20,6 +20,7 @@ import (
 	"context"
 	"testing"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
@@ -537,6 +538,264 @@ func TestCEL_PodDisruptionBudgetSpec(t *testing.T) {
 	}
 }
 
+func TestCEL_WorkloadLogLevel(t *testing.T) {
+	ns := createCELTestNamespace(t, "cel-log-level")
+
+	tests := []struct {
+		name      string
+		logLevel  string
+		wantError string
+	}{
+		{name: "debug is valid", logLevel: "debug"},
+		{name: "warn is valid", logLevel: "warn"},
+		{name: "unknown level is invalid", logLevel: "verbose", wantError: "Unsupported value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := validOGXServer(uniqueName(), ns)
+			obj.Spec.Workload = &WorkloadSpec{LogLevel: tt.logLevel}
+			err := k8sClient.Create(context.Background(), obj)
+			if tt.wantError == "" {
+				if err != nil {
+					t.Fatalf("expected success, got: %v", err)
+				}
+				t.Cleanup(func() { _ = k8sClient.Delete(context.Background(), obj) })
+			} else {
+				requireCELError(t, err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestCEL_NetworkPolicyAllowNamespaces(t *testing.T) {
+	ns := createCELTestNamespace(t, "cel-allow-namespaces")
+
+	tests := []struct {
+		name       string
+		namespaces []string
+		wantError  string
+	}{
+		{name: "namespace names are valid", namespaces: []string{"monitoring", "probe-1"}},
+		{name: "all namespaces is valid", namespaces: []string{"*"}},
+		{name: "uppercase name is invalid", namespaces: []string{"Monitoring"}, wantError: "should match"},
+		{name: "wildcard pattern is invalid", namespaces: []string{"team-*"}, wantError: "should match"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := validOGXServer(uniqueName(), ns)
+			obj.Spec.Network = &NetworkSpec{Policy: &NetworkPolicySpec{AllowNamespaces: tt.namespaces}}
+			err := k8sClient.Create(context.Background(), obj)
+			if tt.wantError == "" {
+				if err != nil {
+					t.Fatalf("expected success, got: %v", err)
+				}
+				t.Cleanup(func() { _ = k8sClient.Delete(context.Background(), obj) })
+			} else {
+				requireCELError(t, err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestCEL_ServiceType(t *testing.T) {
+	ns := createCELTestNamespace(t, "cel-service-type")
+	lbAnnotations := map[string]string{"service.beta.kubernetes.io/aws-load-balancer-internal": "true"}
+
+	tests := []struct {
+		name      string
+		network   *NetworkSpec
+		wantError string
+	}{
+		{name: "NodePort is valid", network: &NetworkSpec{ServiceType: corev1.ServiceTypeNodePort}},
+		{name: "LoadBalancer with annotations is valid",
+			network: &NetworkSpec{ServiceType: corev1.ServiceTypeLoadBalancer, LoadBalancerAnnotations: lbAnnotations}},
+		{name: "ExternalName is invalid",
+			network: &NetworkSpec{ServiceType: corev1.ServiceTypeExternalName}, wantError: "Unsupported value"},
+		{name: "annotations without LoadBalancer are invalid",
+			network:   &NetworkSpec{ServiceType: corev1.ServiceTypeNodePort, LoadBalancerAnnotations: lbAnnotations},
+			wantError: "loadBalancerAnnotations requires serviceType LoadBalancer"},
+		{name: "annotations without a service type are invalid",
+			network:   &NetworkSpec{LoadBalancerAnnotations: lbAnnotations},
+			wantError: "loadBalancerAnnotations requires serviceType LoadBalancer"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := validOGXServer(uniqueName(), ns)
+			obj.Spec.Network = tt.network
+			err := k8sClient.Create(context.Background(), obj)
+			if tt.wantError == "" {
+				if err != nil {
+					t.Fatalf("expected success, got: %v", err)
+				}
+				t.Cleanup(func() { _ = k8sClient.Delete(context.Background(), obj) })
+			} else {
+				requireCELError(t, err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestCEL_AdditionalConfigMounts(t *testing.T) {
+	ns := createCELTestNamespace(t, "cel-config-mounts")
+
+	tests := []struct {
+		name      string
+		mounts    []ConfigMount
+		wantError string
+	}{
+		{name: "mounts with and without keys are valid", mounts: []ConfigMount{
+			{Name: "prompts", MountPath: "/etc/ogx/prompts"},
+			{Name: "tools", Keys: []string{"search.json"}, MountPath: "/etc/ogx/tools"},
+		}},
+		{name: "relative mount path is invalid",
+			mounts: []ConfigMount{{Name: "prompts", MountPath: "prompts"}}, wantError: "should match"},
+		{name: "duplicate mount path is invalid", mounts: []ConfigMount{
+			{Name: "prompts", MountPath: "/etc/ogx/prompts"},
+			{Name: "tools", MountPath: "/etc/ogx/prompts"},
+		}, wantError: "Duplicate value"},
+		{name: "invalid key is invalid",
+			mounts:    []ConfigMount{{Name: "tools", Keys: []string{"../secret"}, MountPath: "/etc/ogx/tools"}},
+			wantError: "should match"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := validOGXServer(uniqueName(), ns)
+			obj.Spec.Workload = &WorkloadSpec{AdditionalConfigMounts: tt.mounts}
+			err := k8sClient.Create(context.Background(), obj)
+			if tt.wantError == "" {
+				if err != nil {
+					t.Fatalf("expected success, got: %v", err)
+				}
+				t.Cleanup(func() { _ = k8sClient.Delete(context.Background(), obj) })
+			} else {
+				requireCELError(t, err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestCEL_EphemeralStorage(t *testing.T) {
+	ns := createCELTestNamespace(t, "cel-ephemeral")
+	size := func(s string) *resource.Quantity {
+		q := resource.MustParse(s)
+		return &q
+	}
+
+	tests := []struct {
+		name      string
+		workload  WorkloadSpec
+		wantError string
+	}{
+		{name: "size limit and memory medium are valid", workload: WorkloadSpec{
+			EphemeralStorage: &EphemeralStorageSpec{SizeLimit: size("1Gi"), Medium: corev1.StorageMediumMemory},
+		}},
+		{name: "zero size limit is invalid", workload: WorkloadSpec{
+			EphemeralStorage: &EphemeralStorageSpec{SizeLimit: size("0")},
+		}, wantError: "sizeLimit must be a positive quantity"},
+		{name: "unknown medium is invalid", workload: WorkloadSpec{
+			EphemeralStorage: &EphemeralStorageSpec{Medium: "HugePages"},
+		}, wantError: "Unsupported value"},
+		{name: "storage and ephemeral storage are mutually exclusive", workload: WorkloadSpec{
+			Storage:          &PVCStorageSpec{},
+			EphemeralStorage: &EphemeralStorageSpec{SizeLimit: size("1Gi")},
+		}, wantError: "storage and ephemeralStorage are mutually exclusive"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := validOGXServer(uniqueName(), ns)
+			obj.Spec.Workload = &tt.workload
+			err := k8sClient.Create(context.Background(), obj)
+			if tt.wantError == "" {
+				if err != nil {
+					t.Fatalf("expected success, got: %v", err)
+				}
+				t.Cleanup(func() { _ = k8sClient.Delete(context.Background(), obj) })
+			} else {
+				requireCELError(t, err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestCEL_StartupProbeType(t *testing.T) {
+	ns := createCELTestNamespace(t, "cel-probe-type")
+
+	tests := []struct {
+		name      string
+		probe     StartupProbeSpec
+		wantError string
+	}{
+		{name: "exec with command is valid", probe: StartupProbeSpec{
+			Type: StartupProbeTypeExec, Command: []string{"ogx", "health"},
+		}},
+		{name: "httpget without command is valid", probe: StartupProbeSpec{Type: StartupProbeTypeHTTPGet}},
+		{name: "exec without command is invalid", probe: StartupProbeSpec{Type: StartupProbeTypeExec},
+			wantError: "command is required when type is Exec"},
+		{name: "command without exec is invalid", probe: StartupProbeSpec{Command: []string{"ogx", "health"}},
+			wantError: "command is only valid when type is Exec"},
+		{name: "unknown type is invalid", probe: StartupProbeSpec{Type: "TCPSocket"},
+			wantError: "Unsupported value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := validOGXServer(uniqueName(), ns)
+			obj.Spec.Workload = &WorkloadSpec{StartupProbe: &tt.probe}
+			err := k8sClient.Create(context.Background(), obj)
+			if tt.wantError == "" {
+				if err != nil {
+					t.Fatalf("expected success, got: %v", err)
+				}
+				t.Cleanup(func() { _ = k8sClient.Delete(context.Background(), obj) })
+			} else {
+				requireCELError(t, err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestCEL_DeploymentStrategy(t *testing.T) {
+	ns := createCELTestNamespace(t, "cel-strategy")
+	surge := intstr.FromString("50%")
+
+	tests := []struct {
+		name      string
+		strategy  DeploymentStrategySpec
+		wantError string
+	}{
+		{name: "Recreate is valid", strategy: DeploymentStrategySpec{Type: DeploymentStrategyRecreate}},
+		{name: "RollingUpdate with maxSurge is valid", strategy: DeploymentStrategySpec{
+			Type: DeploymentStrategyRollingUpdate, MaxSurge: &surge,
+		}},
+		{name: "type defaults to RollingUpdate", strategy: DeploymentStrategySpec{MaxSurge: &surge}},
+		{name: "Recreate with maxSurge is invalid", strategy: DeploymentStrategySpec{
+			Type: DeploymentStrategyRecreate, MaxSurge: &surge,
+		}, wantError: "maxSurge and maxUnavailable are only allowed with the RollingUpdate strategy"},
+		{name: "unknown type is invalid", strategy: DeploymentStrategySpec{Type: "BlueGreen"}, wantError: "Unsupported value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := validOGXServer(uniqueName(), ns)
+			obj.Spec.Workload = &WorkloadSpec{DeploymentStrategy: &tt.strategy}
+			err := k8sClient.Create(context.Background(), obj)
+			if tt.wantError == "" {
+				if err != nil {
+					t.Fatalf("expected success, got: %v", err)
+				}
+				t.Cleanup(func() { _ = k8sClient.Delete(context.Background(), obj) })
+			} else {
+				requireCELError(t, err, tt.wantError)
+			}
+		})
+	}
+}
+
 func TestCEL_AutoscalingSpec(t *testing.T) {
 	ns := createCELTestNamespace(t, "cel-hpa")
 
@@ -823,6 +1082,58 @@ func TestCEL_ExternalAccessConfig(t *testing.T) {
 	}
 }
 
+func TestCEL_TrustConfig(t *testing.T) {
+	ns := createCELTestNamespace(t, "cel-trust")
+
+	tests := []struct {
+		name      string
+		mutate    func(*OGXServer)
+		wantError string
+	}{
+		{
+			name: "ConfigMap source only is valid",
+			mutate: func(o *OGXServer) {
+				o.Spec.TLS = &TLSClientConfig{Trust: &TrustConfig{
+					CACertificates: []ConfigMapKeyRef{{Name: "ca", Key: "ca.crt"}},
+				}}
+			},
+		},
+		{
+			name: "Secret source only is valid",
+			mutate: func(o *OGXServer) {
+				o.Spec.TLS = &TLSClientConfig{Trust: &TrustConfig{
+					CACertificateSecrets: []SecretKeyRef{{Name: "ca", Key: "ca.crt"}},
+				}}
+			},
+		},
+		{
+			name: "ConfigMap and Secret sources together are valid",
+			mutate: func(o *OGXServer) {
+				o.Spec.TLS = &TLSClientConfig{Trust: &TrustConfig{
+					CACertificates:       []ConfigMapKeyRef{{Name: "ca", Key: "ca.crt"}},
+					CACertificateSecrets: []SecretKeyRef{{Name: "ca", Key: "ca.crt"}},
+				}}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := validOGXServer(uniqueName(), ns)
+			tt.mutate(obj)
+			err := k8sClient.Create(context.Background(), obj)
+			if tt.wantError == "" {
+				if err != nil {
+					t.Fatalf("expected success, got: %v", err)
+				}
+				t.Cleanup(func() { _ = k8sClient.Delete(context.Background(), obj) })
+			} else {
+				requireCELError(t, err, tt.wantError)
+			}
+		})
+	}
+}
+
 func TestCEL_WorkloadOverrides(t *testing.T) {
 	ns := createCELTestNamespace(t, "cel-overrides")
 
@@ -33,8 +33,13 @@ import (
 const (
 	// DefaultContainerName is the default name for the container.
 	DefaultContainerName = "ogx"
+	// CABundleInitContainerName is the init container that assembles the CA
+	// bundle when CA certificates are sourced from Secrets.
+	CABundleInitContainerName = "ca-bundle"
 	// DefaultServerPort is the default port for the server.
 	DefaultServerPort int32 = 8321
+	// DefaultRevisionHistoryLimit is the default number of old ReplicaSets kept.
+	DefaultRevisionHistoryLimit int32 = 3
 	// DefaultServicePortName is the default name for the service port.
 	DefaultServicePortName = "http"
 	// DefaultLabelKey is the default key for labels.
@@ -43,6 +48,10 @@ const (
 	DefaultLabelValue = "ogx"
 	// DefaultMountPath is the default mount path for storage.
 	DefaultMountPath = "/.ogx"
+	// DefaultHealthCheckPath is the default path of the server health endpoint.
+	DefaultHealthCheckPath = "/v1/health"
+	// DefaultAPIBasePath is the default path prefix of the server API.
+	DefaultAPIBasePath = "/v1"
 	// OGXServerKind is the kind name for OGXServer resources.
 	OGXServerKind = "OGXServer"
 
@@ -54,6 +63,15 @@ const (
 	AdoptedFromLabel = "ogx.io/adopted-from"
 	// AdoptedAtAnnotation is set on adopted child resources with an RFC 3339 timestamp.
 	AdoptedAtAnnotation = "ogx.io/adopted-at"
+	// SuspendReconcileAnnotation suspends reconciliation of an OGXServer when
+	// set to "true". Its resources are left as they are until it is removed.
+	SuspendReconcileAnnotation = "ogx.io/suspend-reconcile"
+	// RestartedAtAnnotation restarts the server pods when set or changed on an
+	// OGXServer, e.g. to a timestamp. It is copied to the pod template.
+	RestartedAtAnnotation = "ogx.io/restarted-at"
+
+	// ManagedCABundleKey is the key of the combined bundle in the managed CA bundle ConfigMap.
+	ManagedCABundleKey = "ca-bundle.crt"
 )
 
 var (
@@ -82,6 +100,12 @@ type DistributionSpec struct {
 	// Image is a direct container image reference to use.
 	// +optional
 	Image string `json:"image,omitempty"`
+	// Version is the OGX server version shipped in the distribution image, such
+	// as "0.3.1". When set, the operator starts the server with the command of
+	// that version instead of detecting the version when the container starts.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^v?[0-9]+\.[0-9]+\.[0-9]+[0-9A-Za-z.+-]*$`
+	Version string `json:"version,omitempty"`
 }
 
 // SecretKeyRef references a specific key in a Kubernetes Secret.
@@ -116,6 +140,27 @@ type ConfigMapKeyRef struct {
 	Key string `json:"key"`
 }
 
+// ConfigMount mounts a ConfigMap as read-only files in the server container.
+type ConfigMount struct {
+	// Name is the name of the ConfigMap, in the namespace of the OGXServer.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Keys limits the mounted files to these ConfigMap keys. All keys are
+	// mounted when omitted.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:MaxLength=253
+	// +kubebuilder:validation:items:Pattern="^[a-zA-Z0-9]([a-zA-Z0-9\\-_.]*[a-zA-Z0-9])?$"
+	Keys []string `json:"keys,omitempty"`
+	// MountPath is the directory the keys are mounted in, one file per key.
+	// It must not be the mount path of an operator-managed volume.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^/`
+	MountPath string `json:"mountPath"`
+}
+
 // ModelConfig defines a model registration with optional provider assignment and metadata.
 // +kubebuilder:validation:XValidation:rule="!has(self.provider) || self.provider.size() > 0",message="provider must not be empty if specified"
 // +kubebuilder:validation:XValidation:rule="!has(self.modelType) || self.modelType.size() > 0",message="modelType must not be empty if specified"
@@ -204,8 +249,8 @@ type StateStorageSpec struct {
 type TLSSpec struct {
 	// SecretName references a Kubernetes TLS Secret containing a valid TLS certificate
 	// for server TLS termination. The Secret must be in the same namespace as the
-	// OGXServer and must have the label ogx.io/watch: "true" to be detected by the
-	// operator's cache.
+	// OGXServer. The operator trusts the ca.crt key of the Secret when querying
+	// the server, or the certificate itself when ca.crt is absent.
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinLength=1
 	SecretName string `json:"secretName"`
@@ -214,12 +259,39 @@ type TLSSpec struct {
 // TrustConfig configures trust anchors for verifying outbound TLS connections.
 type TrustConfig struct {
 	// CACertificates lists ConfigMap keys containing PEM-encoded CA certificates.
-	// All certificates are concatenated into a single trust bundle.
+	// All certificates are concatenated into a single trust bundle in the
+	// order listed, followed by any auto-detected bundle sorted by key.
 	// Referenced ConfigMaps must be in the same namespace as the OGXServer
 	// and must have the label ogx.io/watch: "true".
 	// +optional
 	// +kubebuilder:validation:MinItems=1
 	CACertificates []ConfigMapKeyRef `json:"caCertificates,omitempty"`
+	// CACertificateSecrets lists Secret keys containing PEM-encoded CA certificates.
+	// They are not copied into the managed ConfigMap. The Secrets are mounted
+	// in a projected volume, and the ca-bundle init container appends their
+	// certificates to the combined bundle in the order listed.
+	// Referenced Secrets must be in the same namespace as the OGXServer
+	// and must have the label ogx.io/watch: "true".
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	CACertificateSecrets []SecretKeyRef `json:"caCertificateSecrets,omitempty"`
+	// PreserveKeys additionally stores each referenced key in the managed CA
+	// bundle under its original name, so consumers can load specific certificate
+	// files next to the combined ca-bundle.crt. Keys must be unique across the
+	// referenced sources and must not be named ca-bundle.crt.
+	// +optional
+	PreserveKeys bool `json:"preserveKeys,omitempty"`
+	// ManagedConfigMapName overrides the name of the ConfigMap the operator
+	// creates to hold the combined CA bundle. Defaults to the OGXServer name
+	// followed by -ca-bundle, shortened with a hash when longer than 253 characters.
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	ManagedConfigMapName string `json:"managedConfigMapName,omitempty"`
+	// ExpiryWarningWindow is how long before a CA certificate expires the
+	// operator starts warning about it. Defaults to 720h (30 days).
+	// +optional
+	ExpiryWarningWindow *metav1.Duration `json:"expiryWarningWindow,omitempty"`
 }
 
 // IdentityConfig configures client certificate identity for mTLS authentication.
@@ -276,6 +348,14 @@ type NetworkPolicySpec struct {
 	// (allow from same-namespace and operator-namespace on the service port).
 	// +optional
 	Ingress []networkingv1.NetworkPolicyIngressRule `json:"ingress,omitempty"`
+	// AllowNamespaces lists further namespaces whose pods may reach the
+	// server ports, in addition to the same namespace and the operator
+	// namespace, e.g. where health probes originate. "*" allows all namespaces.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:items:Pattern=`^(\*|[a-z0-9]([-a-z0-9]*[a-z0-9])?)$`
+	// +kubebuilder:validation:items:MaxLength=63
+	AllowNamespaces []string `json:"allowNamespaces,omitempty"`
 	// Egress rules. When non-empty, a kube-dns egress rule is auto-injected
 	// to prevent DNS breakage.
 	// +optional
@@ -283,6 +363,7 @@ type NetworkPolicySpec struct {
 }
 
 // ExternalAccessConfig controls external service exposure.
+// On OpenShift the operator creates a Route; on other clusters it creates an Ingress.
 // +kubebuilder:validation:XValidation:rule="!has(self.hostname) || self.hostname.size() > 0",message="hostname must not be empty if specified"
 type ExternalAccessConfig struct {
 	// Enabled controls whether external access is created.
@@ -293,9 +374,48 @@ type ExternalAccessConfig struct {
 	// When omitted, an auto-generated hostname is used.
 	// +optional
 	Hostname string `json:"hostname,omitempty"`
+	// TLS configures TLS termination for the external endpoint.
+	// When omitted, the endpoint is served over plain HTTP, except that a
+	// Route to a server serving HTTPS (network.tls) always uses TLS.
+	// +optional
+	TLS *ExternalAccessTLSConfig `json:"tls,omitempty"`
+	// Annotations are added to the generated Route or Ingress, for example
+	// to select an ingress class or a certificate issuer.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// ExternalTLSTermination selects where TLS is terminated for external access.
+// +kubebuilder:validation:Enum=Edge;Passthrough;Reencrypt
+type ExternalTLSTermination string
+
+const (
+	// ExternalTLSTerminationEdge terminates TLS at the router or ingress controller.
+	ExternalTLSTerminationEdge ExternalTLSTermination = "Edge"
+	// ExternalTLSTerminationPassthrough forwards encrypted traffic to the server.
+	// Only supported by OpenShift Routes.
+	ExternalTLSTerminationPassthrough ExternalTLSTermination = "Passthrough"
+	// ExternalTLSTerminationReencrypt terminates TLS at the router and
+	// re-encrypts traffic to the server. Only supported by OpenShift Routes.
+	ExternalTLSTerminationReencrypt ExternalTLSTermination = "Reencrypt"
+)
+
+// ExternalAccessTLSConfig configures TLS for the external endpoint.
+type ExternalAccessTLSConfig struct {
+	// Termination selects where TLS is terminated. Defaults to Reencrypt
+	// on Routes to a server serving HTTPS (network.tls) and to Edge
+	// otherwise. Ingresses always terminate TLS at the ingress controller
+	// and reject Passthrough and Reencrypt.
+	// +optional
+	Termination ExternalTLSTermination `json:"termination,omitempty"`
+	// SecretName references a TLS Secret holding the certificate for the
+	// Ingress host. Routes use the router's default certificate.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
 }
 
 // NetworkSpec defines network access controls for the OGXServer.
+// +kubebuilder:validation:XValidation:rule="!has(self.loadBalancerAnnotations) || (has(self.serviceType) && self.serviceType == 'LoadBalancer')",message="loadBalancerAnnotations requires serviceType LoadBalancer"
 type NetworkSpec struct {
 	// Port is the server listen port.
 	// +optional
@@ -303,6 +423,23 @@ type NetworkSpec struct {
 	// +kubebuilder:validation:Maximum=65535
 	// +kubebuilder:default:=8321
 	Port int32 `json:"port,omitempty"`
+	// AdditionalPorts exposes further ports of the server container, such as a
+	// metrics port, on the Service and in the default NetworkPolicy ingress rule.
+	// Each port needs a unique name other than http.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	AdditionalPorts []corev1.ContainerPort `json:"additionalPorts,omitempty"`
+	// ServiceType is the type of the server Service. Defaults to ClusterIP.
+	// NodePort and LoadBalancer expose the server outside the cluster; the
+	// default NetworkPolicy still only admits in-cluster traffic.
+	// +optional
+	// +kubebuilder:validation:Enum=ClusterIP;NodePort;LoadBalancer
+	ServiceType corev1.ServiceType `json:"serviceType,omitempty"`
+	// LoadBalancerAnnotations are added to the Service when serviceType is
+	// LoadBalancer, e.g. to select an internal load balancer. They take
+	// precedence over workload.commonAnnotations.
+	// +optional
+	LoadBalancerAnnotations map[string]string `json:"loadBalancerAnnotations,omitempty"`
 	// TLS configures optional TLS termination for the server.
 	// When omitted, the server listens over plain HTTP.
 	// +optional
@@ -314,19 +451,94 @@ type NetworkSpec struct {
 	// When nil, the operator creates a default NetworkPolicy with safe ingress rules.
 	// +optional
 	Policy *NetworkPolicySpec `json:"policy,omitempty"`
+	// Proxy routes the server's outbound requests, such as calls to remote
+	// inference providers, through an HTTP proxy. Fields left empty fall back
+	// to the operator's proxy defaults.
+	// +optional
+	Proxy *ProxySpec `json:"proxy,omitempty"`
+}
+
+// ProxySpec sets the proxy environment variables of the server container.
+type ProxySpec struct {
+	// HTTPProxy is the proxy URL for HTTP requests, set as HTTP_PROXY.
+	// +optional
+	HTTPProxy string `json:"httpProxy,omitempty"`
+	// HTTPSProxy is the proxy URL for HTTPS requests, set as HTTPS_PROXY.
+	// +optional
+	HTTPSProxy string `json:"httpsProxy,omitempty"`
+	// NoProxy is a comma-separated list of hosts and domains reached without
+	// the proxy, set as NO_PROXY. localhost, 127.0.0.1, <namespace>.svc and
+	// the in-cluster service domains .svc and .cluster.local are always added.
+	// Entries match host name suffixes, so services addressed by a short name
+	// such as my-service must be listed here or addressed by the full name.
+	// +optional
+	NoProxy string `json:"noProxy,omitempty"`
+}
+
+// EphemeralStorageSpec configures the emptyDir volume for non-persistent data.
+// +kubebuilder:validation:XValidation:rule="!has(self.sizeLimit) || quantity(self.sizeLimit).isGreaterThan(quantity('0'))",message="sizeLimit must be a positive quantity"
+type EphemeralStorageSpec struct {
+	// SizeLimit caps the space the volume may use. The pod is evicted when
+	// it is exceeded. With the Memory medium, the volume counts towards the
+	// container memory limit.
+	// +optional
+	SizeLimit *resource.Quantity `json:"sizeLimit,omitempty"`
+	// Medium is the storage medium backing the volume. Defaults to the
+	// node's default medium; Memory uses a tmpfs.
+	// +optional
+	// +kubebuilder:validation:Enum="";Memory
+	Medium corev1.StorageMedium `json:"medium,omitempty"`
+}
+
+// ModelCacheSpec configures where the server caches downloaded models.
+type ModelCacheSpec struct {
+	// Path is the cache directory, set as HF_HOME. Hub downloads are cached
+	// in its hub subdirectory, set as HF_HUB_CACHE.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^/`
+	Path string `json:"path"`
+	// ClaimName is an existing PersistentVolumeClaim in the server's
+	// namespace, mounted at path. When omitted, path is not backed by a
+	// dedicated volume and the cache is lost when the pod is replaced
+	// unless path is within another mounted volume. A claim that is not
+	// ReadWriteMany requires a single replica and the Recreate strategy.
+	// +optional
+	ClaimName string `json:"claimName,omitempty"`
 }
 
 // PVCStorageSpec defines PVC storage for persistent data.
 // +kubebuilder:validation:XValidation:rule="!has(self.mountPath) || self.mountPath.size() > 0",message="mountPath must not be empty if specified"
 // +kubebuilder:validation:XValidation:rule="!has(self.size) || quantity(self.size).isGreaterThan(quantity('0'))",message="size must be a positive quantity"
 type PVCStorageSpec struct {
-	// Size is the size of the PVC.
+	// Size is the size of the PVC. Increasing it expands an existing PVC
+	// when its StorageClass allows volume expansion. Decreasing it has no
+	// effect, as PVCs cannot shrink.
 	// +optional
 	Size *resource.Quantity `json:"size,omitempty"`
 	// MountPath is the container mount path for the PVC.
 	// +optional
 	// +kubebuilder:default:="/.ogx"
 	MountPath string `json:"mountPath,omitempty"`
+	// AccessModes are the access modes of the PVC. Defaults to ReadWriteOnce.
+	// Use ReadWriteMany with a shared filesystem to let several replicas
+	// mount the volume. Only applied when the PVC is created.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	AccessModes []corev1.PersistentVolumeAccessMode `json:"accessModes,omitempty"`
+	// StorageClassName is the StorageClass of the PVC. Defaults to the
+	// cluster default StorageClass. Only applied when the PVC is created.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// Labels are added to the PVC, e.g. to select it for volume snapshots.
+	// They take precedence over workload.commonLabels. Labels set by the
+	// operator take precedence. Only applied when the PVC is created.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations are added to the PVC, e.g. for backup tooling. They take
+	// precedence over workload.commonAnnotations. Only applied when the PVC
+	// is created.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
 // PodDisruptionBudgetSpec defines voluntary disruption controls.
@@ -341,6 +553,59 @@ type PodDisruptionBudgetSpec struct {
 	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
 }
 
+// DeploymentStrategyType is the update strategy of the server Deployment.
+// +kubebuilder:validation:Enum=RollingUpdate;Recreate
+type DeploymentStrategyType string
+
+const (
+	// DeploymentStrategyRollingUpdate replaces pods gradually.
+	DeploymentStrategyRollingUpdate DeploymentStrategyType = "RollingUpdate"
+	// DeploymentStrategyRecreate stops all old pods before starting new ones.
+	DeploymentStrategyRecreate DeploymentStrategyType = "Recreate"
+)
+
+// DeploymentStrategySpec configures how server pods are replaced on updates.
+// +kubebuilder:validation:XValidation:rule="!has(self.type) || self.type == 'RollingUpdate' || (!has(self.maxSurge) && !has(self.maxUnavailable))",message="maxSurge and maxUnavailable are only allowed with the RollingUpdate strategy"
+type DeploymentStrategySpec struct {
+	// Type is RollingUpdate or Recreate. Recreate avoids running two pods
+	// at once, e.g. to prevent multi-attach deadlocks on ReadWriteOnce volumes.
+	// +optional
+	// +kubebuilder:default:=RollingUpdate
+	Type DeploymentStrategyType `json:"type,omitempty"`
+	// MaxSurge is the maximum number of pods created above the desired count
+	// during a rolling update.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+	// MaxUnavailable is the maximum number of pods that can be unavailable
+	// during a rolling update.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// RolloutHealthGateSpec gates rollout completion on provider health. While
+// the gate is set, rolling updates keep all old pods until new pods are Ready
+// (maxUnavailable=0) unless deploymentStrategy sets maxUnavailable. If the new
+// pods' providers keep reporting errors past the provider error grace period
+// within the window, the Deployment is rolled back to the previous revision
+// and held there until the spec or the ogx.io/restarted-at annotation changes.
+type RolloutHealthGateSpec struct {
+	// Window is how long after a rollout starts provider errors trigger a
+	// rollback. Defaults to 10m.
+	// +optional
+	Window *metav1.Duration `json:"window,omitempty"`
+}
+
+// PlacementStatusSpec configures the pod placement recorded in status.placement.
+type PlacementStatusSpec struct {
+	// NodeLabels are node label keys, such as topology.kubernetes.io/zone,
+	// whose values are copied from each pod's node into its placement entry.
+	// Reading them needs a Node lookup per node. Without them, only node
+	// names are recorded.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	NodeLabels []string `json:"nodeLabels,omitempty"`
+}
+
 // AutoscalingSpec configures HorizontalPodAutoscaler targets.
 // +kubebuilder:validation:XValidation:rule="!has(self.minReplicas) || self.maxReplicas >= self.minReplicas",message="maxReplicas must be greater than or equal to minReplicas"
 type AutoscalingSpec struct {
@@ -374,6 +639,12 @@ type WorkloadOverrides struct {
 	// +optional
 	// +kubebuilder:validation:MinItems=1
 	Env []corev1.EnvVar `json:"env,omitempty"`
+	// EnvFrom populates environment variables from ConfigMaps and Secrets.
+	// Pods restart when a referenced ConfigMap or Secret with the label
+	// ogx.io/watch: "true" changes.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	EnvFrom []corev1.EnvFromSource `json:"envFrom,omitempty"`
 	// Command overrides the container command.
 	// +optional
 	// +kubebuilder:validation:MinItems=1
@@ -385,16 +656,128 @@ type WorkloadOverrides struct {
 	// +kubebuilder:validation:items:MinLength=1
 	Args []string `json:"args,omitempty"`
 	// Volumes adds additional volumes to the Pod.
+	// Names must not collide with those of the operator-managed volumes of
+	// this instance, e.g. ogx-storage, or user-config with overrideConfig.
 	// +optional
 	// +kubebuilder:validation:MinItems=1
 	Volumes []corev1.Volume `json:"volumes,omitempty"`
 	// VolumeMounts adds additional volume mounts to the container.
+	// Mount paths must not collide with those of operator-managed volumes.
 	// +optional
 	// +kubebuilder:validation:MinItems=1
 	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+	// NodeSelector constrains the Pod to nodes with matching labels.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations allow the Pod to schedule onto nodes with matching taints.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// Affinity defines node and Pod scheduling constraints.
+	// A podAntiAffinity set here replaces the default anti-affinity used
+	// for multi-replica deployments.
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+	// HostAliases adds entries to the Pod's /etc/hosts file, for example to
+	// resolve internal registries or provider endpoints in air-gapped clusters.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	HostAliases []corev1.HostAlias `json:"hostAliases,omitempty"`
+	// DNSConfig sets additional DNS parameters of the Pod, such as
+	// nameservers and search domains. They are merged with the settings
+	// generated from the cluster DNS.
+	// +optional
+	DNSConfig *corev1.PodDNSConfig `json:"dnsConfig,omitempty"`
+	// PodSecurityContext replaces the Pod security context, for example to set
+	// runAsUser or a seccompProfile. fsGroup defaults to 1001 when unset.
+	// +optional
+	PodSecurityContext *corev1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+	// SecurityContext sets the security context of the server container.
+	// Sidecars and init containers keep their own.
+	// +optional
+	SecurityContext *corev1.SecurityContext `json:"securityContext,omitempty"`
+	// ImagePullPolicy sets the pull policy of the server container. Defaults to
+	// Always for images tagged latest or without a tag, and IfNotPresent otherwise.
+	// +optional
+	// +kubebuilder:validation:Enum=Always;IfNotPresent;Never
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	// TerminationGracePeriodSeconds is the time the server has to drain
+	// in-flight requests after it is asked to stop. Defaults to 60 seconds.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+	// Lifecycle sets the lifecycle hooks of the server container, for example
+	// a preStop hook that waits for load balancers to stop sending requests.
+	// +optional
+	Lifecycle *corev1.Lifecycle `json:"lifecycle,omitempty"`
+	// TerminationMessagePolicy sets how the termination message of the server
+	// container is populated. FallbackToLogsOnError uses the end of the log
+	// when the server fails without writing a message. Defaults to File.
+	// +optional
+	// +kubebuilder:validation:Enum=File;FallbackToLogsOnError
+	TerminationMessagePolicy corev1.TerminationMessagePolicy `json:"terminationMessagePolicy,omitempty"`
+}
+
+// ProviderSecretRef maps a Secret key to an environment variable of the server.
+type ProviderSecretRef struct {
+	// EnvVar is the name of the environment variable set from the Secret key.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[A-Za-z_][A-Za-z0-9_]*$`
+	EnvVar string `json:"envVar"`
+	// SecretKeyRef references the Secret key holding the value. The Secret
+	// must have the label ogx.io/watch: "true" for pods to restart when it
+	// is rotated.
+	// +kubebuilder:validation:Required
+	SecretKeyRef SecretKeyRef `json:"secretKeyRef"`
+}
+
+// StartupProbeType selects how the startup probe checks the server health.
+// +kubebuilder:validation:Enum=HTTPGet;Exec
+type StartupProbeType string
+
+const (
+	// StartupProbeTypeHTTPGet queries the server health endpoint.
+	StartupProbeTypeHTTPGet StartupProbeType = "HTTPGet"
+	// StartupProbeTypeExec runs a command in the server container.
+	StartupProbeTypeExec StartupProbeType = "Exec"
+)
+
+// StartupProbeSpec overrides the check and timing of the server startup
+// probe. Unset fields keep the operator defaults.
+// +kubebuilder:validation:XValidation:rule="!has(self.type) || self.type != 'Exec' || has(self.command)",message="command is required when type is Exec"
+// +kubebuilder:validation:XValidation:rule="!has(self.command) || (has(self.type) && self.type == 'Exec')",message="command is only valid when type is Exec"
+type StartupProbeSpec struct {
+	// Type is HTTPGet or Exec. Exec suits distributions that report their
+	// health through a command instead of an HTTP endpoint. Defaults to HTTPGet.
+	// +optional
+	Type StartupProbeType `json:"type,omitempty"`
+	// Command is run in the server container when Type is Exec. The server
+	// is healthy when the command exits with status 0.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	Command []string `json:"command,omitempty"`
+	// InitialDelaySeconds is the delay before the first probe. Defaults to 15.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	InitialDelaySeconds *int32 `json:"initialDelaySeconds,omitempty"`
+	// PeriodSeconds is how often the probe runs. Defaults to 10.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	PeriodSeconds *int32 `json:"periodSeconds,omitempty"`
+	// TimeoutSeconds is how long a probe may take. Defaults to 30.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+	// FailureThreshold is the number of consecutive failures after which the
+	// container is restarted. Defaults to 3.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	FailureThreshold *int32 `json:"failureThreshold,omitempty"`
 }
 
 // WorkloadSpec consolidates Kubernetes deployment settings.
+// +kubebuilder:validation:XValidation:rule="!has(self.rolloutHealthGate) || !has(self.revisionHistoryLimit) || self.revisionHistoryLimit > 0",message="revisionHistoryLimit must be positive when rolloutHealthGate is set"
+// +kubebuilder:validation:XValidation:rule="!has(self.storage) || !has(self.ephemeralStorage)",message="storage and ephemeralStorage are mutually exclusive"
 type WorkloadSpec struct {
 	// Replicas is the desired Pod replica count.
 	// +optional
@@ -405,6 +788,40 @@ type WorkloadSpec struct {
 	// +optional
 	// +kubebuilder:validation:Minimum=1
 	Workers *int32 `json:"workers,omitempty"`
+	// LogLevel sets the log level of all server components.
+	// When omitted, the distribution default is used.
+	// +optional
+	// +kubebuilder:validation:Enum=debug;info;warn;error
+	LogLevel string `json:"logLevel,omitempty"`
+	// HealthCheckPath is the path of the server health endpoint used by the
+	// startup probe, for server builds that expose it elsewhere.
+	// +optional
+	// +kubebuilder:default:="/v1/health"
+	// +kubebuilder:validation:Pattern=`^/`
+	HealthCheckPath string `json:"healthCheckPath,omitempty"`
+	// StartupProbe tunes the startup probe timing, for example to give
+	// servers that load large models more time before they are restarted.
+	// +optional
+	StartupProbe *StartupProbeSpec `json:"startupProbe,omitempty"`
+	// APIBasePath is the path prefix of the server API. The operator queries
+	// the providers and version endpoints below it.
+	// +optional
+	// +kubebuilder:default:="/v1"
+	// +kubebuilder:validation:Pattern=`^/`
+	APIBasePath string `json:"apiBasePath,omitempty"`
+	// StartupScriptConfigMap references a ConfigMap key holding a shell script
+	// that replaces the operator's default startup script, for example to set
+	// up a proxy before the server starts. The script is run with /bin/sh.
+	// +optional
+	StartupScriptConfigMap *ConfigMapKeyRef `json:"startupScriptConfigMap,omitempty"`
+	// AdditionalConfigMounts mounts further ConfigMaps read-only in the server
+	// container, e.g. prompt templates or tool specs. Changing a mounted
+	// ConfigMap restarts the server.
+	// +optional
+	// +listType=map
+	// +listMapKey=mountPath
+	// +kubebuilder:validation:MaxItems=16
+	AdditionalConfigMounts []ConfigMount `json:"additionalConfigMounts,omitempty"`
 	// Resources defines CPU/memory requests and limits.
 	// +optional
 	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
@@ -414,13 +831,94 @@ type WorkloadSpec struct {
 	// Storage defines PVC configuration.
 	// +optional
 	Storage *PVCStorageSpec `json:"storage,omitempty"`
+	// EphemeralStorage configures the emptyDir volume used for server data
+	// when no storage is configured.
+	// +optional
+	EphemeralStorage *EphemeralStorageSpec `json:"ephemeralStorage,omitempty"`
+	// ModelCache moves the Hugging Face model cache out of the storage
+	// volume. By default HF_HOME is the storage mount path.
+	// +optional
+	ModelCache *ModelCacheSpec `json:"modelCache,omitempty"`
 	// PodDisruptionBudget controls voluntary disruption tolerance.
 	// +optional
 	PodDisruptionBudget *PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+	// DeploymentStrategy configures how server pods are replaced on updates.
+	// Defaults to RollingUpdate, or to Recreate when storage is configured.
+	// +optional
+	DeploymentStrategy *DeploymentStrategySpec `json:"deploymentStrategy,omitempty"`
+	// RevisionHistoryLimit is the number of old ReplicaSets kept to allow
+	// rollback. Defaults to 3 rather than the Kubernetes default of 10. The
+	// rollout health gate needs at least one to roll back to.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+	// RolloutHealthGate rolls back a rollout whose providers report errors.
+	// +optional
+	RolloutHealthGate *RolloutHealthGateSpec `json:"rolloutHealthGate,omitempty"`
+	// PlacementStatus records the node of each Ready server pod in
+	// status.placement. Without it, status.placement is not populated.
+	// +optional
+	PlacementStatus *PlacementStatusSpec `json:"placementStatus,omitempty"`
+	// RequireHealthyProviders keeps the phase at Initializing after the pods
+	// are ready until the server's providers report healthy. Without it,
+	// provider errors that outlast the grace period only mark the server as
+	// degraded.
+	// +optional
+	RequireHealthyProviders bool `json:"requireHealthyProviders,omitempty"`
+	// ProjectedConfig mounts the override config and the managed CA bundle
+	// together in a single projected volume at /etc/ogx/ instead of separate
+	// volumes. SSL_CERT_FILE then points to /etc/ogx/ca-bundle.crt. It has no
+	// effect unless both an override config and a CA bundle are configured, nor
+	// with caCertificateSecrets, whose bundle an init container assembles.
+	// +optional
+	ProjectedConfig bool `json:"projectedConfig,omitempty"`
 	// TopologySpreadConstraints defines Pod spreading rules.
 	// +optional
 	// +kubebuilder:validation:MinItems=1
 	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// Sidecars are additional containers run alongside the server container,
+	// for example log forwarders or telemetry agents. They can mount the
+	// volumes declared in overrides.volumes. The name ogx is reserved.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Sidecars []corev1.Container `json:"sidecars,omitempty"`
+	// InitContainers run before the server starts, for example to download
+	// model weights. The server storage volume is mounted at the storage mount
+	// path unless the init container already mounts it. The name ogx and the
+	// sidecar names are reserved.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	InitContainers []corev1.Container `json:"initContainers,omitempty"`
+	// ProviderSecrets exposes Secret keys to the server as environment
+	// variables, so that provider settings in the config can reference
+	// credentials such as ${env.VLLM_API_TOKEN}. Entries in overrides.env with
+	// the same name take precedence.
+	// +optional
+	// +listType=map
+	// +listMapKey=envVar
+	// +kubebuilder:validation:MinItems=1
+	ProviderSecrets []ProviderSecretRef `json:"providerSecrets,omitempty"`
+	// ImagePullSecrets references Secrets in the OGXServer namespace used to
+	// pull the server, sidecar and init container images from private registries.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+	// CommonLabels are added to every resource rendered for the server and to
+	// its pods. Labels set by the operator take precedence.
+	// +optional
+	CommonLabels map[string]string `json:"commonLabels,omitempty"`
+	// CommonAnnotations are added to every resource rendered for the server and
+	// to its pods. Annotations set by the operator take precedence.
+	// +optional
+	CommonAnnotations map[string]string `json:"commonAnnotations,omitempty"`
+	// DeploymentAnnotations are added to the metadata of the server
+	// Deployment only, not to its pods, e.g. for Argo CD sync waves or Flux
+	// hints. They take precedence over commonAnnotations. Annotations set by
+	// the operator take precedence.
+	// +optional
+	DeploymentAnnotations map[string]string `json:"deploymentAnnotations,omitempty"`
 	// Overrides allows pod-level customization.
 	// +optional
 	Overrides *WorkloadOverrides `json:"overrides,omitempty"`
@@ -481,7 +979,7 @@ type OGXServerSpec struct {
 }
 
 // OGXServerPhase represents the current phase of the OGXServer.
-// +kubebuilder:validation:Enum=Pending;Initializing;Ready;Failed;Terminating
+// +kubebuilder:validation:Enum=Pending;Initializing;Ready;Failed;Terminating;Suspended
 type OGXServerPhase string
 
 const (
@@ -490,10 +988,24 @@ const (
 	OGXServerPhaseReady        OGXServerPhase = "Ready"
 	OGXServerPhaseFailed       OGXServerPhase = "Failed"
 	OGXServerPhaseTerminating  OGXServerPhase = "Terminating"
+	// OGXServerPhaseSuspended reports a server scaled to zero replicas.
+	OGXServerPhaseSuspended OGXServerPhase = "Suspended"
+)
+
+// Provider health status values reported in ProviderHealthStatus.Status.
+const (
+	ProviderHealthOK             = "OK"
+	ProviderHealthError          = "Error"
+	ProviderHealthNotImplemented = "Not Implemented"
+	// ProviderHealthInitializing marks a provider that is still starting up.
+	// The operator records it in place of transient statuses such as
+	// "initializing" or "starting" reported while the server warms up.
+	ProviderHealthInitializing = "Initializing"
 )
 
 // ProviderHealthStatus represents the health status of a provider.
 type ProviderHealthStatus struct {
+	// Status is one of OK, Error, Not Implemented or Initializing.
 	Status  string `json:"status"`
 	Message string `json:"message"`
 }
@@ -507,24 +1019,60 @@ type ProviderInfo struct {
 	Health       ProviderHealthStatus `json:"health"`
 }
 
+// ModelInfo represents a single model from the models endpoint.
+type ModelInfo struct {
+	// Identifier is the model ID clients use in requests.
+	Identifier string `json:"identifier"`
+	// ProviderID is the provider serving the model.
+	// +optional
+	ProviderID string `json:"providerID,omitempty"`
+	// ModelType is the kind of model, such as llm or embedding.
+	// +optional
+	ModelType string `json:"modelType,omitempty"`
+}
+
 // DistributionConfig represents the configuration from the providers endpoint.
 type DistributionConfig struct {
 	ActiveDistribution     string            `json:"activeDistribution,omitempty"`
 	Providers              []ProviderInfo    `json:"providers,omitempty"`
 	AvailableDistributions map[string]string `json:"availableDistributions,omitempty"`
+	// ProvidersStale is true when the providers could not be refreshed from a
+	// Ready server, and providers holds the last list the server reported.
+	// +optional
+	ProvidersStale bool `json:"providersStale,omitempty"`
+	// Models lists the models the Ready server exposes. It keeps the last
+	// list the server reported when the models endpoint cannot be queried.
+	// +optional
+	Models []ModelInfo `json:"models,omitempty"`
 }
 
 // VersionInfo contains version-related information.
 type VersionInfo struct {
-	OperatorVersion string      `json:"operatorVersion,omitempty"`
-	ServerVersion   string      `json:"serverVersion,omitempty"`
-	LastUpdated     metav1.Time `json:"lastUpdated,omitempty"`
+	OperatorVersion string `json:"operatorVersion,omitempty"`
+	ServerVersion   string `json:"serverVersion,omitempty"`
+	// Capabilities lists the version-dependent server capabilities enabled for
+	// spec.distribution.version, the version the operator renders the pod for.
+	// Empty when the version is not set.
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	Capabilities []string `json:"capabilities,omitempty"`
+	// ServerModule is the Python module path that starts the server, selected
+	// from spec.distribution.version. Empty when the version is not set and the
+	// container detects it at startup.
+	// +optional
+	ServerModule string      `json:"serverModule,omitempty"`
+	LastUpdated  metav1.Time `json:"lastUpdated,omitempty"`
 }
 
 // ResolvedDistributionStatus tracks the resolved distribution image for change detection.
 type ResolvedDistributionStatus struct {
-	// Image is the resolved container image reference (with digest when available).
+	// Image is the container image reference the server runs. It includes the
+	// pinned digest when the operator runs with --pin-image-digests.
 	Image string `json:"image,omitempty"`
+	// ImageSource indicates where the image came from: "catalog" for the
+	// distribution catalog, "override" for an image-overrides entry of the
+	// operator config, or "custom" for spec.distribution.image.
+	ImageSource string `json:"imageSource,omitempty"`
 	// ConfigSource indicates the config origin: "embedded" or "oci-label".
 	ConfigSource string `json:"configSource,omitempty"`
 	// ConfigHash is the SHA256 hash of the base config used.
@@ -547,6 +1095,60 @@ type ConfigGenerationStatus struct {
 	ConfigVersion int `json:"configVersion,omitempty"`
 }
 
+// PodPlacement records where a Ready server pod is scheduled.
+type PodPlacement struct {
+	// PodName is the name of the pod.
+	PodName string `json:"podName"`
+	// NodeName is the name of the node the pod is scheduled on.
+	NodeName string `json:"nodeName,omitempty"`
+	// NodeLabels holds the node's values of the label keys listed in
+	// spec.workload.placementStatus.nodeLabels. Labels the node does not
+	// have are omitted.
+	// +optional
+	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
+}
+
+// ManagedResourceRef identifies a resource the operator applies for a server.
+type ManagedResourceRef struct {
+	// Kind is the kind of the resource.
+	Kind string `json:"kind"`
+	// Name is the name of the resource.
+	Name string `json:"name"`
+	// Namespace is the namespace of the resource. Empty for cluster-scoped resources.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// CABundleStatus reports the certificates in the managed CA bundle.
+type CABundleStatus struct {
+	// CertificateCount is the number of certificates in the combined bundle.
+	CertificateCount int32 `json:"certificateCount,omitempty"`
+	// EarliestExpiry is when the first certificate in the bundle expires.
+	// +optional
+	EarliestExpiry *metav1.Time `json:"earliestExpiry,omitempty"`
+	// EarliestExpirySubject is the subject of the certificate that expires first.
+	// +optional
+	EarliestExpirySubject string `json:"earliestExpirySubject,omitempty"`
+}
+
+// ReconcileTimings records how long each phase of the last reconcile took.
+// Phases that did not run in the last reconcile are omitted.
+type ReconcileTimings struct {
+	// StartedAt is when the last reconcile started.
+	StartedAt metav1.Time `json:"startedAt"`
+	// Render is the time spent building and rendering the manifests.
+	// +optional
+	Render *metav1.Duration `json:"render,omitempty"`
+	// Apply is the time spent applying the manifests to the cluster.
+	// +optional
+	Apply *metav1.Duration `json:"apply,omitempty"`
+	// HealthProbe is the time spent querying the server's provider and version endpoints.
+	// +optional
+	HealthProbe *metav1.Duration `json:"healthProbe,omitempty"`
+	// Total is the time from the start of the reconcile to the status update.
+	// +optional
+	Total *metav1.Duration `json:"total,omitempty"`
+}
+
 // OGXServerStatus defines the observed state of OGXServer.
 type OGXServerStatus struct {
 	// Phase represents the current phase of the server.
@@ -565,11 +1167,42 @@ type OGXServerStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 	// AvailableReplicas is the number of available replicas.
 	AvailableReplicas int32 `json:"availableReplicas,omitempty"`
+	// Placement lists the nodes of Ready server pods, sorted by pod name, when
+	// spec.workload.placementStatus is set. Refreshed on each reconcile and
+	// capped at 50 entries.
+	// +optional
+	// +kubebuilder:validation:MaxItems=50
+	Placement []PodPlacement `json:"placement,omitempty"`
 	// ServiceURL is the internal Kubernetes service URL.
 	ServiceURL string `json:"serviceURL,omitempty"`
 	// ExternalURL is the external URL when external access is configured.
 	// +optional
 	ExternalURL *string `json:"externalURL,omitempty"`
+	// LoadBalancerAddress is the external IP or hostname assigned to the
+	// Service when network.serviceType is LoadBalancer. Empty until the load
+	// balancer is provisioned.
+	// +optional
+	LoadBalancerAddress string `json:"loadBalancerAddress,omitempty"`
+	// ManagedResources lists the resources applied from the operator
+	// manifests on the last successful apply, sorted by kind and name. It
+	// includes resources without an owner reference to the OGXServer, such
+	// as the PVC.
+	// +optional
+	ManagedResources []ManagedResourceRef `json:"managedResources,omitempty"`
+	// CABundle reports the certificates in the managed CA bundle.
+	// Only set when a CA bundle is configured.
+	// +optional
+	CABundle *CABundleStatus `json:"caBundle,omitempty"`
+	// ReconcileTimings records per-phase durations of the last reconcile.
+	// Only reported when the operator runs with --report-reconcile-timings.
+	// +optional
+	ReconcileTimings *ReconcileTimings `json:"reconcileTimings,omitempty"`
+	// LastReconcileTime is when the last reconcile finished, whether or not it succeeded.
+	// +optional
+	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
+	// LastReconcileDuration is how long the last reconcile took.
+	// +optional
+	LastReconcileDuration *metav1.Duration `json:"lastReconcileDuration,omitempty"`
 }
 
 // +kubebuilder:object:root=true
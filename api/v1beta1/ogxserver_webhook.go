@@ -19,10 +19,13 @@ package v1beta1
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -31,6 +34,9 @@ import (
 
 var ogxserverlog = logf.Log.WithName("ogxserver-webhook")
 
+// caBundleInitReservedMessage explains why the CA bundle init container name is rejected.
+const caBundleInitReservedMessage = "the name is reserved for the CA bundle init container while caCertificateSecrets is set"
+
 // OGXServerValidator validates OGXServer resources.
 type OGXServerValidator struct {
 	// EmbeddedDistributionNames is the list of known distribution names from
@@ -103,11 +109,161 @@ func (v *OGXServerValidator) collectValidationErrors(r *OGXServer) field.ErrorLi
 		allErrs = append(allErrs, validateProviderReferences(r.Spec.Resources, r.Spec.Providers)...)
 	}
 
+	if r.Spec.TLS != nil && r.Spec.TLS.Trust != nil {
+		allErrs = append(allErrs, validateTrustConfig(r.Spec.TLS.Trust)...)
+	}
+
+	if r.Spec.Network != nil {
+		allErrs = append(allErrs, validateAdditionalPorts(r.Spec.Network)...)
+	}
+
+	if r.Spec.Workload != nil {
+		caBundleInit := rendersCABundleInitContainer(r.Spec.TLS)
+		allErrs = append(allErrs, validateSidecars(r.Spec.Workload.Sidecars, caBundleInit)...)
+		allErrs = append(allErrs, validateInitContainers(r.Spec.Workload.InitContainers, r.Spec.Workload.Sidecars, caBundleInit)...)
+	}
+
 	allErrs = append(allErrs, validateAdoptionAnnotations(r)...)
 
 	return allErrs
 }
 
+// rendersCABundleInitContainer reports whether the pod gets the CA bundle init
+// container, which is the case when CA certificates are sourced from Secrets.
+// Its name is only reserved then, so that existing specs keep working.
+func rendersCABundleInitContainer(tls *TLSClientConfig) bool {
+	return tls != nil && tls.Trust != nil && len(tls.Trust.CACertificateSecrets) > 0
+}
+
+// validateSidecars rejects sidecars named like the server container or the CA
+// bundle init container, which would otherwise only fail when the Deployment
+// is applied.
+func validateSidecars(sidecars []corev1.Container, caBundleInit bool) field.ErrorList {
+	var errs field.ErrorList
+	for i, sidecar := range sidecars {
+		path := field.NewPath("spec", "workload", "sidecars").Index(i).Child("name")
+		switch {
+		case sidecar.Name == DefaultContainerName:
+			errs = append(errs, field.Invalid(path, sidecar.Name, "the name is reserved for the server container"))
+		case caBundleInit && sidecar.Name == CABundleInitContainerName:
+			errs = append(errs, field.Invalid(path, sidecar.Name, caBundleInitReservedMessage))
+		}
+	}
+	return errs
+}
+
+// validateAdditionalPorts rejects additional ports that cannot be added to the
+// Service next to the server port, which requires unique port names and numbers.
+func validateAdditionalPorts(network *NetworkSpec) field.ErrorList {
+	var errs field.ErrorList
+	serverPort := DefaultServerPort
+	if network.Port != 0 {
+		serverPort = network.Port
+	}
+
+	type protocolPort struct {
+		protocol corev1.Protocol
+		port     int32
+	}
+	names := make(map[string]bool)
+	ports := map[protocolPort]bool{{protocol: corev1.ProtocolTCP, port: serverPort}: true}
+	for i, port := range network.AdditionalPorts {
+		path := field.NewPath("spec", "network", "additionalPorts").Index(i)
+		switch {
+		case port.Name == "":
+			errs = append(errs, field.Required(path.Child("name"), "a name is required to expose the port on the Service"))
+		case port.Name == DefaultServicePortName:
+			errs = append(errs, field.Invalid(path.Child("name"), port.Name, "the name is reserved for the server port"))
+		case names[port.Name]:
+			errs = append(errs, field.Duplicate(path.Child("name"), port.Name))
+		default:
+			for _, msg := range validation.IsValidPortName(port.Name) {
+				errs = append(errs, field.Invalid(path.Child("name"), port.Name, msg))
+			}
+		}
+		names[port.Name] = true
+
+		key := protocolPort{protocol: port.Protocol, port: port.ContainerPort}
+		if key.protocol == "" {
+			key.protocol = corev1.ProtocolTCP
+		}
+		switch {
+		case key.port == serverPort && key.protocol == corev1.ProtocolTCP:
+			errs = append(errs, field.Invalid(path.Child("containerPort"), port.ContainerPort, "the port is used by the server"))
+		case ports[key]:
+			errs = append(errs, field.Duplicate(path.Child("containerPort"), port.ContainerPort))
+		}
+		ports[key] = true
+	}
+	return errs
+}
+
+// validateTrustConfig rejects CA certificate references that would otherwise
+// only fail when the managed CA bundle is built: the same key referenced twice,
+// and, with preserveKeys, keys that clash with each other or with the combined bundle.
+func validateTrustConfig(trust *TrustConfig) field.ErrorList {
+	var errs field.ErrorList
+	trustPath := field.NewPath("spec", "tls", "trust")
+
+	type keyRef struct{ name, key string }
+	refs := make(map[keyRef]bool)
+	keys := make(map[string]bool)
+	check := func(path *field.Path, name, key string) {
+		ref := keyRef{name: name, key: key}
+		if refs[ref] {
+			errs = append(errs, field.Duplicate(path, fmt.Sprintf("%s/%s", name, key)))
+			return
+		}
+		refs[ref] = true
+
+		if !trust.PreserveKeys {
+			return
+		}
+		if key == ManagedCABundleKey {
+			errs = append(errs, field.Invalid(path.Child("key"), key,
+				"the key is reserved for the combined bundle when preserveKeys is set"))
+			return
+		}
+		if keys[key] {
+			errs = append(errs, field.Invalid(path.Child("key"), key,
+				"the key is referenced by more than one source; keys must be unique when preserveKeys is set"))
+		}
+		keys[key] = true
+	}
+
+	for i, ref := range trust.CACertificates {
+		check(trustPath.Child("caCertificates").Index(i), ref.Name, ref.Key)
+		if ref.Name == trust.ManagedConfigMapName {
+			errs = append(errs, field.Invalid(trustPath.Child("managedConfigMapName"), trust.ManagedConfigMapName,
+				"the managed CA bundle must not replace a referenced CA certificate ConfigMap"))
+		}
+	}
+	for i, ref := range trust.CACertificateSecrets {
+		check(trustPath.Child("caCertificateSecrets").Index(i), ref.Name, ref.Key)
+	}
+
+	return errs
+}
+
+// validateInitContainers rejects init containers named like the server
+// container, the CA bundle init container or a sidecar, as container names
+// must be unique within a pod.
+func validateInitContainers(initContainers, sidecars []corev1.Container, caBundleInit bool) field.ErrorList {
+	var errs field.ErrorList
+	for i, initContainer := range initContainers {
+		path := field.NewPath("spec", "workload", "initContainers").Index(i).Child("name")
+		switch {
+		case initContainer.Name == DefaultContainerName:
+			errs = append(errs, field.Invalid(path, initContainer.Name, "the name is reserved for the server container"))
+		case caBundleInit && initContainer.Name == CABundleInitContainerName:
+			errs = append(errs, field.Invalid(path, initContainer.Name, caBundleInitReservedMessage))
+		case slices.ContainsFunc(sidecars, func(c corev1.Container) bool { return c.Name == initContainer.Name }):
+			errs = append(errs, field.Duplicate(path, initContainer.Name))
+		}
+	}
+	return errs
+}
+
 // validateAdoptionAnnotations rejects adoption annotations whose value equals
 // the CR name. Same-name adoption causes Deployment name conflicts and is not
 // a supported migration path.
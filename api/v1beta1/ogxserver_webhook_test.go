@@ -17,9 +17,12 @@ limitations under the License.
 package v1beta1
 
 import (
+	"context"
+	"slices"
 	"strings"
 	"testing"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -643,3 +646,225 @@ func TestCollectValidationErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateTrustConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		trust     *TrustConfig
+		wantErrs  int
+		errSubstr string
+	}{
+		{
+			name: "distinct references are valid",
+			trust: &TrustConfig{CACertificates: []ConfigMapKeyRef{
+				{Name: "corporate", Key: "root.crt"},
+				{Name: "vendor", Key: "root.crt"},
+			}},
+			wantErrs: 0,
+		},
+		{
+			name: "same reference twice",
+			trust: &TrustConfig{CACertificateSecrets: []SecretKeyRef{
+				{Name: "ca", Key: "ca.crt"},
+				{Name: "ca", Key: "ca.crt"},
+			}},
+			wantErrs:  1,
+			errSubstr: "Duplicate value",
+		},
+		{
+			name: "shared key with preserveKeys",
+			trust: &TrustConfig{PreserveKeys: true, CACertificates: []ConfigMapKeyRef{
+				{Name: "corporate", Key: "root.crt"},
+				{Name: "vendor", Key: "root.crt"},
+			}},
+			wantErrs:  1,
+			errSubstr: "referenced by more than one source",
+		},
+		{
+			name: "combined bundle key with preserveKeys",
+			trust: &TrustConfig{PreserveKeys: true, CACertificates: []ConfigMapKeyRef{
+				{Name: "corporate", Key: ManagedCABundleKey},
+			}},
+			wantErrs:  1,
+			errSubstr: "reserved for the combined bundle",
+		},
+		{
+			name: "combined bundle key without preserveKeys",
+			trust: &TrustConfig{CACertificates: []ConfigMapKeyRef{
+				{Name: "corporate", Key: ManagedCABundleKey},
+			}},
+			wantErrs: 0,
+		},
+		{
+			name: "managed name replaces a source ConfigMap",
+			trust: &TrustConfig{ManagedConfigMapName: "corporate", CACertificates: []ConfigMapKeyRef{
+				{Name: "corporate", Key: "root.crt"},
+			}},
+			wantErrs:  1,
+			errSubstr: "must not replace a referenced CA certificate ConfigMap",
+		},
+		{
+			name: "distinct managed name",
+			trust: &TrustConfig{ManagedConfigMapName: "trusted-cas", CACertificates: []ConfigMapKeyRef{
+				{Name: "corporate", Key: "root.crt"},
+			}},
+			wantErrs: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateTrustConfig(tt.trust)
+			if len(errs) != tt.wantErrs {
+				t.Fatalf("validateTrustConfig() returned %d errors, want %d: %v", len(errs), tt.wantErrs, errs)
+			}
+			if tt.errSubstr != "" && !strings.Contains(errs.ToAggregate().Error(), tt.errSubstr) {
+				t.Errorf("no error contains %q; errors: %v", tt.errSubstr, errs)
+			}
+		})
+	}
+}
+
+func TestValidateSidecars(t *testing.T) {
+	errs := validateSidecars([]corev1.Container{{Name: "log-forwarder"}, {Name: CABundleInitContainerName}}, false)
+	if len(errs) != 0 {
+		t.Fatalf("validateSidecars() returned errors for valid sidecars: %v", errs)
+	}
+
+	errs = validateSidecars([]corev1.Container{{Name: "metrics"}, {Name: DefaultContainerName}}, false)
+	if len(errs) != 1 {
+		t.Fatalf("validateSidecars() returned %d errors, want 1: %v", len(errs), errs)
+	}
+	if errs[0].Field != "spec.workload.sidecars[1].name" {
+		t.Errorf("error field = %q, want spec.workload.sidecars[1].name", errs[0].Field)
+	}
+
+	errs = validateSidecars([]corev1.Container{{Name: CABundleInitContainerName}}, true)
+	if len(errs) != 1 {
+		t.Fatalf("validateSidecars() returned %d errors for the CA bundle init container name, want 1: %v", len(errs), errs)
+	}
+}
+
+func TestValidateInitContainers(t *testing.T) {
+	sidecars := []corev1.Container{{Name: "metrics"}}
+
+	errs := validateInitContainers([]corev1.Container{{Name: "download-model"}, {Name: CABundleInitContainerName}}, sidecars, false)
+	if len(errs) != 0 {
+		t.Fatalf("validateInitContainers() returned errors for valid init containers: %v", errs)
+	}
+
+	errs = validateInitContainers([]corev1.Container{{Name: DefaultContainerName}, {Name: "metrics"}}, sidecars, false)
+	if len(errs) != 2 {
+		t.Fatalf("validateInitContainers() returned %d errors, want 2: %v", len(errs), errs)
+	}
+	if errs[0].Field != "spec.workload.initContainers[0].name" {
+		t.Errorf("error field = %q, want spec.workload.initContainers[0].name", errs[0].Field)
+	}
+	if errs[1].Field != "spec.workload.initContainers[1].name" {
+		t.Errorf("error field = %q, want spec.workload.initContainers[1].name", errs[1].Field)
+	}
+
+	errs = validateInitContainers([]corev1.Container{{Name: CABundleInitContainerName}}, sidecars, true)
+	if len(errs) != 1 {
+		t.Fatalf("validateInitContainers() returned %d errors for the CA bundle init container name, want 1: %v", len(errs), errs)
+	}
+}
+
+func TestValidateAdditionalPorts(t *testing.T) {
+	errs := validateAdditionalPorts(&NetworkSpec{AdditionalPorts: []corev1.ContainerPort{
+		{Name: "metrics", ContainerPort: 9090},
+		{Name: "dns", ContainerPort: 9090, Protocol: corev1.ProtocolUDP},
+	}})
+	if len(errs) != 0 {
+		t.Fatalf("validateAdditionalPorts() returned errors for valid ports: %v", errs)
+	}
+
+	errs = validateAdditionalPorts(&NetworkSpec{Port: 9000, AdditionalPorts: []corev1.ContainerPort{
+		{ContainerPort: 9090},
+		{Name: DefaultServicePortName, ContainerPort: 9091},
+		{Name: "metrics", ContainerPort: 9000},
+		{Name: "metrics", ContainerPort: 9092},
+		{Name: "Metrics_Port", ContainerPort: 9092},
+	}})
+	want := []string{
+		"spec.network.additionalPorts[0].name",
+		"spec.network.additionalPorts[1].name",
+		"spec.network.additionalPorts[2].containerPort",
+		"spec.network.additionalPorts[3].name",
+		"spec.network.additionalPorts[4].name",
+		"spec.network.additionalPorts[4].containerPort",
+	}
+	var got []string
+	for _, err := range errs {
+		if !slices.Contains(got, err.Field) {
+			got = append(got, err.Field)
+		}
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("validateAdditionalPorts() error fields = %v, want %v: %v", got, want, errs)
+	}
+}
+
+func TestOGXServerValidator(t *testing.T) {
+	validator := &OGXServerValidator{EmbeddedDistributionNames: []string{"starter"}}
+
+	newServer := func(mutate func(*OGXServer)) *OGXServer {
+		server := &OGXServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec:       OGXServerSpec{Distribution: DistributionSpec{Name: "starter"}},
+		}
+		if mutate != nil {
+			mutate(server)
+		}
+		return server
+	}
+
+	tests := []struct {
+		name      string
+		server    *OGXServer
+		errSubstr string
+	}{
+		{
+			name:   "accepts a valid server",
+			server: newServer(nil),
+		},
+		{
+			name: "rejects an unknown distribution",
+			server: newServer(func(s *OGXServer) {
+				s.Spec.Distribution.Name = "unknown"
+			}),
+			errSubstr: "spec.distribution.name",
+		},
+		{
+			name: "rejects clashing preserved CA keys",
+			server: newServer(func(s *OGXServer) {
+				s.Spec.TLS = &TLSClientConfig{Trust: &TrustConfig{
+					PreserveKeys: true,
+					CACertificates: []ConfigMapKeyRef{
+						{Name: "corporate", Key: "root.crt"},
+						{Name: "vendor", Key: "root.crt"},
+					},
+				}}
+			}),
+			errSubstr: "spec.tls.trust.caCertificates[1].key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, createErr := validator.ValidateCreate(context.Background(), tt.server)
+			_, updateErr := validator.ValidateUpdate(context.Background(), newServer(nil), tt.server)
+			for op, err := range map[string]error{"create": createErr, "update": updateErr} {
+				if tt.errSubstr == "" {
+					if err != nil {
+						t.Errorf("%s: expected no error, got: %v", op, err)
+					}
+					continue
+				}
+				if err == nil || !strings.Contains(err.Error(), tt.errSubstr) {
+					t.Errorf("%s: expected error containing %q, got: %v", op, tt.errSubstr, err)
+				}
+			}
+		})
+	}
+}
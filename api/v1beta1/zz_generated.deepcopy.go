@@ -22,9 +22,9 @@ package v1beta1
 
 import (
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/api/networking/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
@@ -250,6 +250,25 @@ func (in *BraveSearchProvider) DeepCopy() *BraveSearchProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CABundleStatus) DeepCopyInto(out *CABundleStatus) {
+	*out = *in
+	if in.EarliestExpiry != nil {
+		in, out := &in.EarliestExpiry, &out.EarliestExpiry
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CABundleStatus.
+func (in *CABundleStatus) DeepCopy() *CABundleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CABundleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChunkRetrievalParams) DeepCopyInto(out *ChunkRetrievalParams) {
 	*out = *in
@@ -346,6 +365,26 @@ func (in *ConfigMapKeyRef) DeepCopy() *ConfigMapKeyRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMount) DeepCopyInto(out *ConfigMount) {
+	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMount.
+func (in *ConfigMount) DeepCopy() *ConfigMount {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMount)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContextPromptParams) DeepCopyInto(out *ContextPromptParams) {
 	*out = *in
@@ -424,6 +463,31 @@ func (in *CustomProvider) DeepCopy() *CustomProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentStrategySpec) DeepCopyInto(out *DeploymentStrategySpec) {
+	*out = *in
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentStrategySpec.
+func (in *DeploymentStrategySpec) DeepCopy() *DeploymentStrategySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentStrategySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DistributionConfig) DeepCopyInto(out *DistributionConfig) {
 	*out = *in
@@ -441,6 +505,11 @@ func (in *DistributionConfig) DeepCopyInto(out *DistributionConfig) {
 			(*out)[key] = val
 		}
 	}
+	if in.Models != nil {
+		in, out := &in.Models, &out.Models
+		*out = make([]ModelInfo, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionConfig.
@@ -468,9 +537,41 @@ func (in *DistributionSpec) DeepCopy() *DistributionSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EphemeralStorageSpec) DeepCopyInto(out *EphemeralStorageSpec) {
+	*out = *in
+	if in.SizeLimit != nil {
+		in, out := &in.SizeLimit, &out.SizeLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EphemeralStorageSpec.
+func (in *EphemeralStorageSpec) DeepCopy() *EphemeralStorageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EphemeralStorageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalAccessConfig) DeepCopyInto(out *ExternalAccessConfig) {
 	*out = *in
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(ExternalAccessTLSConfig)
+		**out = **in
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalAccessConfig.
@@ -483,6 +584,21 @@ func (in *ExternalAccessConfig) DeepCopy() *ExternalAccessConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalAccessTLSConfig) DeepCopyInto(out *ExternalAccessTLSConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalAccessTLSConfig.
+func (in *ExternalAccessTLSConfig) DeepCopy() *ExternalAccessTLSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalAccessTLSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FileBatchParams) DeepCopyInto(out *FileBatchParams) {
 	*out = *in
@@ -926,6 +1042,21 @@ func (in *KVStorageSpec) DeepCopy() *KVStorageSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedResourceRef) DeepCopyInto(out *ManagedResourceRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedResourceRef.
+func (in *ManagedResourceRef) DeepCopy() *ManagedResourceRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedResourceRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MilvusProvider) DeepCopyInto(out *MilvusProvider) {
 	*out = *in
@@ -947,6 +1078,21 @@ func (in *MilvusProvider) DeepCopy() *MilvusProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelCacheSpec) DeepCopyInto(out *ModelCacheSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelCacheSpec.
+func (in *ModelCacheSpec) DeepCopy() *ModelCacheSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelCacheSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ModelConfig) DeepCopyInto(out *ModelConfig) {
 	*out = *in
@@ -983,6 +1129,21 @@ func (in *ModelContextProtocolProvider) DeepCopy() *ModelContextProtocolProvider
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelInfo) DeepCopyInto(out *ModelInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelInfo.
+func (in *ModelInfo) DeepCopy() *ModelInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
 	*out = *in
@@ -1030,19 +1191,24 @@ func (in *NetworkPolicySpec) DeepCopyInto(out *NetworkPolicySpec) {
 	}
 	if in.PolicyTypes != nil {
 		in, out := &in.PolicyTypes, &out.PolicyTypes
-		*out = make([]v1.PolicyType, len(*in))
+		*out = make([]networkingv1.PolicyType, len(*in))
 		copy(*out, *in)
 	}
 	if in.Ingress != nil {
 		in, out := &in.Ingress, &out.Ingress
-		*out = make([]v1.NetworkPolicyIngressRule, len(*in))
+		*out = make([]networkingv1.NetworkPolicyIngressRule, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.AllowNamespaces != nil {
+		in, out := &in.AllowNamespaces, &out.AllowNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Egress != nil {
 		in, out := &in.Egress, &out.Egress
-		*out = make([]v1.NetworkPolicyEgressRule, len(*in))
+		*out = make([]networkingv1.NetworkPolicyEgressRule, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1062,6 +1228,18 @@ func (in *NetworkPolicySpec) DeepCopy() *NetworkPolicySpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 	*out = *in
+	if in.AdditionalPorts != nil {
+		in, out := &in.AdditionalPorts, &out.AdditionalPorts
+		*out = make([]corev1.ContainerPort, len(*in))
+		copy(*out, *in)
+	}
+	if in.LoadBalancerAnnotations != nil {
+		in, out := &in.LoadBalancerAnnotations, &out.LoadBalancerAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.TLS != nil {
 		in, out := &in.TLS, &out.TLS
 		*out = new(TLSSpec)
@@ -1070,13 +1248,18 @@ func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 	if in.ExternalAccess != nil {
 		in, out := &in.ExternalAccess, &out.ExternalAccess
 		*out = new(ExternalAccessConfig)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Policy != nil {
 		in, out := &in.Policy, &out.Policy
 		*out = new(NetworkPolicySpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(ProxySpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSpec.
@@ -1221,7 +1404,14 @@ func (in *OGXServerStatus) DeepCopyInto(out *OGXServerStatus) {
 	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = make([]PodPlacement, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1231,6 +1421,30 @@ func (in *OGXServerStatus) DeepCopyInto(out *OGXServerStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ManagedResources != nil {
+		in, out := &in.ManagedResources, &out.ManagedResources
+		*out = make([]ManagedResourceRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = new(CABundleStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReconcileTimings != nil {
+		in, out := &in.ReconcileTimings, &out.ReconcileTimings
+		*out = new(ReconcileTimings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastReconcileTime != nil {
+		in, out := &in.LastReconcileTime, &out.LastReconcileTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastReconcileDuration != nil {
+		in, out := &in.LastReconcileDuration, &out.LastReconcileDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OGXServerStatus.
@@ -1289,6 +1503,30 @@ func (in *PVCStorageSpec) DeepCopyInto(out *PVCStorageSpec) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.AccessModes != nil {
+		in, out := &in.AccessModes, &out.AccessModes
+		*out = make([]corev1.PersistentVolumeAccessMode, len(*in))
+		copy(*out, *in)
+	}
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PVCStorageSpec.
@@ -1328,6 +1566,26 @@ func (in *PgvectorProvider) DeepCopy() *PgvectorProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementStatusSpec) DeepCopyInto(out *PlacementStatusSpec) {
+	*out = *in
+	if in.NodeLabels != nil {
+		in, out := &in.NodeLabels, &out.NodeLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementStatusSpec.
+func (in *PlacementStatusSpec) DeepCopy() *PlacementStatusSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementStatusSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodDisruptionBudgetSpec) DeepCopyInto(out *PodDisruptionBudgetSpec) {
 	*out = *in
@@ -1353,6 +1611,28 @@ func (in *PodDisruptionBudgetSpec) DeepCopy() *PodDisruptionBudgetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodPlacement) DeepCopyInto(out *PodPlacement) {
+	*out = *in
+	if in.NodeLabels != nil {
+		in, out := &in.NodeLabels, &out.NodeLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodPlacement.
+func (in *PodPlacement) DeepCopy() *PodPlacement {
+	if in == nil {
+		return nil
+	}
+	out := new(PodPlacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderHealthStatus) DeepCopyInto(out *ProviderHealthStatus) {
 	*out = *in
@@ -1385,6 +1665,22 @@ func (in *ProviderInfo) DeepCopy() *ProviderInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderSecretRef) DeepCopyInto(out *ProviderSecretRef) {
+	*out = *in
+	out.SecretKeyRef = in.SecretKeyRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderSecretRef.
+func (in *ProviderSecretRef) DeepCopy() *ProviderSecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProvidersSpec) DeepCopyInto(out *ProvidersSpec) {
 	*out = *in
@@ -1470,6 +1766,21 @@ func (in *ProxyConfig) DeepCopy() *ProxyConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxySpec) DeepCopyInto(out *ProxySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxySpec.
+func (in *ProxySpec) DeepCopy() *ProxySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QdrantProvider) DeepCopyInto(out *QdrantProvider) {
 	*out = *in
@@ -1536,6 +1847,42 @@ func (in *QualifiedModel) DeepCopy() *QualifiedModel {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReconcileTimings) DeepCopyInto(out *ReconcileTimings) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	if in.Render != nil {
+		in, out := &in.Render, &out.Render
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Apply != nil {
+		in, out := &in.Apply, &out.Apply
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.HealthProbe != nil {
+		in, out := &in.HealthProbe, &out.HealthProbe
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Total != nil {
+		in, out := &in.Total, &out.Total
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcileTimings.
+func (in *ReconcileTimings) DeepCopy() *ReconcileTimings {
+	if in == nil {
+		return nil
+	}
+	out := new(ReconcileTimings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteInferenceCommonConfig) DeepCopyInto(out *RemoteInferenceCommonConfig) {
 	*out = *in
@@ -1727,6 +2074,26 @@ func (in *RewriteQueryParams) DeepCopy() *RewriteQueryParams {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutHealthGateSpec) DeepCopyInto(out *RolloutHealthGateSpec) {
+	*out = *in
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutHealthGateSpec.
+func (in *RolloutHealthGateSpec) DeepCopy() *RolloutHealthGateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutHealthGateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RoutedProviderBase) DeepCopyInto(out *RoutedProviderBase) {
 	*out = *in
@@ -1807,6 +2174,46 @@ func (in *SecretKeyRef) DeepCopy() *SecretKeyRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StartupProbeSpec) DeepCopyInto(out *StartupProbeSpec) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InitialDelaySeconds != nil {
+		in, out := &in.InitialDelaySeconds, &out.InitialDelaySeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PeriodSeconds != nil {
+		in, out := &in.PeriodSeconds, &out.PeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StartupProbeSpec.
+func (in *StartupProbeSpec) DeepCopy() *StartupProbeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StartupProbeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StateStorageSpec) DeepCopyInto(out *StateStorageSpec) {
 	*out = *in
@@ -2047,6 +2454,16 @@ func (in *TrustConfig) DeepCopyInto(out *TrustConfig) {
 		*out = make([]ConfigMapKeyRef, len(*in))
 		copy(*out, *in)
 	}
+	if in.CACertificateSecrets != nil {
+		in, out := &in.CACertificateSecrets, &out.CACertificateSecrets
+		*out = make([]SecretKeyRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExpiryWarningWindow != nil {
+		in, out := &in.ExpiryWarningWindow, &out.ExpiryWarningWindow
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustConfig.
@@ -2269,6 +2686,11 @@ func (in *VectorStoresConfig) DeepCopy() *VectorStoresConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VersionInfo) DeepCopyInto(out *VersionInfo) {
 	*out = *in
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	in.LastUpdated.DeepCopyInto(&out.LastUpdated)
 }
 
@@ -2332,6 +2754,13 @@ func (in *WorkloadOverrides) DeepCopyInto(out *WorkloadOverrides) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.EnvFrom != nil {
+		in, out := &in.EnvFrom, &out.EnvFrom
+		*out = make([]corev1.EnvFromSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Command != nil {
 		in, out := &in.Command, &out.Command
 		*out = make([]string, len(*in))
@@ -2356,6 +2785,57 @@ func (in *WorkloadOverrides) DeepCopyInto(out *WorkloadOverrides) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HostAliases != nil {
+		in, out := &in.HostAliases, &out.HostAliases
+		*out = make([]corev1.HostAlias, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DNSConfig != nil {
+		in, out := &in.DNSConfig, &out.DNSConfig
+		*out = new(corev1.PodDNSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodSecurityContext != nil {
+		in, out := &in.PodSecurityContext, &out.PodSecurityContext
+		*out = new(corev1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(corev1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TerminationGracePeriodSeconds != nil {
+		in, out := &in.TerminationGracePeriodSeconds, &out.TerminationGracePeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = new(corev1.Lifecycle)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadOverrides.
@@ -2381,6 +2861,23 @@ func (in *WorkloadSpec) DeepCopyInto(out *WorkloadSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.StartupProbe != nil {
+		in, out := &in.StartupProbe, &out.StartupProbe
+		*out = new(StartupProbeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartupScriptConfigMap != nil {
+		in, out := &in.StartupScriptConfigMap, &out.StartupScriptConfigMap
+		*out = new(ConfigMapKeyRef)
+		**out = **in
+	}
+	if in.AdditionalConfigMounts != nil {
+		in, out := &in.AdditionalConfigMounts, &out.AdditionalConfigMounts
+		*out = make([]ConfigMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
 		*out = new(corev1.ResourceRequirements)
@@ -2396,11 +2893,41 @@ func (in *WorkloadSpec) DeepCopyInto(out *WorkloadSpec) {
 		*out = new(PVCStorageSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.EphemeralStorage != nil {
+		in, out := &in.EphemeralStorage, &out.EphemeralStorage
+		*out = new(EphemeralStorageSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ModelCache != nil {
+		in, out := &in.ModelCache, &out.ModelCache
+		*out = new(ModelCacheSpec)
+		**out = **in
+	}
 	if in.PodDisruptionBudget != nil {
 		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
 		*out = new(PodDisruptionBudgetSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DeploymentStrategy != nil {
+		in, out := &in.DeploymentStrategy, &out.DeploymentStrategy
+		*out = new(DeploymentStrategySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RolloutHealthGate != nil {
+		in, out := &in.RolloutHealthGate, &out.RolloutHealthGate
+		*out = new(RolloutHealthGateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PlacementStatus != nil {
+		in, out := &in.PlacementStatus, &out.PlacementStatus
+		*out = new(PlacementStatusSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TopologySpreadConstraints != nil {
 		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
 		*out = make([]corev1.TopologySpreadConstraint, len(*in))
@@ -2408,6 +2935,51 @@ func (in *WorkloadSpec) DeepCopyInto(out *WorkloadSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Sidecars != nil {
+		in, out := &in.Sidecars, &out.Sidecars
+		*out = make([]corev1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InitContainers != nil {
+		in, out := &in.InitContainers, &out.InitContainers
+		*out = make([]corev1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ProviderSecrets != nil {
+		in, out := &in.ProviderSecrets, &out.ProviderSecrets
+		*out = make([]ProviderSecretRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]corev1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.CommonLabels != nil {
+		in, out := &in.CommonLabels, &out.CommonLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CommonAnnotations != nil {
+		in, out := &in.CommonAnnotations, &out.CommonAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DeploymentAnnotations != nil {
+		in, out := &in.DeploymentAnnotations, &out.DeploymentAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.Overrides != nil {
 		in, out := &in.Overrides, &out.Overrides
 		*out = new(WorkloadOverrides)
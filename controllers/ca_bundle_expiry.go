@@ -0,0 +1,118 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefaultCABundleExpiryWarningWindow is how long before a CA certificate
+// expires the operator warns about it when the trust config sets no window.
+const DefaultCABundleExpiryWarningWindow = 30 * 24 * time.Hour
+
+// caBundleExpiryWarningWindow returns the configured expiry warning window.
+func caBundleExpiryWarningWindow(instance *ogxiov1beta1.OGXServer) time.Duration {
+	if instance.Spec.TLS == nil || instance.Spec.TLS.Trust == nil {
+		return DefaultCABundleExpiryWarningWindow
+	}
+	window := instance.Spec.TLS.Trust.ExpiryWarningWindow
+	if window == nil || window.Duration <= 0 {
+		return DefaultCABundleExpiryWarningWindow
+	}
+	return window.Duration
+}
+
+// parseBundleCertificates parses the PEM certificates of a combined CA bundle.
+// The bundle is built from validated certificates, so blocks that do not
+// parse are skipped.
+func parseBundleCertificates(bundle string) []*x509.Certificate {
+	var certificates []*x509.Certificate
+	remaining := []byte(bundle)
+	for {
+		block, rest := pem.Decode(remaining)
+		if block == nil {
+			return certificates
+		}
+		remaining = rest
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		if cert, err := x509.ParseCertificate(block.Bytes); err == nil {
+			certificates = append(certificates, cert)
+		}
+	}
+}
+
+// updateCABundleExpiry reports the earliest certificate expiry of the combined
+// bundle in the status. When a certificate is expired or expires within the
+// warning window, the CABundleExpiring condition is set and a Warning Event
+// is emitted once per transition. Expiry never fails the reconcile.
+func (r *OGXServerReconciler) updateCABundleExpiry(instance *ogxiov1beta1.OGXServer, bundle string, now time.Time) {
+	certificates := parseBundleCertificates(bundle)
+	if len(certificates) == 0 {
+		instance.Status.CABundle = nil
+		return
+	}
+
+	earliest := certificates[0]
+	expiring := 0
+	window := caBundleExpiryWarningWindow(instance)
+	for _, cert := range certificates {
+		if cert.NotAfter.Before(earliest.NotAfter) {
+			earliest = cert
+		}
+		if cert.NotAfter.Sub(now) < window {
+			expiring++
+		}
+	}
+
+	expiry := metav1.NewTime(earliest.NotAfter.UTC())
+	instance.Status.CABundle = &ogxiov1beta1.CABundleStatus{
+		CertificateCount:      int32(len(certificates)), //nolint:gosec // bounded by MaxCABundleCertificates
+		EarliestExpiry:        &expiry,
+		EarliestExpirySubject: earliest.Subject.String(),
+	}
+
+	var reason, message string
+	switch {
+	case !earliest.NotAfter.After(now):
+		reason = ReasonCABundleCertificateExpired
+		message = fmt.Sprintf("CA certificate %q expired at %s; %d of %d certificates are expired or expire within %s",
+			earliest.Subject.String(), earliest.NotAfter.UTC().Format(time.RFC3339), expiring, len(certificates), window)
+	case expiring > 0:
+		reason = ReasonCABundleCertificateExpiring
+		message = fmt.Sprintf("CA certificate %q expires at %s; %d of %d certificates expire within %s",
+			earliest.Subject.String(), earliest.NotAfter.UTC().Format(time.RFC3339), expiring, len(certificates), window)
+	default:
+		SetCABundleExpiringCondition(&instance.Status, false, ReasonCABundleCertificatesCurrent,
+			fmt.Sprintf("No CA certificate expires within %s", window))
+		return
+	}
+
+	previous := GetCondition(&instance.Status, ConditionTypeCABundleExpiring)
+	if previous == nil || previous.Status != metav1.ConditionTrue || previous.Reason != reason {
+		r.recordEvent(instance, corev1.EventTypeWarning, reason, message)
+	}
+	SetCABundleExpiringCondition(&instance.Status, true, reason, message)
+}
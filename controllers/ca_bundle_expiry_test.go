@@ -0,0 +1,152 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+// generateTestCertPEMExpiring creates a self-signed PEM certificate that expires at notAfter.
+func generateTestCertPEMExpiring(t *testing.T, commonName string, notAfter time.Time) string {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	require.NoError(t, err)
+	tmpl := x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+		IsCA:         true,
+		KeyUsage:     x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestUpdateCABundleExpiry(t *testing.T) {
+	now := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	current := generateTestCertPEMExpiring(t, "current-ca", now.Add(365*24*time.Hour))
+	expiring := generateTestCertPEMExpiring(t, "expiring-ca", now.Add(10*24*time.Hour))
+	expired := generateTestCertPEMExpiring(t, "expired-ca", now.Add(-24*time.Hour))
+
+	tests := []struct {
+		name        string
+		bundle      []string
+		window      *metav1.Duration
+		wantExpiry  time.Time
+		wantSubject string
+		wantStatus  metav1.ConditionStatus
+		wantReason  string
+	}{
+		{
+			name:        "all certificates current",
+			bundle:      []string{current},
+			wantExpiry:  now.Add(365 * 24 * time.Hour),
+			wantSubject: "CN=current-ca",
+			wantStatus:  metav1.ConditionFalse,
+			wantReason:  ReasonCABundleCertificatesCurrent,
+		},
+		{
+			name:        "certificate within default window",
+			bundle:      []string{current, expiring},
+			wantExpiry:  now.Add(10 * 24 * time.Hour),
+			wantSubject: "CN=expiring-ca",
+			wantStatus:  metav1.ConditionTrue,
+			wantReason:  ReasonCABundleCertificateExpiring,
+		},
+		{
+			name:        "certificate outside configured window",
+			bundle:      []string{current, expiring},
+			window:      &metav1.Duration{Duration: 7 * 24 * time.Hour},
+			wantExpiry:  now.Add(10 * 24 * time.Hour),
+			wantSubject: "CN=expiring-ca",
+			wantStatus:  metav1.ConditionFalse,
+			wantReason:  ReasonCABundleCertificatesCurrent,
+		},
+		{
+			name:        "expired certificate",
+			bundle:      []string{expiring, expired, current},
+			wantExpiry:  now.Add(-24 * time.Hour),
+			wantSubject: "CN=expired-ca",
+			wantStatus:  metav1.ConditionTrue,
+			wantReason:  ReasonCABundleCertificateExpired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &ogxiov1beta1.OGXServer{
+				Spec: ogxiov1beta1.OGXServerSpec{
+					TLS: &ogxiov1beta1.TLSClientConfig{Trust: &ogxiov1beta1.TrustConfig{ExpiryWarningWindow: tt.window}},
+				},
+			}
+			recorder := record.NewFakeRecorder(2)
+			r := &OGXServerReconciler{Recorder: recorder}
+
+			r.updateCABundleExpiry(instance, strings.Join(tt.bundle, "\n"), now)
+
+			require.NotNil(t, instance.Status.CABundle)
+			assert.Equal(t, int32(len(tt.bundle)), instance.Status.CABundle.CertificateCount)
+			require.NotNil(t, instance.Status.CABundle.EarliestExpiry)
+			assert.True(t, tt.wantExpiry.Equal(instance.Status.CABundle.EarliestExpiry.Time))
+			assert.Equal(t, tt.wantSubject, instance.Status.CABundle.EarliestExpirySubject)
+
+			condition := GetCondition(&instance.Status, ConditionTypeCABundleExpiring)
+			require.NotNil(t, condition)
+			assert.Equal(t, tt.wantStatus, condition.Status)
+			assert.Equal(t, tt.wantReason, condition.Reason)
+
+			if tt.wantStatus != metav1.ConditionTrue {
+				assert.Empty(t, recorder.Events)
+				return
+			}
+			require.Len(t, recorder.Events, 1)
+			assert.Contains(t, <-recorder.Events, "Warning "+tt.wantReason)
+
+			// The warning is only emitted again when the condition changes.
+			r.updateCABundleExpiry(instance, strings.Join(tt.bundle, "\n"), now)
+			assert.Empty(t, recorder.Events)
+		})
+	}
+}
+
+func TestUpdateCABundleExpiryWithoutCertificates(t *testing.T) {
+	instance := &ogxiov1beta1.OGXServer{}
+	instance.Status.CABundle = &ogxiov1beta1.CABundleStatus{CertificateCount: 1}
+
+	(&OGXServerReconciler{}).updateCABundleExpiry(instance, "", time.Now())
+
+	assert.Nil(t, instance.Status.CABundle)
+	assert.Nil(t, GetCondition(&instance.Status, ConditionTypeCABundleExpiring))
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestControllerOptions(t *testing.T) {
+	r := &OGXServerReconciler{MaxConcurrentReconciles: 4}
+	assert.Equal(t, 4, r.controllerOptions().MaxConcurrentReconciles)
+
+	r = &OGXServerReconciler{}
+	assert.Zero(t, r.controllerOptions().MaxConcurrentReconciles,
+		"unset concurrency should fall back to the controller-runtime default")
+}
+
+func TestRefreshOperatorConfigSkipsUnchangedVersion(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: operatorConfigData, Namespace: "ogx-system"},
+		Data:       map[string]string{"enable-cluster-scoped-resources": "false"},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(configMap).Build()
+	r := &OGXServerReconciler{Client: c, operatorNamespace: "ogx-system"}
+
+	r.refreshOperatorConfig(t.Context())
+	require.True(t, r.DisableClusterScopedResources)
+
+	// Settings are not reapplied while the ConfigMap is unchanged.
+	r.DisableClusterScopedResources = false
+	r.refreshOperatorConfig(t.Context())
+	assert.False(t, r.DisableClusterScopedResources)
+
+	require.NoError(t, c.Get(t.Context(), client.ObjectKeyFromObject(configMap), configMap))
+	configMap.Data["proxy"] = "httpProxy: http://proxy:3128"
+	require.NoError(t, c.Update(t.Context(), configMap))
+
+	r.refreshOperatorConfig(t.Context())
+	assert.True(t, r.DisableClusterScopedResources)
+	require.NotNil(t, r.DefaultProxy)
+	assert.Equal(t, "http://proxy:3128", r.DefaultProxy.HTTPProxy)
+}
+
+func TestOperatorSettingsSnapshot(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: operatorConfigData, Namespace: "ogx-system"},
+		Data:       map[string]string{"proxy": "httpProxy: http://old-proxy:3128"},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(configMap).Build()
+	r := &OGXServerReconciler{Client: c, operatorNamespace: "ogx-system"}
+	r.refreshOperatorConfig(t.Context())
+
+	ctx := r.withOperatorSettings(t.Context())
+
+	// A refresh during the reconcile does not wait for it and does not change
+	// the settings it works with.
+	configMap.Data["proxy"] = "httpProxy: http://new-proxy:3128"
+	require.NoError(t, c.Update(t.Context(), configMap))
+	r.refreshOperatorConfig(t.Context())
+
+	assert.Equal(t, "http://old-proxy:3128", r.operatorSettings(ctx).defaultProxy.HTTPProxy)
+	assert.Equal(t, "http://new-proxy:3128", r.operatorSettings(t.Context()).defaultProxy.HTTPProxy)
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// configMountVolumePrefix prefixes the names of the additional config mount volumes.
+const configMountVolumePrefix = "config-mount-"
+
+// getConfigMounts returns the additional ConfigMaps mounted in the server container.
+func getConfigMounts(instance *ogxiov1beta1.OGXServer) []ogxiov1beta1.ConfigMount {
+	if instance.Spec.Workload == nil {
+		return nil
+	}
+	return instance.Spec.Workload.AdditionalConfigMounts
+}
+
+// configMountVolumeName returns the volume name of the config mount at index i.
+func configMountVolumeName(i int) string {
+	return fmt.Sprintf("%s%d", configMountVolumePrefix, i)
+}
+
+// addConfigMountVolumeMounts mounts the additional config volumes read-only in the container.
+func addConfigMountVolumeMounts(instance *ogxiov1beta1.OGXServer, container *corev1.Container) {
+	for i, mount := range getConfigMounts(instance) {
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      configMountVolumeName(i),
+			MountPath: mount.MountPath,
+			ReadOnly:  true,
+		})
+	}
+}
+
+// configureConfigMounts adds a ConfigMap volume for each additional config
+// mount. Listed keys are mounted as files of the same name.
+func configureConfigMounts(instance *ogxiov1beta1.OGXServer, podSpec *corev1.PodSpec) {
+	for i, mount := range getConfigMounts(instance) {
+		var items []corev1.KeyToPath
+		for _, key := range mount.Keys {
+			items = append(items, corev1.KeyToPath{Key: key, Path: key})
+		}
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name: configMountVolumeName(i),
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: mount.Name},
+					Items:                items,
+				},
+			},
+		})
+	}
+}
+
+// getConfigMountConfigMap fetches the ConfigMap of an additional config mount.
+func (r *OGXServerReconciler) getConfigMountConfigMap(
+	ctx context.Context, instance *ogxiov1beta1.OGXServer, mount ogxiov1beta1.ConfigMount,
+) (*corev1.ConfigMap, error) {
+	// Read via direct client — user ConfigMaps lack operator labels
+	configMap := &corev1.ConfigMap{}
+	if err := r.directGet(ctx, types.NamespacedName{Name: mount.Name, Namespace: instance.Namespace}, configMap); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to find config mount ConfigMap %s/%s", instance.Namespace, mount.Name)
+		}
+		return nil, fmt.Errorf("failed to fetch config mount ConfigMap %s/%s: %w", instance.Namespace, mount.Name, err)
+	}
+	return configMap, nil
+}
+
+// validateConfigMounts checks that the additional config mounts do not use the
+// mount path of an operator-managed volume, and that their ConfigMaps exist and
+// hold the listed keys, as the pod could not start otherwise.
+func (r *OGXServerReconciler) validateConfigMounts(ctx context.Context, instance *ogxiov1beta1.OGXServer) error {
+	managedVolumes := getManagedVolumes(ctx, r, instance)
+	for i, mount := range getConfigMounts(instance) {
+		for _, managed := range managedVolumes {
+			if path.Clean(mount.MountPath) == path.Clean(managed.mountPath) {
+				msg := fmt.Sprintf("additionalConfigMounts[%d] uses the mountPath %q of operator-managed volume %q",
+					i, mount.MountPath, managed.name)
+				log.FromContext(ctx).Error(nil, msg)
+				return &terminalError{message: msg}
+			}
+		}
+	}
+
+	for _, mount := range getConfigMounts(instance) {
+		configMap, err := r.getConfigMountConfigMap(ctx, instance, mount)
+		if err != nil {
+			return err
+		}
+		var missing []string
+		for _, key := range mount.Keys {
+			_, inData := configMap.Data[key]
+			_, inBinaryData := configMap.BinaryData[key]
+			if !inData && !inBinaryData {
+				missing = append(missing, key)
+			}
+		}
+		if len(missing) > 0 {
+			return fmt.Errorf("config mount ConfigMap %s/%s is missing keys: %s",
+				instance.Namespace, mount.Name, strings.Join(missing, ", "))
+		}
+	}
+	return nil
+}
+
+// getConfigMountsHash returns a hash of the data of the additional config
+// mount ConfigMaps, so that changes to them restart the server.
+func (r *OGXServerReconciler) getConfigMountsHash(ctx context.Context, instance *ogxiov1beta1.OGXServer) (string, error) {
+	hasher := newSourceContentHasher()
+	for _, mount := range getConfigMounts(instance) {
+		configMap, err := r.getConfigMountConfigMap(ctx, instance, mount)
+		if err != nil {
+			return "", err
+		}
+		hasher.addConfigMap(configMap)
+	}
+	return hasher.sum(), nil
+}
+
+// referencesConfigMountConfigMap reports whether instance mounts the named ConfigMap.
+func referencesConfigMountConfigMap(instance *ogxiov1beta1.OGXServer, cmName, cmNamespace string) bool {
+	if cmNamespace != instance.Namespace {
+		return false
+	}
+	for _, mount := range getConfigMounts(instance) {
+		if mount.Name == cmName {
+			return true
+		}
+	}
+	return false
+}
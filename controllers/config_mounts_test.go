@@ -0,0 +1,157 @@
+package controllers
+
+import (
+	"testing"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newConfigMountsInstance() *ogxiov1beta1.OGXServer {
+	return NewOGXServerBuilder().WithName("test").WithImage("x:latest").WithConfigMounts(
+		ogxiov1beta1.ConfigMount{Name: "prompts", MountPath: "/etc/ogx/prompts"},
+		ogxiov1beta1.ConfigMount{Name: "tools", Keys: []string{"search.json", "weather.json"}, MountPath: "/etc/ogx/tools"},
+	).Build()
+}
+
+func TestConfigMountsPodSpec(t *testing.T) {
+	instance := newConfigMountsInstance()
+
+	container := buildContainerSpec(t.Context(), nil, instance, "x:latest")
+	assert.Contains(t, container.VolumeMounts, corev1.VolumeMount{
+		Name: "config-mount-0", MountPath: "/etc/ogx/prompts", ReadOnly: true,
+	})
+	assert.Contains(t, container.VolumeMounts, corev1.VolumeMount{
+		Name: "config-mount-1", MountPath: "/etc/ogx/tools", ReadOnly: true,
+	})
+
+	podSpec := configurePodStorage(t.Context(), nil, instance, container, "")
+	assert.Contains(t, podSpec.Volumes, corev1.Volume{
+		Name: "config-mount-0",
+		VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "prompts"},
+		}},
+	})
+	assert.Contains(t, podSpec.Volumes, corev1.Volume{
+		Name: "config-mount-1",
+		VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "tools"},
+			Items: []corev1.KeyToPath{
+				{Key: "search.json", Path: "search.json"},
+				{Key: "weather.json", Path: "weather.json"},
+			},
+		}},
+	})
+	require.NoError(t, validatePodVolumes(podSpec))
+}
+
+func TestConfigMountsOverrideConflicts(t *testing.T) {
+	instance := newConfigMountsInstance()
+	instance.Spec.Workload.Overrides = &ogxiov1beta1.WorkloadOverrides{
+		Volumes:      []corev1.Volume{{Name: "config-mount-1"}},
+		VolumeMounts: []corev1.VolumeMount{{Name: "extra", MountPath: "/etc/ogx/prompts/"}},
+	}
+
+	conflicts := findWorkloadOverrideConflicts(t.Context(), nil, instance)
+	assert.Equal(t, []string{
+		`volume "config-mount-1" conflicts with an operator-managed volume`,
+		`volumeMount "extra" uses the mountPath "/etc/ogx/prompts/" of operator-managed volume "config-mount-0"`,
+	}, conflicts)
+}
+
+func TestValidateConfigMounts(t *testing.T) {
+	prompts := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "prompts", Namespace: "default"},
+		Data:       map[string]string{"system.txt": "You are helpful."},
+	}
+	tests := []struct {
+		name      string
+		tools     *corev1.ConfigMap
+		wantError string
+	}{
+		{
+			name: "all keys present",
+			tools: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "tools", Namespace: "default"},
+				Data:       map[string]string{"search.json": "{}"},
+				BinaryData: map[string][]byte{"weather.json": []byte("{}")},
+			},
+		},
+		{
+			name: "missing key",
+			tools: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "tools", Namespace: "default"},
+				Data:       map[string]string{"search.json": "{}"},
+			},
+			wantError: "config mount ConfigMap default/tools is missing keys: weather.json",
+		},
+		{name: "missing ConfigMap", wantError: "failed to find config mount ConfigMap default/tools"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := fake.NewClientBuilder().WithObjects(prompts)
+			if tt.tools != nil {
+				builder = builder.WithObjects(tt.tools)
+			}
+			r := &OGXServerReconciler{Client: builder.Build()}
+
+			err := r.validateConfigMounts(t.Context(), newConfigMountsInstance())
+			if tt.wantError == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.wantError)
+		})
+	}
+}
+
+func TestConfigMountsHash(t *testing.T) {
+	prompts := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "prompts", Namespace: "default"},
+		Data:       map[string]string{"system.txt": "You are helpful."},
+	}
+	tools := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "tools", Namespace: "default"},
+		Data:       map[string]string{"search.json": "{}", "weather.json": "{}"},
+	}
+	r := &OGXServerReconciler{Client: fake.NewClientBuilder().WithObjects(prompts, tools).Build()}
+	instance := newConfigMountsInstance()
+
+	before, err := r.getConfigMountsHash(t.Context(), instance)
+	require.NoError(t, err)
+	assert.NotEmpty(t, before)
+
+	require.NoError(t, r.Get(t.Context(), types.NamespacedName{Name: "tools", Namespace: "default"}, tools))
+	tools.Data["search.json"] = `{"engine": "brave"}`
+	require.NoError(t, r.Update(t.Context(), tools))
+
+	after, err := r.getConfigMountsHash(t.Context(), instance)
+	require.NoError(t, err)
+	assert.NotEqual(t, before, after, "a changed ConfigMap must change the pod template hash")
+
+	assert.True(t, r.instanceReferencesConfigMap(instance, "tools", "default"))
+	assert.False(t, r.instanceReferencesConfigMap(instance, "tools", "other"))
+
+	empty, err := r.getConfigMountsHash(t.Context(), createTestOGX("", "x:latest"))
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+}
+
+func TestValidateConfigMountsManagedMountPaths(t *testing.T) {
+	instance := newConfigMountsInstance()
+	instance.Spec.Workload.AdditionalConfigMounts[1].MountPath = getMountPath(instance) + "/"
+	r := &OGXServerReconciler{Client: fake.NewClientBuilder().Build()}
+
+	err := r.validateConfigMounts(t.Context(), instance)
+
+	var termErr *terminalError
+	require.ErrorAs(t, err, &termErr)
+	assert.Contains(t, err.Error(), `additionalConfigMounts[1] uses the mountPath`)
+	assert.Contains(t, err.Error(), storageVolumeName)
+}
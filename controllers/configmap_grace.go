@@ -0,0 +1,96 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// DefaultConfigMapMissingGracePeriod is how long a running server is left
+// alone after its override ConfigMap disappears before reconciliation fails.
+const DefaultConfigMapMissingGracePeriod = 5 * time.Minute
+
+// errOverrideConfigMapNotFound is returned when the override ConfigMap does not exist.
+var errOverrideConfigMapNotFound = errors.New("failed to find referenced ConfigMap")
+
+// configMapMissingError signals that the override ConfigMap is missing but the
+// grace period has not elapsed. The existing deployment is kept and the
+// reconciler retries after retryAfter.
+type configMapMissingError struct {
+	message    string
+	retryAfter time.Duration
+}
+
+func (e *configMapMissingError) Error() string {
+	return e.message
+}
+
+// configMapMissingGracePeriod returns the configured grace period for a missing override ConfigMap.
+func (r *OGXServerReconciler) configMapMissingGracePeriod() time.Duration {
+	if r.ConfigMapMissingGracePeriod > 0 {
+		return r.ConfigMapMissingGracePeriod
+	}
+	return DefaultConfigMapMissingGracePeriod
+}
+
+// checkConfigMapMissingGrace turns a missing override ConfigMap into a
+// configMapMissingError while the server deployment exists and the ConfigMap
+// has been missing for less than the grace period, so that a transient
+// deletion does not take down a healthy server. The server is marked degraded
+// in the meantime. Other errors, and missing ConfigMaps past the grace period
+// or without a deployment, are returned unchanged.
+func (r *OGXServerReconciler) checkConfigMapMissingGrace(ctx context.Context, instance *ogxiov1beta1.OGXServer, err error) error {
+	key := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+	if !errors.Is(err, errOverrideConfigMapNotFound) {
+		r.missingConfigMaps.forget(key)
+		if err == nil {
+			if condition := GetCondition(&instance.Status, ConditionTypeDegraded); condition != nil &&
+				condition.Reason == ReasonConfigMapMissing {
+				SetDegradedCondition(&instance.Status, false, "")
+			}
+		}
+		return err
+	}
+
+	deployment := &appsv1.Deployment{}
+	if getErr := r.Get(ctx, key, deployment); getErr != nil {
+		if k8serrors.IsNotFound(getErr) {
+			return err
+		}
+		return fmt.Errorf("failed to fetch deployment: %w", getErr)
+	}
+
+	missingFor := r.missingConfigMaps.observe(key, true, time.Now())
+	gracePeriod := r.configMapMissingGracePeriod()
+	if missingFor >= gracePeriod {
+		log.FromContext(ctx).Info("Override ConfigMap is still missing after the grace period", "duration", missingFor)
+		return err
+	}
+
+	msg := fmt.Sprintf("%v; keeping the existing deployment for up to %s", err, gracePeriod-missingFor)
+	SetConfigMapMissingCondition(&instance.Status, msg)
+	return &configMapMissingError{message: msg, retryAfter: min(gracePeriod-missingFor, time.Minute)}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestCheckConfigMapMissingGrace(t *testing.T) {
+	key := types.NamespacedName{Name: "test", Namespace: "default"}
+	scheme := runtime.NewScheme()
+	require.NoError(t, ogxiov1beta1.AddToScheme(scheme))
+	require.NoError(t, appsv1.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	newInstance := func() *ogxiov1beta1.OGXServer {
+		return &ogxiov1beta1.OGXServer{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Spec: ogxiov1beta1.OGXServerSpec{
+				OverrideConfig: &ogxiov1beta1.ConfigMapKeyRef{Name: "override", Key: "config.yaml"},
+			},
+		}
+	}
+	newReconciler := func(objects ...client.Object) *OGXServerReconciler {
+		return &OGXServerReconciler{
+			Client:                      fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+			ConfigMapMissingGracePeriod: 5 * time.Minute,
+		}
+	}
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}
+
+	t.Run("keeps the running deployment within the grace period", func(t *testing.T) {
+		r := newReconciler(deployment.DeepCopy())
+		instance := newInstance()
+
+		err := r.checkConfigMapMissingGrace(t.Context(), instance, r.reconcileOverrideConfigMap(t.Context(), instance))
+
+		var missingErr *configMapMissingError
+		require.ErrorAs(t, err, &missingErr)
+		assert.Equal(t, time.Minute, missingErr.retryAfter)
+		condition := GetCondition(&instance.Status, ConditionTypeDegraded)
+		require.NotNil(t, condition)
+		assert.Equal(t, metav1.ConditionTrue, condition.Status)
+		assert.Equal(t, ReasonConfigMapMissing, condition.Reason)
+		assert.Contains(t, condition.Message, "default/override")
+	})
+
+	t.Run("fails once the grace period elapsed", func(t *testing.T) {
+		r := newReconciler(deployment.DeepCopy())
+		r.missingConfigMaps.observe(key, true, time.Now().Add(-time.Hour))
+		instance := newInstance()
+
+		err := r.checkConfigMapMissingGrace(t.Context(), instance, r.reconcileOverrideConfigMap(t.Context(), instance))
+
+		require.ErrorIs(t, err, errOverrideConfigMapNotFound)
+		var missingErr *configMapMissingError
+		assert.False(t, errors.As(err, &missingErr))
+	})
+
+	t.Run("fails right away without a deployment", func(t *testing.T) {
+		r := newReconciler()
+		instance := newInstance()
+
+		err := r.checkConfigMapMissingGrace(t.Context(), instance, r.reconcileOverrideConfigMap(t.Context(), instance))
+
+		require.ErrorIs(t, err, errOverrideConfigMapNotFound)
+		assert.Nil(t, GetCondition(&instance.Status, ConditionTypeDegraded))
+	})
+
+	t.Run("recovers when the ConfigMap is restored", func(t *testing.T) {
+		r := newReconciler(deployment.DeepCopy())
+		r.missingConfigMaps.observe(key, true, time.Now().Add(-time.Minute))
+		instance := newInstance()
+		SetConfigMapMissingCondition(&instance.Status, "missing")
+
+		require.NoError(t, r.checkConfigMapMissingGrace(t.Context(), instance, nil))
+
+		condition := GetCondition(&instance.Status, ConditionTypeDegraded)
+		require.NotNil(t, condition)
+		assert.Equal(t, metav1.ConditionFalse, condition.Status)
+		assert.Zero(t, r.missingConfigMaps.observe(key, true, time.Now()), "the missing time should be reset")
+	})
+
+	t.Run("other errors are returned unchanged", func(t *testing.T) {
+		r := newReconciler(deployment.DeepCopy())
+		instance := newInstance()
+		otherErr := errors.New("boom")
+
+		assert.Same(t, otherErr, r.checkConfigMapMissingGrace(t.Context(), instance, otherErr))
+	})
+}
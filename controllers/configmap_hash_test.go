@@ -0,0 +1,185 @@
+package controllers
+
+import (
+	"testing"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestConfigMapContentHash(t *testing.T) {
+	base := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "user-config", Namespace: "default", ResourceVersion: "1"},
+		Data:       map[string]string{"config.yaml": "version: 2", "extra": "a"},
+		BinaryData: map[string][]byte{"logo.png": {0x89, 0x50}},
+	}
+	hash := configMapContentHash(base)
+
+	t.Run("metadata-only changes keep the hash", func(t *testing.T) {
+		changed := base.DeepCopy()
+		changed.ResourceVersion = "2"
+		changed.Labels = map[string]string{"ogx.io/watch": "true"}
+		changed.Annotations = map[string]string{"note": "relabeled"}
+		assert.Equal(t, hash, configMapContentHash(changed))
+	})
+
+	tests := []struct {
+		name   string
+		mutate func(*corev1.ConfigMap)
+	}{
+		{name: "data value", mutate: func(cm *corev1.ConfigMap) { cm.Data["config.yaml"] = "version: 3" }},
+		{name: "data key added", mutate: func(cm *corev1.ConfigMap) { cm.Data["new"] = "" }},
+		{name: "data key removed", mutate: func(cm *corev1.ConfigMap) { delete(cm.Data, "extra") }},
+		{name: "binary data value", mutate: func(cm *corev1.ConfigMap) { cm.BinaryData["logo.png"] = []byte{0x89} }},
+		{name: "value moved between keys", mutate: func(cm *corev1.ConfigMap) {
+			cm.Data = map[string]string{"config.yaml": "version: 2a", "extra": ""}
+		}},
+		{name: "data moved to binary data", mutate: func(cm *corev1.ConfigMap) {
+			delete(cm.Data, "extra")
+			cm.BinaryData["extra"] = []byte("a")
+		}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name+" changes the hash", func(t *testing.T) {
+			changed := base.DeepCopy()
+			tc.mutate(changed)
+			assert.NotEqual(t, hash, configMapContentHash(changed))
+		})
+	}
+}
+
+func TestConfigMapHashIgnoresMetadataUpdates(t *testing.T) {
+	instance := &ogxiov1beta1.OGXServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: ogxiov1beta1.OGXServerSpec{
+			OverrideConfig: &ogxiov1beta1.ConfigMapKeyRef{Name: "user-config", Key: "config.yaml"},
+			TLS: &ogxiov1beta1.TLSClientConfig{Trust: &ogxiov1beta1.TrustConfig{
+				CACertificates: []ogxiov1beta1.ConfigMapKeyRef{{Name: "custom-ca", Key: "ca.crt"}},
+			}},
+		},
+	}
+	userConfig := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "user-config", Namespace: "default"},
+		Data:       map[string]string{"config.yaml": "version: 2"},
+	}
+	caBundle := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: getManagedCABundleConfigMapName(instance), Namespace: "default"},
+		Data:       map[string]string{DefaultCABundleKey: "-----BEGIN CERTIFICATE-----"},
+	}
+	r := &OGXServerReconciler{Client: fake.NewClientBuilder().WithObjects(userConfig, caBundle).Build()}
+
+	hashes := func() (string, string) {
+		t.Helper()
+		configHash, err := r.getConfigMapHash(t.Context(), instance)
+		require.NoError(t, err)
+		caHash, err := r.getCABundleConfigMapHash(t.Context(), instance)
+		require.NoError(t, err)
+		return configHash, caHash
+	}
+	update := func(name string, mutate func(*corev1.ConfigMap)) {
+		t.Helper()
+		configMap := &corev1.ConfigMap{}
+		require.NoError(t, r.Get(t.Context(), types.NamespacedName{Name: name, Namespace: "default"}, configMap))
+		mutate(configMap)
+		require.NoError(t, r.Update(t.Context(), configMap))
+	}
+
+	configHash, caHash := hashes()
+	require.NotEmpty(t, configHash)
+	require.NotEmpty(t, caHash)
+
+	relabel := func(cm *corev1.ConfigMap) { cm.Labels = map[string]string{"ogx.io/watch": "true"} }
+	update(userConfig.Name, relabel)
+	update(caBundle.Name, relabel)
+	newConfigHash, newCAHash := hashes()
+	assert.Equal(t, configHash, newConfigHash, "relabeling the user config must not restart the server")
+	assert.Equal(t, caHash, newCAHash, "relabeling the CA bundle must not restart the server")
+
+	update(userConfig.Name, func(cm *corev1.ConfigMap) { cm.Data["config.yaml"] = "version: 3" })
+	update(caBundle.Name, func(cm *corev1.ConfigMap) { cm.Data[DefaultCABundleKey] += "\n" })
+	newConfigHash, newCAHash = hashes()
+	assert.NotEqual(t, configHash, newConfigHash)
+	assert.NotEqual(t, caHash, newCAHash)
+}
+
+func TestSecretHashesIgnoreMetadataUpdates(t *testing.T) {
+	instance := &ogxiov1beta1.OGXServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: ogxiov1beta1.OGXServerSpec{
+			Workload: &ogxiov1beta1.WorkloadSpec{
+				ProviderSecrets: []ogxiov1beta1.ProviderSecretRef{{
+					EnvVar:       "API_KEY",
+					SecretKeyRef: ogxiov1beta1.SecretKeyRef{Name: "provider-keys", Key: "api-key"},
+				}},
+				Overrides: &ogxiov1beta1.WorkloadOverrides{Env: []corev1.EnvVar{{
+					Name: "MODE",
+					ValueFrom: &corev1.EnvVarSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "settings"},
+						Key:                  "mode",
+					}},
+				}}},
+			},
+			TLS: &ogxiov1beta1.TLSClientConfig{Trust: &ogxiov1beta1.TrustConfig{
+				CACertificateSecrets: []ogxiov1beta1.SecretKeyRef{{Name: "ca-secret", Key: "ca.crt"}},
+			}},
+		},
+	}
+	providerKeys := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "provider-keys", Namespace: "default"},
+		Data:       map[string][]byte{"api-key": []byte("secret")},
+	}
+	settings := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "default"},
+		Data:       map[string]string{"mode": "fast"},
+	}
+	caSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "ca-secret", Namespace: "default"},
+		Data:       map[string][]byte{"ca.crt": []byte("-----BEGIN CERTIFICATE-----")},
+	}
+	r := &OGXServerReconciler{Client: fake.NewClientBuilder().WithObjects(providerKeys, settings, caSecret).Build()}
+
+	hashes := func() (string, string) {
+		t.Helper()
+		envHash, err := r.getEnvSourceHash(t.Context(), instance)
+		require.NoError(t, err)
+		caHash, err := r.getCABundleSecretHash(t.Context(), instance)
+		require.NoError(t, err)
+		return envHash, caHash
+	}
+	updateSecret := func(name string, mutate func(*corev1.Secret)) {
+		t.Helper()
+		secret := &corev1.Secret{}
+		require.NoError(t, r.Get(t.Context(), types.NamespacedName{Name: name, Namespace: "default"}, secret))
+		mutate(secret)
+		require.NoError(t, r.Update(t.Context(), secret))
+	}
+
+	envHash, caHash := hashes()
+	require.NotEmpty(t, envHash)
+	require.NotEmpty(t, caHash)
+
+	relabel := func(s *corev1.Secret) { s.Labels = map[string]string{"ogx.io/watch": "true"} }
+	updateSecret(providerKeys.Name, relabel)
+	updateSecret(caSecret.Name, relabel)
+	newEnvHash, newCAHash := hashes()
+	assert.Equal(t, envHash, newEnvHash, "relabeling a provider Secret must not restart the server")
+	assert.Equal(t, caHash, newCAHash, "relabeling a CA Secret must not restart the server")
+
+	updateSecret(providerKeys.Name, func(s *corev1.Secret) { s.Data["api-key"] = []byte("rotated") })
+	updateSecret(caSecret.Name, func(s *corev1.Secret) { s.Data["ca.crt"] = append(s.Data["ca.crt"], '\n') })
+	newEnvHash, newCAHash = hashes()
+	assert.NotEqual(t, envHash, newEnvHash)
+	assert.NotEqual(t, caHash, newCAHash)
+
+	configMap := &corev1.ConfigMap{}
+	require.NoError(t, r.Get(t.Context(), types.NamespacedName{Name: settings.Name, Namespace: "default"}, configMap))
+	configMap.Data["mode"] = "slow"
+	require.NoError(t, r.Update(t.Context(), configMap))
+	changedEnvHash, _ := hashes()
+	assert.NotEqual(t, newEnvHash, changedEnvHash, "editing an env ConfigMap must restart the server")
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"slices"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	sigsyaml "sigs.k8s.io/yaml"
+)
+
+// DistributionDefaults are container defaults for servers of one distribution.
+type DistributionDefaults struct {
+	// Resources fill in requests and limits the OGXServer does not set. They
+	// take precedence over the operator-wide default-resources.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// Env is added to the server container. Operator-managed variables and
+	// the OGXServer's own env take precedence.
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// Command starts the server when an override config is used, instead of
+	// the startup script detecting the server version with Python. Arguments
+	// can reference $(OGX_CONFIG), $(OGX_PORT) and $(OGX_WORKERS).
+	Command []string `json:"command,omitempty"`
+}
+
+// ParseDistributionDefaults parses the distribution-defaults key of the
+// operator config ConfigMap into defaults keyed by distribution name.
+// Distributions with invalid resources or empty command arguments are skipped.
+func ParseDistributionDefaults(ctx context.Context, configMapData map[string]string) map[string]DistributionDefaults {
+	logger := log.FromContext(ctx)
+
+	defaultsYAML, exists := configMapData["distribution-defaults"]
+	if !exists {
+		return nil
+	}
+
+	var defaults map[string]DistributionDefaults
+	if err := sigsyaml.UnmarshalStrict([]byte(defaultsYAML), &defaults); err != nil {
+		// Log error but continue without distribution defaults
+		logger.V(1).Info("failed to parse distribution-defaults YAML", "error", err)
+		return nil
+	}
+
+	for name, entry := range defaults {
+		if slices.Contains(entry.Command, "") {
+			logger.V(1).Info("ignoring distribution-defaults entry with an empty command argument", "distribution", name)
+			delete(defaults, name)
+			continue
+		}
+		if entry.Resources == nil {
+			continue
+		}
+		if err := validateResources(*entry.Resources); err != nil {
+			logger.V(1).Info("ignoring invalid distribution-defaults entry", "distribution", name, "error", err)
+			delete(defaults, name)
+		}
+	}
+	return defaults
+}
+
+// getDistributionDefaults returns the defaults of the instance's distribution, if any.
+func getDistributionDefaults(ctx context.Context, r *OGXServerReconciler, instance *ogxiov1beta1.OGXServer) *DistributionDefaults {
+	if r == nil || instance.Spec.Distribution.Name == "" {
+		return nil
+	}
+	defaults, ok := r.operatorSettings(ctx).distributionDefaults[instance.Spec.Distribution.Name]
+	if !ok {
+		return nil
+	}
+	return &defaults
+}
+
+// mergeDefaultResources overlays the distribution resources on the
+// operator-wide defaults, without modifying either.
+func mergeDefaultResources(operatorDefaults, distributionDefaults *corev1.ResourceRequirements) *corev1.ResourceRequirements {
+	if distributionDefaults == nil {
+		return operatorDefaults
+	}
+	if operatorDefaults == nil {
+		return distributionDefaults
+	}
+	merged := operatorDefaults.DeepCopy()
+	if len(distributionDefaults.Requests) > 0 && merged.Requests == nil {
+		merged.Requests = corev1.ResourceList{}
+	}
+	for name, quantity := range distributionDefaults.Requests {
+		merged.Requests[name] = quantity.DeepCopy()
+	}
+	if len(distributionDefaults.Limits) > 0 && merged.Limits == nil {
+		merged.Limits = corev1.ResourceList{}
+	}
+	for name, quantity := range distributionDefaults.Limits {
+		merged.Limits[name] = quantity.DeepCopy()
+	}
+	return merged
+}
+
+// appendDistributionEnv adds the distribution env vars that do not collide
+// with variables the container already defines.
+func appendDistributionEnv(env []corev1.EnvVar, defaults *DistributionDefaults) []corev1.EnvVar {
+	if defaults == nil {
+		return env
+	}
+	defined := make(map[string]struct{}, len(env))
+	for _, e := range env {
+		defined[e.Name] = struct{}{}
+	}
+	for _, e := range defaults.Env {
+		if _, ok := defined[e.Name]; ok {
+			continue
+		}
+		env = append(env, *e.DeepCopy())
+	}
+	return env
+}
@@ -0,0 +1,203 @@
+package controllers
+
+import (
+	"testing"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestParseDistributionDefaults(t *testing.T) {
+	t.Run("parses resources and env per distribution", func(t *testing.T) {
+		defaults := ParseDistributionDefaults(t.Context(), map[string]string{
+			"distribution-defaults": `
+gpu:
+  resources:
+    requests:
+      memory: 16Gi
+    limits:
+      nvidia.com/gpu: "1"
+  env:
+  - name: VLLM_MAX_TOKENS
+    value: "4096"
+starter:
+  env:
+  - name: OLLAMA_URL
+    value: http://ollama:11434
+`,
+		})
+		require.Len(t, defaults, 2)
+		require.NotNil(t, defaults["gpu"].Resources)
+		assert.Equal(t, resource.MustParse("16Gi"), defaults["gpu"].Resources.Requests[corev1.ResourceMemory])
+		assert.Equal(t, resource.MustParse("1"), defaults["gpu"].Resources.Limits["nvidia.com/gpu"])
+		assert.Equal(t, []corev1.EnvVar{{Name: "VLLM_MAX_TOKENS", Value: "4096"}}, defaults["gpu"].Env)
+		assert.Nil(t, defaults["starter"].Resources)
+	})
+
+	t.Run("absent key", func(t *testing.T) {
+		assert.Nil(t, ParseDistributionDefaults(t.Context(), map[string]string{}))
+	})
+
+	t.Run("invalid YAML", func(t *testing.T) {
+		assert.Nil(t, ParseDistributionDefaults(t.Context(), map[string]string{
+			"distribution-defaults": "gpu: [",
+		}))
+	})
+
+	t.Run("skips distributions with limits below requests", func(t *testing.T) {
+		defaults := ParseDistributionDefaults(t.Context(), map[string]string{
+			"distribution-defaults": `
+gpu:
+  resources:
+    requests:
+      memory: 16Gi
+    limits:
+      memory: 8Gi
+starter:
+  env:
+  - name: A
+    value: b
+`,
+		})
+		assert.NotContains(t, defaults, "gpu")
+		assert.Contains(t, defaults, "starter")
+	})
+
+	t.Run("parses the command", func(t *testing.T) {
+		defaults := ParseDistributionDefaults(t.Context(), map[string]string{
+			"distribution-defaults": `
+node:
+  command: ["node", "server.js", "--config", "$(OGX_CONFIG)"]
+broken:
+  command: ["node", ""]
+`,
+		})
+		assert.Equal(t, []string{"node", "server.js", "--config", "$(OGX_CONFIG)"}, defaults["node"].Command)
+		assert.NotContains(t, defaults, "broken", "empty command arguments are rejected")
+	})
+}
+
+func TestConfigureContainerCommandsDistributionDefaults(t *testing.T) {
+	command := []string{"uvicorn", "ogx.core.server.server:create_app", "--factory"}
+	r := &OGXServerReconciler{
+		DistributionDefaults: map[string]DistributionDefaults{"starter": {Command: command}},
+	}
+	newInstance := func(name, version string) *ogxiov1beta1.OGXServer {
+		return &ogxiov1beta1.OGXServer{
+			Spec: ogxiov1beta1.OGXServerSpec{
+				Distribution:   ogxiov1beta1.DistributionSpec{Name: name, Version: version},
+				OverrideConfig: &ogxiov1beta1.ConfigMapKeyRef{Name: "user-config", Key: "config.yaml"},
+			},
+		}
+	}
+
+	t.Run("bypasses the detection script for mapped distributions", func(t *testing.T) {
+		container := corev1.Container{Args: []string{"--from-image"}}
+		configureContainerCommands(t.Context(), r, newInstance("starter", ""), &container)
+		assert.Equal(t, command, container.Command)
+		assert.Empty(t, container.Args)
+		assert.NotContains(t, container.Command, startupScript)
+
+		container.Command[0] = "changed"
+		assert.Equal(t, "uvicorn", r.DistributionDefaults["starter"].Command[0], "the defaults must not be mutated")
+	})
+
+	t.Run("unmapped distributions keep the detection script", func(t *testing.T) {
+		container := corev1.Container{}
+		configureContainerCommands(t.Context(), r, newInstance("remote-vllm", ""), &container)
+		assert.Equal(t, []string{"/bin/sh", "-c", startupScript}, container.Command)
+	})
+
+	t.Run("a declared version takes precedence", func(t *testing.T) {
+		container := corev1.Container{}
+		configureContainerCommands(t.Context(), r, newInstance("starter", "0.2.0"), &container)
+		assert.Equal(t, serverCommand("0.2.0"), container.Command)
+	})
+
+	t.Run("the image entrypoint is kept without an override config", func(t *testing.T) {
+		instance := newInstance("starter", "")
+		instance.Spec.OverrideConfig = nil
+		container := corev1.Container{}
+		configureContainerCommands(t.Context(), r, instance, &container)
+		assert.Nil(t, container.Command)
+	})
+}
+
+func TestBuildContainerSpecDistributionDefaults(t *testing.T) {
+	r := &OGXServerReconciler{
+		Client: fake.NewClientBuilder().Build(),
+		DefaultResources: &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("500m"),
+				corev1.ResourceMemory: resource.MustParse("2Gi"),
+			},
+		},
+		DistributionDefaults: map[string]DistributionDefaults{
+			"gpu": {
+				Resources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("16Gi")},
+					Limits:   corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")},
+				},
+				Env: []corev1.EnvVar{
+					{Name: "VLLM_MAX_TOKENS", Value: "4096"},
+					{Name: "OGX_PORT", Value: "1234"},
+				},
+			},
+		},
+	}
+	newInstance := func(name string, workload *ogxiov1beta1.WorkloadSpec) *ogxiov1beta1.OGXServer {
+		return &ogxiov1beta1.OGXServer{
+			Spec: ogxiov1beta1.OGXServerSpec{
+				Distribution: ogxiov1beta1.DistributionSpec{Name: name},
+				Workload:     workload,
+			},
+		}
+	}
+	envValues := func(c corev1.Container, name string) []string {
+		var values []string
+		for _, e := range c.Env {
+			if e.Name == name {
+				values = append(values, e.Value)
+			}
+		}
+		return values
+	}
+
+	t.Run("applies the distribution defaults", func(t *testing.T) {
+		c := buildContainerSpec(t.Context(), r, newInstance("gpu", nil), "gpu-image:latest")
+		assert.Equal(t, resource.MustParse("500m"), c.Resources.Requests[corev1.ResourceCPU])
+		assert.Equal(t, resource.MustParse("16Gi"), c.Resources.Requests[corev1.ResourceMemory])
+		assert.Equal(t, resource.MustParse("1"), c.Resources.Limits["nvidia.com/gpu"])
+		assert.Equal(t, []string{"4096"}, envValues(c, "VLLM_MAX_TOKENS"))
+		assert.Equal(t, []string{"8321"}, envValues(c, "OGX_PORT"), "operator-managed env must not be overridden")
+		assert.Equal(t, resource.MustParse("2Gi"), r.DefaultResources.Requests[corev1.ResourceMemory],
+			"the operator defaults must not be mutated")
+	})
+
+	t.Run("the OGXServer takes precedence", func(t *testing.T) {
+		instance := newInstance("gpu", &ogxiov1beta1.WorkloadSpec{
+			Resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("32Gi")},
+			},
+			Overrides: &ogxiov1beta1.WorkloadOverrides{
+				Env: []corev1.EnvVar{{Name: "VLLM_MAX_TOKENS", Value: "8192"}},
+			},
+		})
+		c := buildContainerSpec(t.Context(), r, instance, "gpu-image:latest")
+		assert.Equal(t, resource.MustParse("32Gi"), c.Resources.Requests[corev1.ResourceMemory])
+		values := envValues(c, "VLLM_MAX_TOKENS")
+		require.NotEmpty(t, values)
+		assert.Equal(t, "8192", values[len(values)-1], "the last definition of a variable wins")
+	})
+
+	t.Run("other distributions only get the operator defaults", func(t *testing.T) {
+		c := buildContainerSpec(t.Context(), r, newInstance("starter", nil), "starter-image:latest")
+		assert.Equal(t, resource.MustParse("2Gi"), c.Resources.Requests[corev1.ResourceMemory])
+		assert.NotContains(t, c.Resources.Limits, corev1.ResourceName("nvidia.com/gpu"))
+		assert.Empty(t, envValues(c, "VLLM_MAX_TOKENS"))
+	})
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// imageDigestResolver resolves an image reference to the digest of its manifest,
+// authenticating with the credentials keychain returns for the registry.
+type imageDigestResolver interface {
+	ResolveDigest(ctx context.Context, image string, keychain authn.Keychain) (string, error)
+}
+
+// registryDigestResolver resolves digests with a HEAD request to the registry.
+type registryDigestResolver struct {
+	transport http.RoundTripper
+	timeout   time.Duration
+}
+
+// ResolveDigest returns the digest the tag of image currently points to.
+func (d registryDigestResolver) ResolveDigest(ctx context.Context, image string, keychain authn.Keychain) (string, error) {
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse image reference %s: %w", image, err)
+	}
+	if d.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.timeout)
+		defer cancel()
+	}
+	desc, err := remote.Head(ref, remote.WithContext(ctx), remote.WithTransport(d.transport),
+		remote.WithAuthFromKeychain(keychain))
+	if err != nil {
+		return "", fmt.Errorf("failed to query registry for image %s: %w", image, err)
+	}
+	return desc.Digest.String(), nil
+}
+
+// digestResolver returns the resolver used to pin images, by default a
+// registry resolver sharing the transport and timeout of the operator's client.
+func (r *OGXServerReconciler) digestResolver() imageDigestResolver {
+	if r.imageDigestResolver != nil {
+		return r.imageDigestResolver
+	}
+	resolver := registryDigestResolver{transport: http.DefaultTransport}
+	if r.httpClient != nil {
+		if r.httpClient.Transport != nil {
+			resolver.transport = r.httpClient.Transport
+		}
+		resolver.timeout = r.httpClient.Timeout
+	}
+	return resolver
+}
+
+// pinImage returns the image the Deployment runs and records it in the status.
+// With PinImageDigests, tag references are pinned to the digest they point to.
+// The pinned image is kept until the configured image changes, so a moved tag
+// does not roll the Deployment. Tags that stay unpinned are reported on the
+// MutableImageTag condition.
+func (r *OGXServerReconciler) pinImage(ctx context.Context, instance *ogxiov1beta1.OGXServer, image string) string {
+	pinned := image
+	defer func() {
+		if instance.Status.ResolvedDistribution == nil {
+			instance.Status.ResolvedDistribution = &ogxiov1beta1.ResolvedDistributionStatus{}
+		}
+		instance.Status.ResolvedDistribution.Image = pinned
+	}()
+
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		// Invalid references surface when the Deployment is applied
+		return pinned
+	}
+	if _, ok := ref.(name.Digest); ok {
+		SetMutableImageTagCondition(&instance.Status, false, ReasonImageDigestReference, MessageImageDigestReference)
+		return pinned
+	}
+	if !r.PinImageDigests {
+		SetMutableImageTagCondition(&instance.Status, true, ReasonMutableImageTag,
+			fmt.Sprintf("Image %s uses a mutable tag and is not pinned to a digest", image))
+		return pinned
+	}
+
+	if resolved := instance.Status.ResolvedDistribution; resolved != nil && strings.HasPrefix(resolved.Image, image+"@") {
+		pinned = resolved.Image
+	} else {
+		digest, err := r.resolveImageDigest(ctx, instance, image)
+		if err != nil {
+			log.FromContext(ctx).Info("failed to pin image to a digest, using the tag", "image", image, "error", err)
+			SetMutableImageTagCondition(&instance.Status, true, ReasonImageDigestResolutionFailed,
+				fmt.Sprintf("Failed to pin image %s to a digest: %v", image, err))
+			return pinned
+		}
+		pinned = image + "@" + digest
+	}
+	SetMutableImageTagCondition(&instance.Status, false, ReasonImageDigestPinned,
+		fmt.Sprintf("Image %s is pinned to %s", image, strings.TrimPrefix(pinned, image+"@")))
+	return pinned
+}
+
+// resolveImageDigest resolves the digest of image with the credentials of the
+// image pull Secrets of instance, the same ones the kubelet pulls it with.
+func (r *OGXServerReconciler) resolveImageDigest(ctx context.Context, instance *ogxiov1beta1.OGXServer, image string) (string, error) {
+	keychain, err := r.pullSecretKeychain(ctx, instance)
+	if err != nil {
+		return "", err
+	}
+	return r.digestResolver().ResolveDigest(ctx, image, keychain)
+}
+
+// pullSecretKeychain returns the registry credentials of the image pull Secrets
+// of instance. Missing Secrets are skipped, as the kubelet skips them too.
+// Registries without credentials are queried anonymously.
+func (r *OGXServerReconciler) pullSecretKeychain(ctx context.Context, instance *ogxiov1beta1.OGXServer) (pullSecretKeychain, error) {
+	var keychain pullSecretKeychain
+	if instance.Spec.Workload == nil {
+		return keychain, nil
+	}
+
+	for _, ref := range instance.Spec.Workload.ImagePullSecrets {
+		secret := &corev1.Secret{}
+		err := r.directGet(ctx, types.NamespacedName{Name: ref.Name, Namespace: instance.Namespace}, secret)
+		if k8serrors.IsNotFound(err) {
+			log.FromContext(ctx).V(1).Info("image pull Secret not found, skipping", "secret", ref.Name)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get image pull Secret %s/%s: %w", instance.Namespace, ref.Name, err)
+		}
+		entries, err := parsePullSecret(secret)
+		if err != nil {
+			return nil, err
+		}
+		keychain = append(keychain, entries...)
+	}
+	return keychain, nil
+}
+
+// pullSecretEntry holds the credentials of one registry of an image pull Secret.
+type pullSecretEntry struct {
+	// registry is the registry host, optionally followed by a repository path prefix.
+	registry string
+	config   authn.AuthConfig
+}
+
+// pullSecretKeychain is an authn.Keychain over image pull Secret entries.
+// The first entry matching the registry, and the repository path prefix if
+// it has one, provides the credentials.
+type pullSecretKeychain []pullSecretEntry
+
+// Resolve implements authn.Keychain.
+func (k pullSecretKeychain) Resolve(target authn.Resource) (authn.Authenticator, error) {
+	for _, entry := range k {
+		host, prefix, _ := strings.Cut(entry.registry, "/")
+		if host != target.RegistryStr() {
+			continue
+		}
+		if prefix != "" && !strings.HasPrefix(target.String()+"/", host+"/"+strings.TrimSuffix(prefix, "/")+"/") {
+			continue
+		}
+		return authn.FromConfig(entry.config), nil
+	}
+	return authn.Anonymous, nil
+}
+
+// parsePullSecret returns the registry entries of a kubernetes.io/dockerconfigjson
+// or kubernetes.io/dockercfg Secret.
+func parsePullSecret(secret *corev1.Secret) ([]pullSecretEntry, error) {
+	var auths map[string]authn.AuthConfig
+	switch {
+	case secret.Type == corev1.SecretTypeDockerConfigJson || len(secret.Data[corev1.DockerConfigJsonKey]) > 0:
+		var config struct {
+			Auths map[string]authn.AuthConfig `json:"auths"`
+		}
+		if err := json.Unmarshal(secret.Data[corev1.DockerConfigJsonKey], &config); err != nil {
+			return nil, fmt.Errorf("failed to parse image pull Secret %s/%s: %w", secret.Namespace, secret.Name, err)
+		}
+		auths = config.Auths
+	case len(secret.Data[corev1.DockerConfigKey]) > 0:
+		if err := json.Unmarshal(secret.Data[corev1.DockerConfigKey], &auths); err != nil {
+			return nil, fmt.Errorf("failed to parse image pull Secret %s/%s: %w", secret.Namespace, secret.Name, err)
+		}
+	}
+
+	entries := make([]pullSecretEntry, 0, len(auths))
+	for key, config := range auths {
+		if registry := normalizePullSecretRegistry(key); registry != "" {
+			entries = append(entries, pullSecretEntry{registry: registry, config: config})
+		}
+	}
+	// Longer paths are more specific, so they are matched first.
+	slices.SortFunc(entries, func(a, b pullSecretEntry) int {
+		return cmp.Or(cmp.Compare(len(b.registry), len(a.registry)), cmp.Compare(a.registry, b.registry))
+	})
+	return entries, nil
+}
+
+// normalizePullSecretRegistry turns a docker config key such as
+// "https://index.docker.io/v1/" or "quay.io/org" into the registry host, as
+// authn.Resource reports it, followed by any repository path prefix.
+func normalizePullSecretRegistry(key string) string {
+	key = strings.TrimPrefix(strings.TrimPrefix(key, "https://"), "http://")
+	host, prefix, _ := strings.Cut(strings.TrimSuffix(key, "/"), "/")
+	registry, err := name.NewRegistry(host)
+	if err != nil {
+		return ""
+	}
+	if registry.RegistryStr() == name.DefaultRegistry || prefix == "" {
+		// Docker Hub keys carry the API version as their path
+		return registry.RegistryStr()
+	}
+	return registry.RegistryStr() + "/" + prefix
+}
@@ -0,0 +1,240 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testImageDigest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+// fakeDigestResolver returns a fixed digest or error and counts lookups.
+type fakeDigestResolver struct {
+	digest string
+	err    error
+	calls  []string
+}
+
+func (f *fakeDigestResolver) ResolveDigest(_ context.Context, image string, _ authn.Keychain) (string, error) {
+	f.calls = append(f.calls, image)
+	return f.digest, f.err
+}
+
+func TestPinImage(t *testing.T) {
+	const image = "quay.io/ogx/distribution-starter:latest"
+
+	tests := []struct {
+		name          string
+		pin           bool
+		image         string
+		resolverErr   error
+		statusImage   string
+		wantImage     string
+		wantLookups   int
+		wantCondition metav1.ConditionStatus
+		wantReason    string
+	}{
+		{
+			name:          "reports an unpinned tag",
+			image:         image,
+			wantImage:     image,
+			wantCondition: metav1.ConditionTrue,
+			wantReason:    ReasonMutableImageTag,
+		},
+		{
+			name:          "pins a tag to its digest",
+			pin:           true,
+			image:         image,
+			wantImage:     image + "@" + testImageDigest,
+			wantLookups:   1,
+			wantCondition: metav1.ConditionFalse,
+			wantReason:    ReasonImageDigestPinned,
+		},
+		{
+			name:          "keeps the pinned digest of the same image",
+			pin:           true,
+			image:         image,
+			statusImage:   image + "@sha256:" + "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+			wantImage:     image + "@sha256:" + "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+			wantCondition: metav1.ConditionFalse,
+			wantReason:    ReasonImageDigestPinned,
+		},
+		{
+			name:          "re-pins when the image changes",
+			pin:           true,
+			image:         image,
+			statusImage:   "quay.io/ogx/distribution-starter:0.3.0@" + testImageDigest,
+			wantImage:     image + "@" + testImageDigest,
+			wantLookups:   1,
+			wantCondition: metav1.ConditionFalse,
+			wantReason:    ReasonImageDigestPinned,
+		},
+		{
+			name:          "falls back to the tag when the registry fails",
+			pin:           true,
+			image:         image,
+			resolverErr:   errors.New("registry unavailable"),
+			wantImage:     image,
+			wantLookups:   1,
+			wantCondition: metav1.ConditionTrue,
+			wantReason:    ReasonImageDigestResolutionFailed,
+		},
+		{
+			name:          "leaves digest references alone",
+			pin:           true,
+			image:         "quay.io/ogx/distribution-starter@" + testImageDigest,
+			wantImage:     "quay.io/ogx/distribution-starter@" + testImageDigest,
+			wantCondition: metav1.ConditionFalse,
+			wantReason:    ReasonImageDigestReference,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver := &fakeDigestResolver{digest: testImageDigest, err: tt.resolverErr}
+			r := &OGXServerReconciler{PinImageDigests: tt.pin, imageDigestResolver: resolver}
+			instance := &ogxiov1beta1.OGXServer{}
+			if tt.statusImage != "" {
+				instance.Status.ResolvedDistribution = &ogxiov1beta1.ResolvedDistributionStatus{Image: tt.statusImage}
+			}
+
+			got := r.pinImage(t.Context(), instance, tt.image)
+
+			assert.Equal(t, tt.wantImage, got)
+			assert.Len(t, resolver.calls, tt.wantLookups)
+			require.NotNil(t, instance.Status.ResolvedDistribution)
+			assert.Equal(t, tt.wantImage, instance.Status.ResolvedDistribution.Image)
+			condition := GetCondition(&instance.Status, ConditionTypeMutableImageTag)
+			require.NotNil(t, condition)
+			assert.Equal(t, tt.wantCondition, condition.Status)
+			assert.Equal(t, tt.wantReason, condition.Reason)
+		})
+	}
+}
+
+func TestRegistryDigestResolver(t *testing.T) {
+	server := httptest.NewServer(registry.New())
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	image := serverURL.Host + "/ogx/distribution-starter:latest"
+	ref, err := name.ParseReference(image)
+	require.NoError(t, err)
+	img, err := random.Image(64, 1)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img))
+	want, err := img.Digest()
+	require.NoError(t, err)
+
+	r := &OGXServerReconciler{}
+	got, err := r.digestResolver().ResolveDigest(t.Context(), image, pullSecretKeychain(nil))
+	require.NoError(t, err)
+	assert.Equal(t, want.String(), got)
+
+	_, err = r.digestResolver().ResolveDigest(t.Context(), serverURL.Host+"/ogx/missing:latest", pullSecretKeychain(nil))
+	require.Error(t, err)
+}
+
+func TestResolveImageDigestWithPullSecrets(t *testing.T) {
+	registryHandler := registry.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if user, password, ok := req.BasicAuth(); !ok || user != "robot" || password != "s3cret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		registryHandler.ServeHTTP(w, req)
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	image := serverURL.Host + "/ogx/private:latest"
+	ref, err := name.ParseReference(image)
+	require.NoError(t, err)
+	img, err := random.Image(64, 1)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img, remote.WithAuth(&authn.Basic{Username: "robot", Password: "s3cret"})))
+	want, err := img.Digest()
+	require.NoError(t, err)
+
+	pullSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "registry-credentials", Namespace: "default"},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{corev1.DockerConfigJsonKey: []byte(
+			`{"auths":{"http://` + serverURL.Host + `/ogx":{"username":"robot","password":"s3cret"}}}`)},
+	}
+	r := &OGXServerReconciler{Client: fake.NewClientBuilder().WithObjects(pullSecret).Build()}
+	instance := &ogxiov1beta1.OGXServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: ogxiov1beta1.OGXServerSpec{Workload: &ogxiov1beta1.WorkloadSpec{
+			ImagePullSecrets: []corev1.LocalObjectReference{{Name: "missing"}, {Name: pullSecret.Name}},
+		}},
+	}
+
+	got, err := r.resolveImageDigest(t.Context(), instance, image)
+	require.NoError(t, err)
+	assert.Equal(t, want.String(), got)
+
+	instance.Spec.Workload.ImagePullSecrets = nil
+	_, err = r.resolveImageDigest(t.Context(), instance, image)
+	require.Error(t, err, "the private image should not resolve without the pull Secret")
+}
+
+func TestPullSecretKeychain(t *testing.T) {
+	keychain := pullSecretKeychain{}
+	for _, secret := range []*corev1.Secret{
+		{
+			Type: corev1.SecretTypeDockerConfigJson,
+			Data: map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{
+				"https://index.docker.io/v1/":{"username":"hub","password":"hub"},
+				"quay.io":{"auth":"cXVheTpxdWF5"},
+				"quay.io/ogx":{"username":"ogx","password":"ogx"}}}`)},
+		},
+		{
+			Type: corev1.SecretTypeDockercfg,
+			Data: map[string][]byte{corev1.DockerConfigKey: []byte(`{"registry.example.com:5000":{"username":"cfg","password":"cfg"}}`)},
+		},
+	} {
+		entries, err := parsePullSecret(secret)
+		require.NoError(t, err)
+		keychain = append(keychain, entries...)
+	}
+
+	tests := []struct {
+		image    string
+		wantUser string
+	}{
+		{image: "docker.io/library/busybox", wantUser: "hub"},
+		{image: "quay.io/ogx/distribution-starter", wantUser: "ogx"},
+		{image: "quay.io/ogxother/distribution-starter", wantUser: "quay"},
+		{image: "registry.example.com:5000/ogx/starter", wantUser: "cfg"},
+		{image: "ghcr.io/ogx/starter", wantUser: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.image, func(t *testing.T) {
+			repo, err := name.NewRepository(tt.image)
+			require.NoError(t, err)
+			authenticator, err := keychain.Resolve(repo)
+			require.NoError(t, err)
+			config, err := authenticator.Authorization()
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantUser, config.Username)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"gopkg.in/yaml.v3"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// ImageOverrideRejection is an entry of the image-overrides key that the
+// operator did not apply.
+type ImageOverrideRejection struct {
+	// Distribution is the key of the entry, or empty when the whole value
+	// failed to parse.
+	Distribution string
+	// Image is the rejected image reference.
+	Image string
+	// Reason describes why the entry was rejected.
+	Reason string
+}
+
+func (r ImageOverrideRejection) String() string {
+	if r.Distribution == "" {
+		return r.Reason
+	}
+	return fmt.Sprintf("%s: %s", r.Distribution, r.Reason)
+}
+
+// ParseImageMappingOverrides parses the image-overrides key of the operator
+// config ConfigMap. Invalid entries are skipped.
+func ParseImageMappingOverrides(ctx context.Context, configMapData map[string]string) map[string]string {
+	overrides, rejections := parseImageMappingOverrides(configMapData)
+	for _, rejection := range rejections {
+		log.FromContext(ctx).V(1).Info("skipping invalid image override",
+			"distribution", rejection.Distribution, "image", rejection.Image, "reason", rejection.Reason)
+	}
+	return overrides
+}
+
+// parseImageMappingOverrides returns the valid entries of the image-overrides
+// key and the rejected ones, sorted by distribution.
+func parseImageMappingOverrides(configMapData map[string]string) (map[string]string, []ImageOverrideRejection) {
+	imageMappingOverrides := make(map[string]string)
+
+	overridesYAML, exists := configMapData["image-overrides"]
+	if !exists {
+		return imageMappingOverrides, nil
+	}
+
+	var overrides map[string]string
+	if err := yaml.Unmarshal([]byte(overridesYAML), &overrides); err != nil {
+		return imageMappingOverrides, []ImageOverrideRejection{
+			{Reason: fmt.Sprintf("failed to parse image-overrides YAML: %v", err)},
+		}
+	}
+
+	var rejections []ImageOverrideRejection
+	for distribution, image := range overrides {
+		if _, err := name.ParseReference(image); err != nil {
+			rejections = append(rejections, ImageOverrideRejection{
+				Distribution: distribution, Image: image, Reason: err.Error(),
+			})
+			continue
+		}
+		imageMappingOverrides[distribution] = image
+	}
+	slices.SortFunc(rejections, func(a, b ImageOverrideRejection) int {
+		return strings.Compare(a.Distribution, b.Distribution)
+	})
+	return imageMappingOverrides, rejections
+}
+
+// setImageMappingOverrides applies the image-overrides key of the operator
+// config and publishes how many entries were accepted and rejected. Rejected
+// entries are logged whenever they change.
+func (r *OGXServerReconciler) setImageMappingOverrides(ctx context.Context, configMapData map[string]string) {
+	overrides, rejections := parseImageMappingOverrides(configMapData)
+	imageOverridesAccepted.Set(float64(len(overrides)))
+	imageOverridesRejected.Set(float64(len(rejections)))
+
+	if !slices.Equal(rejections, r.imageOverrideRejections) && len(rejections) > 0 {
+		logger := log.FromContext(ctx)
+		for _, rejection := range rejections {
+			logger.Info("rejected image override",
+				"distribution", rejection.Distribution, "image", rejection.Image, "reason", rejection.Reason)
+		}
+		logger.Info("image overrides loaded with rejected entries",
+			"accepted", len(overrides), "rejected", len(rejections))
+	}
+
+	r.ImageMappingOverrides = overrides
+	r.imageOverrideRejections = rejections
+}
+
+// ValidateImageOverrides returns an error listing the image-overrides entries
+// that were rejected, for operators that must not run with dropped overrides.
+func (r *OGXServerReconciler) ValidateImageOverrides() error {
+	if len(r.imageOverrideRejections) == 0 {
+		return nil
+	}
+	reasons := make([]string, 0, len(r.imageOverrideRejections))
+	for _, rejection := range r.imageOverrideRejections {
+		reasons = append(reasons, rejection.String())
+	}
+	return errors.New("invalid image overrides: " + strings.Join(reasons, "; "))
+}
@@ -0,0 +1,68 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseImageMappingOverridesRejections(t *testing.T) {
+	t.Run("reports invalid entries sorted by distribution", func(t *testing.T) {
+		overrides, rejections := parseImageMappingOverrides(map[string]string{
+			"image-overrides": `
+starter: quay.io/valid/image:tag
+zeta: not a valid image reference!!!
+alpha: UPPERCASE/INVALID:IMAGE
+`,
+		})
+
+		assert.Equal(t, map[string]string{"starter": "quay.io/valid/image:tag"}, overrides)
+		require.Len(t, rejections, 2)
+		assert.Equal(t, "alpha", rejections[0].Distribution)
+		assert.Equal(t, "UPPERCASE/INVALID:IMAGE", rejections[0].Image)
+		assert.NotEmpty(t, rejections[0].Reason)
+		assert.Equal(t, "zeta", rejections[1].Distribution)
+	})
+
+	t.Run("reports unparseable YAML", func(t *testing.T) {
+		overrides, rejections := parseImageMappingOverrides(map[string]string{
+			"image-overrides": "invalid: yaml: content: [",
+		})
+
+		assert.Empty(t, overrides)
+		require.Len(t, rejections, 1)
+		assert.Empty(t, rejections[0].Distribution)
+		assert.Contains(t, rejections[0].Reason, "failed to parse image-overrides YAML")
+	})
+
+	t.Run("no overrides", func(t *testing.T) {
+		overrides, rejections := parseImageMappingOverrides(nil)
+		assert.Empty(t, overrides)
+		assert.Empty(t, rejections)
+	})
+}
+
+func TestSetImageMappingOverrides(t *testing.T) {
+	r := &OGXServerReconciler{}
+	r.setImageMappingOverrides(t.Context(), map[string]string{
+		"image-overrides": `
+starter: quay.io/valid/image:tag
+another: quay.io/another/valid:image
+broken: not a valid image reference!!!
+`,
+	})
+
+	assert.Len(t, r.ImageMappingOverrides, 2)
+	assert.InDelta(t, 2, testutil.ToFloat64(imageOverridesAccepted), 0)
+	assert.InDelta(t, 1, testutil.ToFloat64(imageOverridesRejected), 0)
+
+	err := r.ValidateImageOverrides()
+	require.Error(t, err, "strict mode rejects dropped overrides")
+	assert.Contains(t, err.Error(), "broken: ")
+
+	r.setImageMappingOverrides(t.Context(), map[string]string{"image-overrides": "starter: quay.io/valid/image:tag"})
+	assert.InDelta(t, 0, testutil.ToFloat64(imageOverridesRejected), 0)
+	require.NoError(t, r.ValidateImageOverrides())
+}
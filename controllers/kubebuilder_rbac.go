@@ -8,13 +8,25 @@ package controllers
 // Deployment permissions - controller creates and manages deployments
 //+kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
 
+// ReplicaSet read permissions - controller finds the previous revision when the rollout health gate rolls back
+//+kubebuilder:rbac:groups=apps,resources=replicasets,verbs=list
+
+// Event permissions - controller emits Events for rollout health gate rollbacks
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
 // Service permissions - controller creates and manages services
 //+kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
 
-// TRANSITIONAL: Legacy resource access for annotation-driven adoption.
-// These permissions will be removed when adoption support is deprecated.
+// Pod read permissions - controller reports rollout problems and pod placement in status.
+// Also used by the TRANSITIONAL annotation-driven adoption.
 //+kubebuilder:rbac:groups="",resources=pods,verbs=list
 
+// Node read permissions - controller records the requested node labels of server pods in status
+//+kubebuilder:rbac:groups="",resources=nodes,verbs=get
+
+// Namespace read permissions - controller pauses reconciliation while the namespace is terminating
+//+kubebuilder:rbac:groups="",resources=namespaces,verbs=get
+
 // ServiceAccount permissions - controller creates and manages service accounts for PVC permissions
 //+kubebuilder:rbac:groups="",resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
 
@@ -28,16 +40,24 @@ package controllers
 //+kubebuilder:rbac:groups=security.openshift.io,resources=securitycontextconstraints,resourceNames=anyuid,verbs=use
 
 //+kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch
+//+kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get
 
 // ConfigMap permissions - controller reads user configmaps and manages operator config configmaps
 //+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch
 
+// Secret permissions - controller reads user Secrets holding CA certificates for the trust bundle
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
 // NetworkPolicy permissions - controller creates and manages network policies
 //+kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;create;update;patch;delete
 
 // Ingress permissions - controller creates and manages ingresses for external access
 //+kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
 
+// Route permissions - on OpenShift, controller creates and manages routes for external access
+//+kubebuilder:rbac:groups=route.openshift.io,resources=routes,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=route.openshift.io,resources=routes/custom-host,verbs=create;update
+
 // PodDisruptionBudget permissions - controller creates and manages voluntary disruption controls
 //+kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
 
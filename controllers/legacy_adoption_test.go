@@ -776,14 +776,6 @@ func TestMultipleAdoptedPVCsReturnsTerminalError(t *testing.T) {
 
 // --- Helpers ---
 
-func (b *OGXServerBuilder) WithAnnotation(key, value string) *OGXServerBuilder {
-	if b.instance.Annotations == nil {
-		b.instance.Annotations = make(map[string]string)
-	}
-	b.instance.Annotations[key] = value
-	return b
-}
-
 func createLegacyPVC(t *testing.T, ns, legacyName string) {
 	t.Helper()
 	storageSize := resource.MustParse("1Gi")
@@ -0,0 +1,155 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/ogx-ai/ogx-k8s-operator/pkg/cluster"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// distributionCatalogEntries exposes the size of the distribution catalog.
+// Zero means name-based OGXServers cannot be deployed.
+var distributionCatalogEntries = prometheus.NewGauge(prometheus.GaugeOpts{
+	Name: "ogx_operator_distribution_catalog_entries",
+	Help: "Number of distributions in the operator's catalog; 0 means distribution names cannot be resolved.",
+})
+
+var (
+	imageOverridesAccepted = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "ogx_operator_image_overrides_accepted",
+		Help: "Number of image-overrides entries of the operator config that are applied.",
+	})
+	imageOverridesRejected = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "ogx_operator_image_overrides_rejected",
+		Help: "Number of image-overrides entries of the operator config that were rejected as invalid.",
+	})
+)
+
+// instanceLabels identify the OGXServer a per-instance series belongs to.
+var instanceLabels = []string{"namespace", "name"}
+
+var (
+	reconcileTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ogx_operator_reconcile_total",
+		Help: "Number of reconciles per OGXServer.",
+	}, instanceLabels)
+	reconcileErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ogx_operator_reconcile_errors_total",
+		Help: "Number of reconciles per OGXServer that failed to reconcile its resources.",
+	}, instanceLabels)
+	deploymentReady = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ogx_operator_deployment_ready",
+		Help: "1 when the OGXServer Deployment has all desired replicas ready, 0 otherwise.",
+	}, instanceLabels)
+	providerHealthy = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ogx_operator_provider_healthy",
+		Help: "1 when the provider reports OK health or does not implement health checks, 0 otherwise.",
+	}, append(instanceLabels, "api", "provider_id"))
+	caBundleValidationFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ogx_operator_ca_bundle_validation_failures_total",
+		Help: "Number of times a referenced CA certificate ConfigMap or Secret was missing or lacked its key.",
+	}, instanceLabels)
+)
+
+func init() { //nolint:gochecknoinits // metrics must be registered before the manager serves them.
+	metrics.Registry.MustRegister(
+		distributionCatalogEntries,
+		imageOverridesAccepted,
+		imageOverridesRejected,
+		reconcileTotal,
+		reconcileErrors,
+		deploymentReady,
+		providerHealthy,
+		caBundleValidationFailures,
+	)
+}
+
+// distributionCatalogSize returns the number of known distributions.
+func distributionCatalogSize(clusterInfo *cluster.ClusterInfo) int {
+	if clusterInfo == nil {
+		return 0
+	}
+	return len(clusterInfo.DistributionImages)
+}
+
+// recordDistributionCatalog publishes the catalog size and warns at startup
+// when the catalog is empty.
+func recordDistributionCatalog(ctx context.Context, clusterInfo *cluster.ClusterInfo) {
+	size := distributionCatalogSize(clusterInfo)
+	distributionCatalogEntries.Set(float64(size))
+	if size == 0 {
+		log.FromContext(ctx).Error(nil, "Distribution catalog is empty; OGXServers that reference a "+
+			"distribution by name report CatalogUnavailable until the catalog is restored and the operator restarted")
+	}
+}
+
+// recordReconcileOutcome counts a reconcile of instance and, unless it only
+// asked to be requeued, whether it failed.
+func recordReconcileOutcome(instance *ogxiov1beta1.OGXServer, reconcileErr error) {
+	reconcileTotal.WithLabelValues(instance.Namespace, instance.Name).Inc()
+	var requeueErr *requeueError
+	if reconcileErr != nil && !errors.As(reconcileErr, &requeueErr) {
+		reconcileErrors.WithLabelValues(instance.Namespace, instance.Name).Inc()
+	}
+}
+
+// recordStatusMetrics publishes the deployment readiness and provider health
+// of instance as reported in its status. Providers that are no longer listed
+// are removed.
+func recordStatusMetrics(instance *ogxiov1beta1.OGXServer) {
+	ready := 0.0
+	if condition := GetCondition(&instance.Status, ConditionTypeDeploymentReady); condition != nil &&
+		condition.Status == metav1.ConditionTrue {
+		ready = 1
+	}
+	deploymentReady.WithLabelValues(instance.Namespace, instance.Name).Set(ready)
+
+	providerHealthy.DeletePartialMatch(prometheus.Labels{"namespace": instance.Namespace, "name": instance.Name})
+	for _, provider := range instance.Status.DistributionConfig.Providers {
+		healthy := 0.0
+		if provider.Health.Status == ogxiov1beta1.ProviderHealthOK ||
+			provider.Health.Status == ogxiov1beta1.ProviderHealthNotImplemented {
+			healthy = 1
+		}
+		providerHealthy.WithLabelValues(instance.Namespace, instance.Name, provider.API, provider.ProviderID).Set(healthy)
+	}
+}
+
+// recordCABundleValidationFailure counts a CA certificate reference of
+// instance that could not be resolved.
+func recordCABundleValidationFailure(instance *ogxiov1beta1.OGXServer) {
+	caBundleValidationFailures.WithLabelValues(instance.Namespace, instance.Name).Inc()
+}
+
+// forgetInstanceMetrics removes the series of a deleted OGXServer.
+func forgetInstanceMetrics(key types.NamespacedName) {
+	labels := prometheus.Labels{"namespace": key.Namespace, "name": key.Name}
+	for _, vec := range []*prometheus.MetricVec{
+		reconcileTotal.MetricVec, reconcileErrors.MetricVec, deploymentReady.MetricVec,
+		providerHealthy.MetricVec, caBundleValidationFailures.MetricVec,
+	} {
+		vec.DeletePartialMatch(labels)
+	}
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/ogx-ai/ogx-k8s-operator/pkg/cluster"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestRecordDistributionCatalog(t *testing.T) {
+	recordDistributionCatalog(t.Context(), &cluster.ClusterInfo{
+		DistributionImages: map[string]string{"starter": "a:1", "ollama": "b:1"},
+	})
+	assert.InDelta(t, 2, testutil.ToFloat64(distributionCatalogEntries), 0)
+
+	recordDistributionCatalog(t.Context(), &cluster.ClusterInfo{})
+	assert.InDelta(t, 0, testutil.ToFloat64(distributionCatalogEntries), 0)
+
+	recordDistributionCatalog(t.Context(), nil)
+	assert.InDelta(t, 0, testutil.ToFloat64(distributionCatalogEntries), 0)
+}
+
+func TestRecordReconcileOutcome(t *testing.T) {
+	instance := &ogxiov1beta1.OGXServer{ObjectMeta: metav1.ObjectMeta{Name: "metrics-outcome", Namespace: "test"}}
+	key := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+	t.Cleanup(func() { forgetInstanceMetrics(key) })
+
+	recordReconcileOutcome(instance, nil)
+	recordReconcileOutcome(instance, errors.New("failed to reconcile deployment"))
+	recordReconcileOutcome(instance, &requeueError{after: time.Second})
+
+	assert.InDelta(t, 3, testutil.ToFloat64(reconcileTotal.WithLabelValues("test", "metrics-outcome")), 0)
+	assert.InDelta(t, 1, testutil.ToFloat64(reconcileErrors.WithLabelValues("test", "metrics-outcome")), 0,
+		"requeue requests are not failures")
+
+	forgetInstanceMetrics(key)
+	assert.InDelta(t, 0, testutil.ToFloat64(reconcileTotal.WithLabelValues("test", "metrics-outcome")), 0)
+}
+
+func TestRecordStatusMetrics(t *testing.T) {
+	instance := &ogxiov1beta1.OGXServer{ObjectMeta: metav1.ObjectMeta{Name: "metrics-status", Namespace: "test"}}
+	key := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+	t.Cleanup(func() { forgetInstanceMetrics(key) })
+	withHealth := func(id, status string) ogxiov1beta1.ProviderInfo {
+		return ogxiov1beta1.ProviderInfo{API: "inference", ProviderID: id, Health: ogxiov1beta1.ProviderHealthStatus{Status: status}}
+	}
+
+	SetDeploymentReadyCondition(&instance.Status, true, MessageDeploymentReady)
+	instance.Status.DistributionConfig.Providers = []ogxiov1beta1.ProviderInfo{
+		withHealth("vllm", ogxiov1beta1.ProviderHealthOK),
+		withHealth("ollama", ogxiov1beta1.ProviderHealthError),
+		withHealth("openai", ogxiov1beta1.ProviderHealthNotImplemented),
+	}
+	recordStatusMetrics(instance)
+
+	assert.InDelta(t, 1, testutil.ToFloat64(deploymentReady.WithLabelValues("test", "metrics-status")), 0)
+	assert.Equal(t, 3, testutil.CollectAndCount(providerHealthy))
+	assert.InDelta(t, 1, testutil.ToFloat64(providerHealthy.WithLabelValues("test", "metrics-status", "inference", "vllm")), 0)
+	assert.InDelta(t, 0, testutil.ToFloat64(providerHealthy.WithLabelValues("test", "metrics-status", "inference", "ollama")), 0)
+	assert.InDelta(t, 1, testutil.ToFloat64(providerHealthy.WithLabelValues("test", "metrics-status", "inference", "openai")), 0)
+
+	SetDeploymentReadyCondition(&instance.Status, false, MessageDeploymentPending)
+	instance.Status.DistributionConfig.Providers = nil
+	recordStatusMetrics(instance)
+
+	assert.InDelta(t, 0, testutil.ToFloat64(deploymentReady.WithLabelValues("test", "metrics-status")), 0)
+	assert.Equal(t, 0, testutil.CollectAndCount(providerHealthy), "providers that are no longer listed are removed")
+}
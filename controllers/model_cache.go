@@ -0,0 +1,152 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/ogx-ai/ogx-k8s-operator/pkg/deploy"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// modelCacheVolumeName is the name of the model cache volume.
+const modelCacheVolumeName = "model-cache"
+
+// getModelCache returns the model cache configuration, or nil for the default.
+func getModelCache(instance *ogxiov1beta1.OGXServer) *ogxiov1beta1.ModelCacheSpec {
+	if instance.Spec.Workload == nil {
+		return nil
+	}
+	return instance.Spec.Workload.ModelCache
+}
+
+// getModelCacheEnv returns the Hugging Face cache env vars. Without a model
+// cache, HF_HOME is the storage mount path so that downloaded models and
+// datasets survive restarts when storage is persistent.
+func getModelCacheEnv(instance *ogxiov1beta1.OGXServer) []corev1.EnvVar {
+	modelCache := getModelCache(instance)
+	if modelCache == nil {
+		return []corev1.EnvVar{{Name: "HF_HOME", Value: getMountPath(instance)}}
+	}
+	return []corev1.EnvVar{
+		{Name: "HF_HOME", Value: modelCache.Path},
+		{Name: "HF_HUB_CACHE", Value: path.Join(modelCache.Path, "hub")},
+	}
+}
+
+// addModelCacheVolumeMount mounts the model cache claim at the cache path.
+func addModelCacheVolumeMount(instance *ogxiov1beta1.OGXServer, container *corev1.Container) {
+	modelCache := getModelCache(instance)
+	if modelCache == nil || modelCache.ClaimName == "" {
+		return
+	}
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      modelCacheVolumeName,
+		MountPath: modelCache.Path,
+	})
+}
+
+// configureModelCache adds the model cache claim volume.
+func configureModelCache(instance *ogxiov1beta1.OGXServer, podSpec *corev1.PodSpec) {
+	modelCache := getModelCache(instance)
+	if modelCache == nil || modelCache.ClaimName == "" {
+		return
+	}
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name: modelCacheVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: modelCache.ClaimName},
+		},
+	})
+}
+
+// validateModelCache checks that the model cache claim is not mounted at the
+// path of another operator-managed volume and that every pod can mount it. A
+// claim that only one node can mount fails to attach when several replicas, or
+// the old and new pods of a rolling update, run on different nodes.
+func (r *OGXServerReconciler) validateModelCache(ctx context.Context, instance *ogxiov1beta1.OGXServer) error {
+	modelCache := getModelCache(instance)
+	if modelCache == nil || modelCache.ClaimName == "" {
+		return nil
+	}
+
+	managedMountPaths := map[string]string{}
+	for _, managed := range getManagedVolumes(ctx, r, instance) {
+		if managed.name != modelCacheVolumeName {
+			managedMountPaths[path.Clean(managed.mountPath)] = managed.name
+		}
+	}
+	for i, mount := range getConfigMounts(instance) {
+		managedMountPaths[path.Clean(mount.MountPath)] = configMountVolumeName(i)
+	}
+	if managed, ok := managedMountPaths[path.Clean(modelCache.Path)]; ok {
+		msg := fmt.Sprintf("modelCache.path %q is the mount path of operator-managed volume %q", modelCache.Path, managed)
+		log.FromContext(ctx).Error(nil, msg)
+		return &terminalError{message: msg}
+	}
+
+	// Read via direct client — user PVCs lack operator labels
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := r.directGet(ctx, types.NamespacedName{Name: modelCache.ClaimName, Namespace: instance.Namespace}, pvc); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return fmt.Errorf("failed to find model cache PVC %s/%s", instance.Namespace, modelCache.ClaimName)
+		}
+		return fmt.Errorf("failed to fetch model cache PVC %s/%s: %w", instance.Namespace, modelCache.ClaimName, err)
+	}
+	if slices.Contains(pvc.Spec.AccessModes, corev1.ReadWriteMany) || slices.Contains(pvc.Spec.AccessModes, corev1.ReadOnlyMany) {
+		return nil
+	}
+
+	pvcName, err := r.resolveEffectivePVCName(ctx, instance)
+	if err != nil {
+		return err
+	}
+	storageAccessModes, err := r.getStoragePVCAccessModes(ctx, instance, pvcName)
+	if err != nil {
+		return err
+	}
+
+	var msg string
+	if replicas := maxReplicas(instance); replicas > 1 {
+		msg = fmt.Sprintf("model cache PVC %q is not ReadWriteMany and cannot be mounted by up to %d replicas",
+			modelCache.ClaimName, replicas)
+	} else if deploy.GetEffectiveStrategyType(instance, storageAccessModes) == ogxiov1beta1.DeploymentStrategyRollingUpdate {
+		msg = fmt.Sprintf("model cache PVC %q is not ReadWriteMany and cannot be mounted by the old and new pods "+
+			"of a RollingUpdate; set workload.deploymentStrategy.type to Recreate", modelCache.ClaimName)
+	}
+	if msg == "" {
+		return nil
+	}
+	log.FromContext(ctx).Error(nil, msg)
+	return &terminalError{message: msg}
+}
+
+// maxReplicas returns the largest number of replicas instance may run,
+// the autoscaling maximum when autoscaling is configured.
+func maxReplicas(instance *ogxiov1beta1.OGXServer) int32 {
+	if instance.Spec.Workload != nil && instance.Spec.Workload.Autoscaling != nil {
+		return max(instance.Spec.Workload.Autoscaling.MaxReplicas, deploy.GetEffectiveReplicas(instance))
+	}
+	return deploy.GetEffectiveReplicas(instance)
+}
@@ -0,0 +1,136 @@
+package controllers
+
+import (
+	"errors"
+	"testing"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newModelCacheInstance(modelCache *ogxiov1beta1.ModelCacheSpec) *ogxiov1beta1.OGXServer {
+	return NewOGXServerBuilder().WithName("test").WithImage("x:latest").WithModelCache(modelCache).Build()
+}
+
+func findEnv(container corev1.Container, name string) *corev1.EnvVar {
+	for i := range container.Env {
+		if container.Env[i].Name == name {
+			return &container.Env[i]
+		}
+	}
+	return nil
+}
+
+func TestModelCacheDefault(t *testing.T) {
+	instance := newModelCacheInstance(nil)
+
+	container := buildContainerSpec(t.Context(), nil, instance, "x:latest")
+	hfHome := findEnv(container, "HF_HOME")
+	require.NotNil(t, hfHome)
+	assert.Equal(t, getMountPath(instance), hfHome.Value, "HF_HOME should default to the storage mount path")
+	assert.Nil(t, findEnv(container, "HF_HUB_CACHE"))
+
+	podSpec := configurePodStorage(t.Context(), nil, instance, container, "")
+	for _, volume := range podSpec.Volumes {
+		assert.NotEqual(t, modelCacheVolumeName, volume.Name)
+	}
+}
+
+func TestModelCacheCustomPath(t *testing.T) {
+	instance := newModelCacheInstance(&ogxiov1beta1.ModelCacheSpec{Path: "/cache/hf"})
+
+	container := buildContainerSpec(t.Context(), nil, instance, "x:latest")
+	assert.Equal(t, "/cache/hf", findEnv(container, "HF_HOME").Value)
+	assert.Equal(t, "/cache/hf/hub", findEnv(container, "HF_HUB_CACHE").Value)
+	for _, mount := range container.VolumeMounts {
+		assert.NotEqual(t, modelCacheVolumeName, mount.Name, "no volume without a claim")
+	}
+}
+
+func TestModelCacheClaim(t *testing.T) {
+	instance := newModelCacheInstance(&ogxiov1beta1.ModelCacheSpec{Path: "/cache/hf", ClaimName: "shared-models"})
+
+	container := buildContainerSpec(t.Context(), nil, instance, "x:latest")
+	assert.Equal(t, "/cache/hf", findEnv(container, "HF_HOME").Value)
+	assert.Contains(t, container.VolumeMounts, corev1.VolumeMount{Name: modelCacheVolumeName, MountPath: "/cache/hf"})
+
+	podSpec := configurePodStorage(t.Context(), nil, instance, container, "")
+	assert.Contains(t, podSpec.Volumes, corev1.Volume{
+		Name: modelCacheVolumeName,
+		VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+			ClaimName: "shared-models",
+		}},
+	})
+	require.NoError(t, validatePodVolumes(podSpec))
+
+	t.Run("override mounts at the cache path conflict", func(t *testing.T) {
+		instance.Spec.Workload.Overrides = &ogxiov1beta1.WorkloadOverrides{
+			VolumeMounts: []corev1.VolumeMount{{Name: "other", MountPath: "/cache/hf/"}},
+		}
+		conflicts := findWorkloadOverrideConflicts(t.Context(), nil, instance)
+		require.Len(t, conflicts, 1)
+		assert.Contains(t, conflicts[0], modelCacheVolumeName)
+	})
+}
+
+func TestValidateModelCache(t *testing.T) {
+	newPVC := func(accessMode corev1.PersistentVolumeAccessMode) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "shared-models", Namespace: "default"},
+			Spec:       corev1.PersistentVolumeClaimSpec{AccessModes: []corev1.PersistentVolumeAccessMode{accessMode}},
+		}
+	}
+	recreate := &ogxiov1beta1.DeploymentStrategySpec{Type: ogxiov1beta1.DeploymentStrategyRecreate}
+
+	tests := []struct {
+		name      string
+		path      string
+		pvc       *corev1.PersistentVolumeClaim
+		replicas  int32
+		strategy  *ogxiov1beta1.DeploymentStrategySpec
+		wantError string
+		terminal  bool
+	}{
+		{name: "ReadWriteOnce with one replica and Recreate", path: "/cache/hf", pvc: newPVC(corev1.ReadWriteOnce), replicas: 1, strategy: recreate},
+		{name: "ReadWriteMany with several replicas", path: "/cache/hf", pvc: newPVC(corev1.ReadWriteMany), replicas: 3},
+		{
+			name: "path of the storage volume", path: ogxiov1beta1.DefaultMountPath + "/", pvc: newPVC(corev1.ReadWriteMany), replicas: 1,
+			wantError: `is the mount path of operator-managed volume "ogx-storage"`, terminal: true,
+		},
+		{
+			name: "ReadWriteOnce with several replicas", path: "/cache/hf", pvc: newPVC(corev1.ReadWriteOnce), replicas: 2, strategy: recreate,
+			wantError: "cannot be mounted by up to 2 replicas", terminal: true,
+		},
+		{
+			name: "ReadWriteOnce with RollingUpdate", path: "/cache/hf", pvc: newPVC(corev1.ReadWriteOnce), replicas: 1,
+			wantError: "RollingUpdate", terminal: true,
+		},
+		{name: "missing PVC", path: "/cache/hf", replicas: 1, wantError: "failed to find model cache PVC default/shared-models"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := newModelCacheInstance(&ogxiov1beta1.ModelCacheSpec{Path: tt.path, ClaimName: "shared-models"})
+			instance.Spec.Workload.Replicas = &tt.replicas
+			instance.Spec.Workload.DeploymentStrategy = tt.strategy
+			builder := fake.NewClientBuilder()
+			if tt.pvc != nil {
+				builder = builder.WithObjects(tt.pvc)
+			}
+			r := &OGXServerReconciler{Client: builder.Build()}
+
+			err := r.validateModelCache(t.Context(), instance)
+			if tt.wantError == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.wantError)
+			var termErr *terminalError
+			assert.Equal(t, tt.terminal, errors.As(err, &termErr))
+		})
+	}
+}
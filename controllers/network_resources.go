@@ -19,24 +19,99 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net/url"
+	"strings"
 
 	"github.com/go-logr/logr"
 	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/ogx-ai/ogx-k8s-operator/pkg/cluster"
 	"github.com/ogx-ai/ogx-k8s-operator/pkg/deploy"
+	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 const (
 	// IngressNameSuffix is the suffix for the Ingress name.
 	IngressNameSuffix = "-ingress"
+	// RouteNameSuffix is the suffix for the Route name.
+	RouteNameSuffix = "-route"
+	// ingressBackendProtocolAnnotation selects the protocol the NGINX ingress
+	// controller uses to reach the server.
+	ingressBackendProtocolAnnotation = "nginx.ingress.kubernetes.io/backend-protocol"
 )
 
+// getExternalAccess returns the external access config if it is enabled.
+func getExternalAccess(instance *ogxiov1beta1.OGXServer) *ogxiov1beta1.ExternalAccessConfig {
+	if instance.Spec.Network == nil || instance.Spec.Network.ExternalAccess == nil || !instance.Spec.Network.ExternalAccess.Enabled {
+		return nil
+	}
+	return instance.Spec.Network.ExternalAccess
+}
+
+// externalAccessLabels returns the labels for operator-managed Routes and Ingresses.
+func externalAccessLabels(instance *ogxiov1beta1.OGXServer) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/managed-by": "ogx-operator",
+		"app.kubernetes.io/instance":   instance.Name,
+	}
+}
+
+// useRoutes reports whether external access is exposed through an OpenShift Route.
+func (r *OGXServerReconciler) useRoutes() bool {
+	return r.ClusterInfo != nil && r.ClusterInfo.IsOpenShift
+}
+
+// reconcileExternalAccess reconciles the Route on OpenShift and the Ingress elsewhere.
+func (r *OGXServerReconciler) reconcileExternalAccess(ctx context.Context, instance *ogxiov1beta1.OGXServer) error {
+	if !r.useRoutes() {
+		return r.reconcileIngress(ctx, instance)
+	}
+
+	if err := r.reconcileRoute(ctx, instance); err != nil {
+		return fmt.Errorf("failed to reconcile Route: %w", err)
+	}
+
+	// A Route replaces the Ingress created before the Route API was detected.
+	ingressName := instance.Name + IngressNameSuffix
+	existing := &networkingv1.Ingress{}
+	err := r.Get(ctx, types.NamespacedName{Name: ingressName, Namespace: instance.Namespace}, existing)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get Ingress: %w", err)
+	}
+	if _, adopted := existing.Labels[ogxiov1beta1.AdoptedFromLabel]; adopted {
+		return nil
+	}
+	return r.handleDisabledIngress(ctx, instance, existing, true, ingressName)
+}
+
+// validateExternalAccess rejects TLS terminations that only Routes support
+// when external access is served by an Ingress.
+func (r *OGXServerReconciler) validateExternalAccess(ctx context.Context, instance *ogxiov1beta1.OGXServer) error {
+	access := getExternalAccess(instance)
+	if r.useRoutes() || access == nil || access.TLS == nil {
+		return nil
+	}
+	switch access.TLS.Termination {
+	case ogxiov1beta1.ExternalTLSTerminationPassthrough, ogxiov1beta1.ExternalTLSTerminationReencrypt:
+		msg := fmt.Sprintf("network.externalAccess.tls.termination %s is only supported by OpenShift Routes, "+
+			"Ingresses terminate TLS at the ingress controller", access.TLS.Termination)
+		log.FromContext(ctx).Error(nil, msg)
+		return &terminalError{message: msg}
+	}
+	return nil
+}
+
 // buildIngress creates an Ingress for external access to the OGXServer.
 func (r *OGXServerReconciler) buildIngress(
 	instance *ogxiov1beta1.OGXServer,
@@ -44,19 +119,27 @@ func (r *OGXServerReconciler) buildIngress(
 	servicePort := deploy.GetServicePort(instance)
 	serviceName := deploy.GetServiceName(instance)
 
+	var hostname string
+	var annotations map[string]string
+	var tls *ogxiov1beta1.ExternalAccessTLSConfig
+	if access := getExternalAccess(instance); access != nil {
+		hostname = access.Hostname
+		annotations = maps.Clone(access.Annotations)
+		tls = access.TLS
+	}
+
 	pathType := networkingv1.PathTypePrefix
 	ingress := &networkingv1.Ingress{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      instance.Name + IngressNameSuffix,
-			Namespace: instance.Namespace,
-			Labels: map[string]string{
-				"app.kubernetes.io/managed-by": "ogx-operator",
-				"app.kubernetes.io/instance":   instance.Name,
-			},
+			Name:        instance.Name + IngressNameSuffix,
+			Namespace:   instance.Namespace,
+			Labels:      externalAccessLabels(instance),
+			Annotations: annotations,
 		},
 		Spec: networkingv1.IngressSpec{
 			Rules: []networkingv1.IngressRule{
 				{
+					Host: hostname,
 					IngressRuleValue: networkingv1.IngressRuleValue{
 						HTTP: &networkingv1.HTTPIngressRuleValue{
 							Paths: []networkingv1.HTTPIngressPath{
@@ -80,6 +163,24 @@ func (r *OGXServerReconciler) buildIngress(
 		},
 	}
 
+	// The ingress controller must speak HTTPS to a server that serves it.
+	if isServingTLSEnabled(instance) {
+		if ingress.Annotations == nil {
+			ingress.Annotations = map[string]string{}
+		}
+		if _, set := ingress.Annotations[ingressBackendProtocolAnnotation]; !set {
+			ingress.Annotations[ingressBackendProtocolAnnotation] = "HTTPS"
+		}
+	}
+
+	if tls != nil {
+		ingressTLS := networkingv1.IngressTLS{SecretName: tls.SecretName}
+		if hostname != "" {
+			ingressTLS.Hosts = []string{hostname}
+		}
+		ingress.Spec.TLS = []networkingv1.IngressTLS{ingressTLS}
+	}
+
 	if err := ctrl.SetControllerReference(instance, ingress, r.Scheme); err != nil {
 		return nil, fmt.Errorf("failed to set controller reference: %w", err)
 	}
@@ -99,7 +200,7 @@ func (r *OGXServerReconciler) reconcileIngress(
 	err := r.Get(ctx, types.NamespacedName{Name: ingressName, Namespace: instance.Namespace}, existing)
 	existsAlready := err == nil
 
-	expose := instance.Spec.Network != nil && instance.Spec.Network.ExternalAccess != nil && instance.Spec.Network.ExternalAccess.Enabled
+	expose := getExternalAccess(instance) != nil
 
 	if !expose {
 		return r.handleDisabledIngress(ctx, instance, existing, existsAlready, ingressName)
@@ -175,12 +276,24 @@ func (r *OGXServerReconciler) handleEnabledIngress(
 	return nil
 }
 
+// getExternalURL returns the external URL from the Route or Ingress if available.
+func (r *OGXServerReconciler) getExternalURL(
+	ctx context.Context,
+	instance *ogxiov1beta1.OGXServer,
+) *string {
+	if r.useRoutes() {
+		return r.getRouteURL(ctx, instance)
+	}
+	return r.getIngressURL(ctx, instance)
+}
+
 // getIngressURL returns the external URL from an Ingress if available.
 func (r *OGXServerReconciler) getIngressURL(
 	ctx context.Context,
 	instance *ogxiov1beta1.OGXServer,
 ) *string {
-	if instance.Spec.Network == nil || instance.Spec.Network.ExternalAccess == nil || !instance.Spec.Network.ExternalAccess.Enabled {
+	access := getExternalAccess(instance)
+	if access == nil {
 		return nil
 	}
 
@@ -194,39 +307,275 @@ func (r *OGXServerReconciler) getIngressURL(
 		return &empty // Ingress not ready yet
 	}
 
+	secure := len(ingress.Spec.TLS) > 0
+
+	// Check for host in rules; with a host set, the load balancer address
+	// alone does not route to the server.
+	if len(ingress.Spec.Rules) > 0 && ingress.Spec.Rules[0].Host != "" {
+		return buildURLString(ingress.Spec.Rules[0].Host, secure)
+	}
+
 	// Check for LoadBalancer ingress
 	if len(ingress.Status.LoadBalancer.Ingress) > 0 {
 		lb := ingress.Status.LoadBalancer.Ingress[0]
 		if lb.Hostname != "" {
-			return buildURLString(lb.Hostname)
+			return buildURLString(lb.Hostname, secure)
 		}
 		if lb.IP != "" {
-			return buildURLString(lb.IP)
+			return buildURLString(lb.IP, secure)
 		}
 	}
 
-	// Check for host in rules
-	if len(ingress.Spec.Rules) > 0 && ingress.Spec.Rules[0].Host != "" {
-		return buildURLString(ingress.Spec.Rules[0].Host)
-	}
-
 	empty := ""
 	return &empty
 }
 
-// buildURLString constructs an HTTP URL from a host and returns a pointer to it.
-func buildURLString(host string) *string {
+// getLoadBalancerAddress returns the hostname or IP assigned to a
+// LoadBalancer Service, or an empty string for other Service types.
+func getLoadBalancerAddress(service *corev1.Service) string {
+	if service.Spec.Type != corev1.ServiceTypeLoadBalancer || len(service.Status.LoadBalancer.Ingress) == 0 {
+		return ""
+	}
+	lb := service.Status.LoadBalancer.Ingress[0]
+	if lb.Hostname != "" {
+		return lb.Hostname
+	}
+	return lb.IP
+}
+
+// buildURLString constructs an HTTP or HTTPS URL from a host and returns a pointer to it.
+func buildURLString(host string, secure bool) *string {
+	scheme := "http"
+	if secure {
+		scheme = "https"
+	}
 	u := &url.URL{
-		Scheme: "http",
+		Scheme: scheme,
 		Host:   host,
 	}
 	s := u.String()
 	return &s
 }
 
+// newRoute returns an empty Route object with its GroupVersionKind set.
+func newRoute() *unstructured.Unstructured {
+	route := &unstructured.Unstructured{}
+	route.SetGroupVersionKind(cluster.RouteGVK)
+	return route
+}
+
+// buildRoute creates an OpenShift Route for external access to the OGXServer.
+// The Route API is not vendored, so the Route is built as an unstructured object.
+func (r *OGXServerReconciler) buildRoute(instance *ogxiov1beta1.OGXServer) (*unstructured.Unstructured, error) {
+	spec := map[string]any{
+		"to": map[string]any{
+			"kind":   "Service",
+			"name":   deploy.GetServiceName(instance),
+			"weight": int64(100),
+		},
+		"port": map[string]any{
+			"targetPort": ogxiov1beta1.DefaultServicePortName,
+		},
+	}
+
+	route := newRoute()
+	route.SetName(instance.Name + RouteNameSuffix)
+	route.SetNamespace(instance.Namespace)
+	route.SetLabels(externalAccessLabels(instance))
+
+	if access := getExternalAccess(instance); access != nil {
+		if access.Hostname != "" {
+			spec["host"] = access.Hostname
+		}
+		// An edge-terminated or plain Route would send HTTP to a server that
+		// serves HTTPS, so such servers default to re-encryption.
+		servingTLS := isServingTLSEnabled(instance)
+		if access.TLS != nil || servingTLS {
+			termination := ogxiov1beta1.ExternalTLSTerminationEdge
+			if servingTLS {
+				termination = ogxiov1beta1.ExternalTLSTerminationReencrypt
+			}
+			if access.TLS != nil && access.TLS.Termination != "" {
+				termination = access.TLS.Termination
+			}
+			spec["tls"] = map[string]any{
+				"termination":                   strings.ToLower(string(termination)),
+				"insecureEdgeTerminationPolicy": "Redirect",
+			}
+		}
+		if len(access.Annotations) > 0 {
+			route.SetAnnotations(maps.Clone(access.Annotations))
+		}
+	}
+
+	if err := unstructured.SetNestedMap(route.Object, spec, "spec"); err != nil {
+		return nil, fmt.Errorf("failed to set Route spec: %w", err)
+	}
+
+	if err := ctrl.SetControllerReference(instance, route, r.Scheme); err != nil {
+		return nil, fmt.Errorf("failed to set controller reference: %w", err)
+	}
+
+	return route, nil
+}
+
+// reconcileRoute creates, updates, or deletes the Route based on expose setting.
+func (r *OGXServerReconciler) reconcileRoute(
+	ctx context.Context,
+	instance *ogxiov1beta1.OGXServer,
+) error {
+	logger := log.FromContext(ctx)
+	routeName := instance.Name + RouteNameSuffix
+
+	existing := newRoute()
+	err := r.Get(ctx, types.NamespacedName{Name: routeName, Namespace: instance.Namespace}, existing)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get Route: %w", err)
+	}
+	existsAlready := err == nil
+
+	if getExternalAccess(instance) == nil {
+		if !existsAlready {
+			return nil
+		}
+		if !metav1.IsControlledBy(existing, instance) {
+			logger.V(1).Info("Route not owned by this instance, skipping deletion", "name", routeName)
+			return nil
+		}
+		logger.Info("Deleting Route as expose is disabled", "name", routeName)
+		if err := r.Delete(ctx, existing); err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete Route: %w", err)
+		}
+		return nil
+	}
+
+	route, err := r.buildRoute(instance)
+	if err != nil {
+		return err
+	}
+
+	if !existsAlready {
+		logger.Info("Creating Route for external access", "name", routeName)
+		if err := r.Create(ctx, route); err != nil {
+			return fmt.Errorf("failed to create Route: %w", err)
+		}
+		return nil
+	}
+
+	if !metav1.IsControlledBy(existing, instance) {
+		logger.V(1).Info("Route not owned by this instance, skipping update", "name", routeName)
+		return nil
+	}
+
+	patch := client.MergeFrom(existing.DeepCopy())
+	if err := applyOwnedRouteFields(existing, route); err != nil {
+		return err
+	}
+	data, err := patch.Data(existing)
+	if err != nil {
+		return fmt.Errorf("failed to compute Route patch: %w", err)
+	}
+	if string(data) == "{}" {
+		return nil
+	}
+	if err := r.Patch(ctx, existing, patch); err != nil {
+		return fmt.Errorf("failed to patch Route: %w", err)
+	}
+	logger.V(1).Info("Patched Route", "name", routeName)
+
+	return nil
+}
+
+// applyOwnedRouteFields copies the fields the operator owns from the desired
+// Route onto the existing one, leaving fields set by the router or other
+// controllers, such as a router-generated host, untouched.
+func applyOwnedRouteFields(existing, desired *unstructured.Unstructured) error {
+	for _, field := range []string{"to", "port", "tls"} {
+		value, found, err := unstructured.NestedFieldCopy(desired.Object, "spec", field)
+		if err != nil {
+			return fmt.Errorf("failed to read Route spec.%s: %w", field, err)
+		}
+		if !found {
+			unstructured.RemoveNestedField(existing.Object, "spec", field)
+			continue
+		}
+		if err := unstructured.SetNestedField(existing.Object, value, "spec", field); err != nil {
+			return fmt.Errorf("failed to set Route spec.%s: %w", field, err)
+		}
+	}
+	if host, found, _ := unstructured.NestedString(desired.Object, "spec", "host"); found {
+		if err := unstructured.SetNestedField(existing.Object, host, "spec", "host"); err != nil {
+			return fmt.Errorf("failed to set Route host: %w", err)
+		}
+	}
+
+	labels := existing.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	maps.Copy(labels, desired.GetLabels())
+	existing.SetLabels(labels)
+
+	if len(desired.GetAnnotations()) > 0 {
+		annotations := existing.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		maps.Copy(annotations, desired.GetAnnotations())
+		existing.SetAnnotations(annotations)
+	}
+	return nil
+}
+
+// getRouteURL returns the external URL from a Route if available.
+func (r *OGXServerReconciler) getRouteURL(
+	ctx context.Context,
+	instance *ogxiov1beta1.OGXServer,
+) *string {
+	if getExternalAccess(instance) == nil {
+		return nil
+	}
+
+	route := newRoute()
+	err := r.Get(ctx, types.NamespacedName{
+		Name:      instance.Name + RouteNameSuffix,
+		Namespace: instance.Namespace,
+	}, route)
+	if err != nil {
+		empty := ""
+		return &empty // Route not ready yet
+	}
+
+	_, secure, _ := unstructured.NestedMap(route.Object, "spec", "tls")
+
+	// The router fills in spec.host when no hostname is requested.
+	if host, _, _ := unstructured.NestedString(route.Object, "spec", "host"); host != "" {
+		return buildURLString(host, secure)
+	}
+
+	ingresses, _, _ := unstructured.NestedSlice(route.Object, "status", "ingress")
+	if len(ingresses) > 0 {
+		if admitted, ok := ingresses[0].(map[string]any); ok {
+			if host, _, _ := unstructured.NestedString(admitted, "host"); host != "" {
+				return buildURLString(host, secure)
+			}
+		}
+	}
+
+	empty := ""
+	return &empty
+}
+
 // BuildIngressForTest is a test helper that exposes buildIngress for unit testing.
 func (r *OGXServerReconciler) BuildIngressForTest(
 	instance *ogxiov1beta1.OGXServer,
 ) (*networkingv1.Ingress, error) {
 	return r.buildIngress(instance)
 }
+
+// BuildRouteForTest is a test helper that exposes buildRoute for unit testing.
+func (r *OGXServerReconciler) BuildRouteForTest(
+	instance *ogxiov1beta1.OGXServer,
+) (*unstructured.Unstructured, error) {
+	return r.buildRoute(instance)
+}
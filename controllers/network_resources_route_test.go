@@ -0,0 +1,89 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/ogx-ai/ogx-k8s-operator/pkg/cluster"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestReconcileRoutePatchesOwnedFields(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, ogxiov1beta1.AddToScheme(scheme))
+
+	instance := &ogxiov1beta1.OGXServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", UID: "test-uid"},
+		Spec: ogxiov1beta1.OGXServerSpec{
+			Network: &ogxiov1beta1.NetworkSpec{ExternalAccess: &ogxiov1beta1.ExternalAccessConfig{
+				Enabled: true,
+				TLS:     &ogxiov1beta1.ExternalAccessTLSConfig{},
+			}},
+		},
+	}
+	r := &OGXServerReconciler{Scheme: scheme, ClusterInfo: &cluster.ClusterInfo{IsOpenShift: true}}
+
+	existing, err := r.buildRoute(instance)
+	require.NoError(t, err)
+	unstructured.RemoveNestedField(existing.Object, "spec", "tls")
+	require.NoError(t, unstructured.SetNestedField(existing.Object, "test-route-default.apps.example.com", "spec", "host"))
+	require.NoError(t, unstructured.SetNestedField(existing.Object, "None", "spec", "wildcardPolicy"))
+	existing.SetAnnotations(map[string]string{"openshift.io/host.generated": "true"})
+
+	r.Client = fake.NewClientBuilder().WithScheme(scheme).WithObjects(existing).Build()
+	require.NoError(t, r.reconcileRoute(t.Context(), instance))
+
+	route := newRoute()
+	require.NoError(t, r.Get(t.Context(), types.NamespacedName{Name: "test" + RouteNameSuffix, Namespace: "default"}, route))
+	termination, _, _ := unstructured.NestedString(route.Object, "spec", "tls", "termination")
+	assert.Equal(t, "edge", termination)
+	host, _, _ := unstructured.NestedString(route.Object, "spec", "host")
+	assert.Equal(t, "test-route-default.apps.example.com", host, "the router-generated host should be kept")
+	wildcardPolicy, _, _ := unstructured.NestedString(route.Object, "spec", "wildcardPolicy")
+	assert.Equal(t, "None", wildcardPolicy, "fields the operator does not own should be kept")
+	assert.Equal(t, "true", route.GetAnnotations()["openshift.io/host.generated"])
+}
+
+func TestValidateExternalAccess(t *testing.T) {
+	newInstance := func(termination ogxiov1beta1.ExternalTLSTermination) *ogxiov1beta1.OGXServer {
+		return &ogxiov1beta1.OGXServer{Spec: ogxiov1beta1.OGXServerSpec{
+			Network: &ogxiov1beta1.NetworkSpec{ExternalAccess: &ogxiov1beta1.ExternalAccessConfig{
+				Enabled: true,
+				TLS:     &ogxiov1beta1.ExternalAccessTLSConfig{Termination: termination},
+			}},
+		}}
+	}
+
+	ingress := &OGXServerReconciler{}
+	routes := &OGXServerReconciler{ClusterInfo: &cluster.ClusterInfo{IsOpenShift: true}}
+
+	require.NoError(t, ingress.validateExternalAccess(t.Context(), newInstance(ogxiov1beta1.ExternalTLSTerminationEdge)))
+	require.NoError(t, routes.validateExternalAccess(t.Context(), newInstance(ogxiov1beta1.ExternalTLSTerminationPassthrough)))
+
+	err := ingress.validateExternalAccess(t.Context(), newInstance(ogxiov1beta1.ExternalTLSTerminationReencrypt))
+	var terminal *terminalError
+	require.ErrorAs(t, err, &terminal)
+	assert.Contains(t, err.Error(), "Reencrypt")
+}
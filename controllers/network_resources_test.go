@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -98,3 +99,134 @@ func TestBuildIngress_CustomPort(t *testing.T) {
 
 	assert.Equal(t, int32(9000), ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service.Port.Number)
 }
+
+func TestBuildIngress_ExternalAccessOptions(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, ogxiov1beta1.AddToScheme(scheme))
+
+	reconciler := controllers.NewTestReconciler(nil, scheme, &cluster.ClusterInfo{}, nil)
+
+	instance := &ogxiov1beta1.OGXServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-ogx",
+			Namespace: "test-ns",
+			UID:       "test-uid",
+		},
+		Spec: ogxiov1beta1.OGXServerSpec{
+			Distribution: ogxiov1beta1.DistributionSpec{Name: "starter"},
+			Network: &ogxiov1beta1.NetworkSpec{
+				ExternalAccess: &ogxiov1beta1.ExternalAccessConfig{
+					Enabled:     true,
+					Hostname:    "ogx.example.com",
+					TLS:         &ogxiov1beta1.ExternalAccessTLSConfig{SecretName: "ogx-tls"},
+					Annotations: map[string]string{"cert-manager.io/cluster-issuer": "letsencrypt"},
+				},
+			},
+		},
+	}
+
+	ingress, err := reconciler.BuildIngressForTest(instance)
+	require.NoError(t, err)
+
+	assert.Equal(t, "ogx.example.com", ingress.Spec.Rules[0].Host)
+	assert.Equal(t, "letsencrypt", ingress.Annotations["cert-manager.io/cluster-issuer"])
+	require.Len(t, ingress.Spec.TLS, 1)
+	assert.Equal(t, []string{"ogx.example.com"}, ingress.Spec.TLS[0].Hosts)
+	assert.Equal(t, "ogx-tls", ingress.Spec.TLS[0].SecretName)
+	assert.NotContains(t, ingress.Annotations, "nginx.ingress.kubernetes.io/backend-protocol")
+
+	instance.Spec.Network.TLS = &ogxiov1beta1.TLSSpec{SecretName: "serving-cert"}
+	ingress, err = reconciler.BuildIngressForTest(instance)
+	require.NoError(t, err)
+	assert.Equal(t, "HTTPS", ingress.Annotations["nginx.ingress.kubernetes.io/backend-protocol"],
+		"the ingress controller must reach a server serving HTTPS over HTTPS")
+	assert.NotContains(t, instance.Spec.Network.ExternalAccess.Annotations, "nginx.ingress.kubernetes.io/backend-protocol")
+}
+
+func TestBuildRoute(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, ogxiov1beta1.AddToScheme(scheme))
+
+	reconciler := controllers.NewTestReconciler(nil, scheme, &cluster.ClusterInfo{IsOpenShift: true}, nil)
+
+	newInstance := func(access *ogxiov1beta1.ExternalAccessConfig) *ogxiov1beta1.OGXServer {
+		return &ogxiov1beta1.OGXServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-ogx",
+				Namespace: "test-ns",
+				UID:       "test-uid",
+			},
+			Spec: ogxiov1beta1.OGXServerSpec{
+				Distribution: ogxiov1beta1.DistributionSpec{Name: "starter"},
+				Network:      &ogxiov1beta1.NetworkSpec{ExternalAccess: access},
+			},
+		}
+	}
+
+	t.Run("targets the server Service", func(t *testing.T) {
+		route, err := reconciler.BuildRouteForTest(newInstance(&ogxiov1beta1.ExternalAccessConfig{Enabled: true}))
+		require.NoError(t, err)
+
+		assert.Equal(t, cluster.RouteGVK, route.GroupVersionKind())
+		assert.Equal(t, "test-ogx-route", route.GetName())
+		assert.Equal(t, "test-ns", route.GetNamespace())
+		require.Len(t, route.GetOwnerReferences(), 1)
+		assert.Equal(t, "test-ogx", route.GetOwnerReferences()[0].Name)
+
+		service, _, _ := unstructured.NestedString(route.Object, "spec", "to", "name")
+		assert.Equal(t, "test-ogx-service", service)
+		targetPort, _, _ := unstructured.NestedString(route.Object, "spec", "port", "targetPort")
+		assert.Equal(t, "http", targetPort)
+		_, hasHost, _ := unstructured.NestedString(route.Object, "spec", "host")
+		assert.False(t, hasHost, "the router should generate the host when none is requested")
+		_, hasTLS, _ := unstructured.NestedMap(route.Object, "spec", "tls")
+		assert.False(t, hasTLS)
+	})
+
+	t.Run("applies hostname, TLS and annotations", func(t *testing.T) {
+		route, err := reconciler.BuildRouteForTest(newInstance(&ogxiov1beta1.ExternalAccessConfig{
+			Enabled:     true,
+			Hostname:    "ogx.apps.example.com",
+			TLS:         &ogxiov1beta1.ExternalAccessTLSConfig{Termination: ogxiov1beta1.ExternalTLSTerminationReencrypt},
+			Annotations: map[string]string{"haproxy.router.openshift.io/timeout": "5m"},
+		}))
+		require.NoError(t, err)
+
+		host, _, _ := unstructured.NestedString(route.Object, "spec", "host")
+		assert.Equal(t, "ogx.apps.example.com", host)
+		termination, _, _ := unstructured.NestedString(route.Object, "spec", "tls", "termination")
+		assert.Equal(t, "reencrypt", termination)
+		assert.Equal(t, "5m", route.GetAnnotations()["haproxy.router.openshift.io/timeout"])
+	})
+
+	t.Run("defaults TLS termination to edge", func(t *testing.T) {
+		route, err := reconciler.BuildRouteForTest(newInstance(&ogxiov1beta1.ExternalAccessConfig{
+			Enabled: true,
+			TLS:     &ogxiov1beta1.ExternalAccessTLSConfig{},
+		}))
+		require.NoError(t, err)
+
+		termination, _, _ := unstructured.NestedString(route.Object, "spec", "tls", "termination")
+		assert.Equal(t, "edge", termination)
+		policy, _, _ := unstructured.NestedString(route.Object, "spec", "tls", "insecureEdgeTerminationPolicy")
+		assert.Equal(t, "Redirect", policy)
+	})
+
+	t.Run("re-encrypts to a server serving HTTPS", func(t *testing.T) {
+		instance := newInstance(&ogxiov1beta1.ExternalAccessConfig{Enabled: true})
+		instance.Spec.Network.TLS = &ogxiov1beta1.TLSSpec{SecretName: "serving-cert"}
+		route, err := reconciler.BuildRouteForTest(instance)
+		require.NoError(t, err)
+
+		termination, _, _ := unstructured.NestedString(route.Object, "spec", "tls", "termination")
+		assert.Equal(t, "reencrypt", termination)
+
+		instance.Spec.Network.ExternalAccess.TLS = &ogxiov1beta1.ExternalAccessTLSConfig{
+			Termination: ogxiov1beta1.ExternalTLSTerminationPassthrough,
+		}
+		route, err = reconciler.BuildRouteForTest(instance)
+		require.NoError(t, err)
+		termination, _, _ = unstructured.NestedString(route.Object, "spec", "tls", "termination")
+		assert.Equal(t, "passthrough", termination, "an explicit termination is kept")
+	})
+}
@@ -0,0 +1,212 @@
+package controllers
+
+import (
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OGXServerBuilder - Builder pattern for test instances of operator custom resource.
+type OGXServerBuilder struct {
+	instance *ogxiov1beta1.OGXServer
+}
+
+func NewOGXServerBuilder() *OGXServerBuilder {
+	return &OGXServerBuilder{
+		instance: &ogxiov1beta1.OGXServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-instance",
+				Namespace: "default",
+			},
+			Spec: ogxiov1beta1.OGXServerSpec{
+				Distribution: ogxiov1beta1.DistributionSpec{
+					Name: "starter",
+				},
+			},
+		},
+	}
+}
+
+func (b *OGXServerBuilder) WithName(name string) *OGXServerBuilder {
+	b.instance.Name = name
+	return b
+}
+
+func (b *OGXServerBuilder) WithNamespace(namespace string) *OGXServerBuilder {
+	b.instance.Namespace = namespace
+	return b
+}
+
+func (b *OGXServerBuilder) WithPort(port int32) *OGXServerBuilder {
+	if b.instance.Spec.Network == nil {
+		b.instance.Spec.Network = &ogxiov1beta1.NetworkSpec{}
+	}
+	b.instance.Spec.Network.Port = port
+	return b
+}
+
+func (b *OGXServerBuilder) WithExternalAccess(access *ogxiov1beta1.ExternalAccessConfig) *OGXServerBuilder {
+	if b.instance.Spec.Network == nil {
+		b.instance.Spec.Network = &ogxiov1beta1.NetworkSpec{}
+	}
+	b.instance.Spec.Network.ExternalAccess = access
+	return b
+}
+
+func (b *OGXServerBuilder) WithReplicas(replicas int32) *OGXServerBuilder {
+	if b.instance.Spec.Workload == nil {
+		b.instance.Spec.Workload = &ogxiov1beta1.WorkloadSpec{}
+	}
+	b.instance.Spec.Workload.Replicas = &replicas
+	return b
+}
+
+func (b *OGXServerBuilder) WithStorage(storage *ogxiov1beta1.PVCStorageSpec) *OGXServerBuilder {
+	if b.instance.Spec.Workload == nil {
+		b.instance.Spec.Workload = &ogxiov1beta1.WorkloadSpec{}
+	}
+	b.instance.Spec.Workload.Storage = storage
+	return b
+}
+
+func (b *OGXServerBuilder) WithAutoscaling(autoscaling *ogxiov1beta1.AutoscalingSpec) *OGXServerBuilder {
+	if b.instance.Spec.Workload == nil {
+		b.instance.Spec.Workload = &ogxiov1beta1.WorkloadSpec{}
+	}
+	b.instance.Spec.Workload.Autoscaling = autoscaling
+	return b
+}
+
+func (b *OGXServerBuilder) WithPodDisruptionBudget(pdb *ogxiov1beta1.PodDisruptionBudgetSpec) *OGXServerBuilder {
+	if b.instance.Spec.Workload == nil {
+		b.instance.Spec.Workload = &ogxiov1beta1.WorkloadSpec{}
+	}
+	b.instance.Spec.Workload.PodDisruptionBudget = pdb
+	return b
+}
+
+func (b *OGXServerBuilder) WithDistribution(distributionName string) *OGXServerBuilder {
+	b.instance.Spec.Distribution.Name = distributionName
+	return b
+}
+
+// WithImage deploys the given image instead of a named distribution.
+func (b *OGXServerBuilder) WithImage(image string) *OGXServerBuilder {
+	b.instance.Spec.Distribution = ogxiov1beta1.DistributionSpec{Image: image}
+	return b
+}
+
+func (b *OGXServerBuilder) WithServingTLS(secretName string) *OGXServerBuilder {
+	if b.instance.Spec.Network == nil {
+		b.instance.Spec.Network = &ogxiov1beta1.NetworkSpec{}
+	}
+	b.instance.Spec.Network.TLS = &ogxiov1beta1.TLSSpec{SecretName: secretName}
+	return b
+}
+
+func (b *OGXServerBuilder) WithResources(resources corev1.ResourceRequirements) *OGXServerBuilder {
+	if b.instance.Spec.Workload == nil {
+		b.instance.Spec.Workload = &ogxiov1beta1.WorkloadSpec{}
+	}
+	b.instance.Spec.Workload.Resources = &resources
+	return b
+}
+
+func (b *OGXServerBuilder) WithServiceAccountName(serviceAccountName string) *OGXServerBuilder {
+	if b.instance.Spec.Workload == nil {
+		b.instance.Spec.Workload = &ogxiov1beta1.WorkloadSpec{}
+	}
+	if b.instance.Spec.Workload.Overrides == nil {
+		b.instance.Spec.Workload.Overrides = &ogxiov1beta1.WorkloadOverrides{}
+	}
+	b.instance.Spec.Workload.Overrides.ServiceAccountName = serviceAccountName
+	return b
+}
+
+func (b *OGXServerBuilder) WithEnvFrom(sources ...corev1.EnvFromSource) *OGXServerBuilder {
+	if b.instance.Spec.Workload == nil {
+		b.instance.Spec.Workload = &ogxiov1beta1.WorkloadSpec{}
+	}
+	if b.instance.Spec.Workload.Overrides == nil {
+		b.instance.Spec.Workload.Overrides = &ogxiov1beta1.WorkloadOverrides{}
+	}
+	b.instance.Spec.Workload.Overrides.EnvFrom = sources
+	return b
+}
+
+func (b *OGXServerBuilder) WithProviderSecrets(refs ...ogxiov1beta1.ProviderSecretRef) *OGXServerBuilder {
+	if b.instance.Spec.Workload == nil {
+		b.instance.Spec.Workload = &ogxiov1beta1.WorkloadSpec{}
+	}
+	b.instance.Spec.Workload.ProviderSecrets = refs
+	return b
+}
+
+func (b *OGXServerBuilder) WithStartupScript(configMapName, key string) *OGXServerBuilder {
+	if b.instance.Spec.Workload == nil {
+		b.instance.Spec.Workload = &ogxiov1beta1.WorkloadSpec{}
+	}
+	b.instance.Spec.Workload.StartupScriptConfigMap = &ogxiov1beta1.ConfigMapKeyRef{
+		Name: configMapName,
+		Key:  key,
+	}
+	return b
+}
+
+func (b *OGXServerBuilder) WithConfigMounts(mounts ...ogxiov1beta1.ConfigMount) *OGXServerBuilder {
+	if b.instance.Spec.Workload == nil {
+		b.instance.Spec.Workload = &ogxiov1beta1.WorkloadSpec{}
+	}
+	b.instance.Spec.Workload.AdditionalConfigMounts = mounts
+	return b
+}
+
+func (b *OGXServerBuilder) WithModelCache(modelCache *ogxiov1beta1.ModelCacheSpec) *OGXServerBuilder {
+	if b.instance.Spec.Workload == nil {
+		b.instance.Spec.Workload = &ogxiov1beta1.WorkloadSpec{}
+	}
+	b.instance.Spec.Workload.ModelCache = modelCache
+	return b
+}
+
+func (b *OGXServerBuilder) WithOverrideConfig(configMapName, key string) *OGXServerBuilder {
+	b.instance.Spec.OverrideConfig = &ogxiov1beta1.ConfigMapKeyRef{
+		Name: configMapName,
+		Key:  key,
+	}
+	return b
+}
+
+func (b *OGXServerBuilder) WithCACertificates(refs ...ogxiov1beta1.ConfigMapKeyRef) *OGXServerBuilder {
+	if b.instance.Spec.TLS == nil {
+		b.instance.Spec.TLS = &ogxiov1beta1.TLSClientConfig{}
+	}
+	if b.instance.Spec.TLS.Trust == nil {
+		b.instance.Spec.TLS.Trust = &ogxiov1beta1.TrustConfig{}
+	}
+	b.instance.Spec.TLS.Trust.CACertificates = refs
+	return b
+}
+
+func (b *OGXServerBuilder) WithCACertificateSecrets(refs ...ogxiov1beta1.SecretKeyRef) *OGXServerBuilder {
+	if b.instance.Spec.TLS == nil {
+		b.instance.Spec.TLS = &ogxiov1beta1.TLSClientConfig{}
+	}
+	if b.instance.Spec.TLS.Trust == nil {
+		b.instance.Spec.TLS.Trust = &ogxiov1beta1.TrustConfig{}
+	}
+	b.instance.Spec.TLS.Trust.CACertificateSecrets = refs
+	return b
+}
+
+func (b *OGXServerBuilder) WithAnnotation(key, value string) *OGXServerBuilder {
+	if b.instance.Annotations == nil {
+		b.instance.Annotations = make(map[string]string)
+	}
+	b.instance.Annotations[key] = value
+	return b
+}
+
+func (b *OGXServerBuilder) Build() *ogxiov1beta1.OGXServer {
+	return b.instance.DeepCopy()
+}
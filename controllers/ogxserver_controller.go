@@ -19,44 +19,55 @@ package controllers
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"crypto/x509"
+	"encoding/hex"
 	"encoding/json"
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"hash"
 	"io"
+	"maps"
 	"net/http"
 	"net/url"
 	"os"
 	"slices"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
-	"github.com/google/go-containerregistry/pkg/name"
 	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
 	"github.com/ogx-ai/ogx-k8s-operator/pkg/cluster"
 	"github.com/ogx-ai/ogx-k8s-operator/pkg/deploy"
-	"gopkg.in/yaml.v3"
+	"golang.org/x/time/rate"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/kustomize/api/resmap"
 	"sigs.k8s.io/kustomize/kyaml/filesys"
+	sigsyaml "sigs.k8s.io/yaml"
 )
 
 const (
@@ -67,9 +78,11 @@ const (
 	DefaultCABundleKey             = "ca-bundle.crt"
 	CABundleVolumeName             = "ca-bundle"
 	ManagedCABundleConfigMapSuffix = "-ca-bundle"
-	ManagedCABundleKey             = "ca-bundle.crt"
+	ManagedCABundleKey             = ogxiov1beta1.ManagedCABundleKey
 	ManagedCABundleMountPath       = "/etc/ssl/certs/ca-bundle"
 	ManagedCABundleFilePath        = "/etc/ssl/certs/ca-bundle/ca-bundle.crt"
+	CABundleSourcesVolumeName      = "ca-bundle-sources"
+	CABundleSourcesMountPath       = "/etc/ssl/certs/ca-bundle-sources"
 
 	// Security limits for CA bundle processing.
 	MaxCABundleSize         = 10 * 1024 * 1024 // 10MB max total size
@@ -84,6 +97,13 @@ const (
 	WatchLabelKey = "ogx.io/watch"
 	// WatchLabelValue is the expected value for the watch label.
 	WatchLabelValue = "true"
+
+	// maxPlacementEntries bounds the number of pods recorded in status.placement.
+	maxPlacementEntries = 50
+
+	// DefaultInitializingRequeueInterval is how often an Initializing server is
+	// re-checked when the operator config sets no interval.
+	DefaultInitializingRequeueInterval = 10 * time.Second
 )
 
 // OGXServerReconciler reconciles an OGXServer object.
@@ -101,12 +121,87 @@ type OGXServerReconciler struct {
 	DirectClient client.Reader
 	// Image mapping overrides
 	ImageMappingOverrides map[string]string
+	// imageOverrideRejections are the image-overrides entries that were not applied.
+	imageOverrideRejections []ImageOverrideRejection
+	// DefaultResources are operator-level container resources applied to
+	// requests and limits the OGXServer does not specify.
+	DefaultResources *corev1.ResourceRequirements
+	// DistributionDefaults are container resources and env vars applied to
+	// servers of the named distributions.
+	DistributionDefaults map[string]DistributionDefaults
+	// InitializingRequeueInterval is how often an Initializing server is
+	// re-checked. Zero uses DefaultInitializingRequeueInterval.
+	InitializingRequeueInterval time.Duration
+	// DisableClusterScopedResources skips the resources that need permissions
+	// beyond the server's namespace, so that the operator can run with
+	// namespace-only RBAC. Today this is the RoleBinding to the OpenShift SCC
+	// ClusterRole, which also needs a ClusterRole lookup.
+	DisableClusterScopedResources bool
+	// DefaultProxy is the operator-level proxy configuration used for the
+	// fields an OGXServer does not set in spec.network.proxy.
+	DefaultProxy *ogxiov1beta1.ProxySpec
 	// Cluster info
 	ClusterInfo *cluster.ClusterInfo
-	httpClient  *http.Client
+	// Recorder emits Events on OGXServer resources, e.g. for rollout rollbacks.
+	Recorder   record.EventRecorder
+	httpClient *http.Client
+	// serverQueryLimiter rate limits the provider and version queries sent to
+	// all servers, so that many servers becoming ready together are not all
+	// queried at once. Nil disables the limit.
+	serverQueryLimiter *rate.Limiter
+
+	// ReconcileFailureThreshold is the number of consecutive reconcile failures
+	// with the same error after which active retries stop. Zero disables the
+	// circuit breaker.
+	ReconcileFailureThreshold int
+	// ReconcileBackoffInterval is the periodic retry interval once the failure
+	// threshold is reached. Spec changes always trigger an immediate reconcile.
+	ReconcileBackoffInterval time.Duration
+	// ProviderErrorGracePeriod is how long providers may report errors after the
+	// server becomes ready before they are considered degraded.
+	ProviderErrorGracePeriod time.Duration
+	// ProviderQueryRetryInterval is how soon a Ready server is reconciled again
+	// when its providers could not be queried. Zero waits for the regular resync.
+	ProviderQueryRetryInterval time.Duration
+	// ConfigMapMissingGracePeriod is how long a running server is left alone
+	// after its override ConfigMap disappears before reconciliation fails.
+	ConfigMapMissingGracePeriod time.Duration
+	// ReportReconcileTimings records per-phase durations of the last reconcile
+	// in the OGXServer status.
+	ReportReconcileTimings bool
+	// PinImageDigests pins server images referenced by tag to the digest the
+	// tag points to when the image is first deployed.
+	PinImageDigests bool
+	// imageDigestResolver overrides the registry lookup used to pin images.
+	imageDigestResolver imageDigestResolver
+
+	// MaxConcurrentReconciles is the number of OGXServers reconciled in
+	// parallel. Zero uses the controller-runtime default.
+	MaxConcurrentReconciles int
 
 	// Cached operator namespace used for config refresh during reconciliation.
 	operatorNamespace string
+	// refreshMu serializes operator config refreshes.
+	refreshMu sync.Mutex
+	// configMu guards the settings read from the operator config. Reconciles
+	// copy them under it at the start and work on the copy, so that a refresh
+	// never changes them mid-reconcile.
+	configMu sync.RWMutex
+	// operatorConfigVersion is the resourceVersion of the operator config the
+	// settings were last read from.
+	operatorConfigVersion string
+	// failures tracks consecutive reconcile failures per instance.
+	failures failureTracker
+	// providerErrors tracks since when providers have reported errors per instance.
+	providerErrors durationTracker
+	// providerQueryFailures tracks since when the provider query has failed per instance.
+	providerQueryFailures durationTracker
+	// serverReady tracks since when the Deployment has been ready per instance.
+	serverReady durationTracker
+	// missingConfigMaps tracks since when the override ConfigMap has been missing per instance.
+	missingConfigMaps durationTracker
+	// servingTLSClients caches the query client of each server that serves HTTPS.
+	servingTLSClients servingTLSClientCache
 }
 
 // hasOverrideConfig checks if the instance references an override ConfigMap.
@@ -116,9 +211,16 @@ func (r *OGXServerReconciler) hasOverrideConfig(instance *ogxiov1beta1.OGXServer
 		instance.Spec.OverrideConfig.Key != ""
 }
 
-// hasCACertificates checks if the instance has TLS trust CA certificates configured.
+// hasCACertificates checks if the instance has TLS trust CA certificates configured,
+// from either ConfigMap or Secret sources.
 func (r *OGXServerReconciler) hasCACertificates(instance *ogxiov1beta1.OGXServer) bool {
-	return instance.Spec.TLS != nil && instance.Spec.TLS.Trust != nil && len(instance.Spec.TLS.Trust.CACertificates) > 0
+	return instance.Spec.TLS != nil && instance.Spec.TLS.Trust != nil &&
+		(len(instance.Spec.TLS.Trust.CACertificates) > 0 || len(instance.Spec.TLS.Trust.CACertificateSecrets) > 0)
+}
+
+// hasCACertificateSecrets checks if the instance sources TLS trust CA certificates from Secrets.
+func (r *OGXServerReconciler) hasCACertificateSecrets(instance *ogxiov1beta1.OGXServer) bool {
+	return instance.Spec.TLS != nil && instance.Spec.TLS.Trust != nil && len(instance.Spec.TLS.Trust.CACertificateSecrets) > 0
 }
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -142,6 +244,7 @@ func (r *OGXServerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	// This reads via the direct (non-cached) API client so it always gets full data,
 	// even though the informer cache strips ConfigMap data to save memory.
 	r.refreshOperatorConfig(ctx)
+	ctx = r.withOperatorSettings(ctx)
 
 	// Fetch the OGXServer instance
 	instance, err := r.fetchInstance(ctx, req.NamespacedName)
@@ -151,18 +254,50 @@ func (r *OGXServerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 
 	if instance == nil {
 		logger.V(1).Info("OGXServer resource not found, skipping reconciliation")
+		r.failures.forget(req.NamespacedName)
+		r.providerErrors.forget(req.NamespacedName)
+		r.providerQueryFailures.forget(req.NamespacedName)
+		r.serverReady.forget(req.NamespacedName)
+		r.missingConfigMaps.forget(req.NamespacedName)
+		r.servingTLSClients.forget(req.NamespacedName)
+		forgetInstanceMetrics(req.NamespacedName)
 		return ctrl.Result{}, nil
 	}
 
+	// Leave the resources alone while reconciliation is suspended. Removing
+	// the annotation triggers a reconcile through the update predicate.
+	if isReconcileSuspended(instance) {
+		r.handleReconcileSuspended(ctx, instance)
+		return ctrl.Result{}, nil
+	}
+	if IsConditionTrue(&instance.Status, ConditionTypeReconcileSuspended) {
+		logger.Info("Suspend annotation removed, resuming reconciliation")
+		SetReconcileSuspendedCondition(&instance.Status, false)
+	}
+
+	reconcileStart := time.Now()
+	r.startReconcileTimings(instance, reconcileStart)
+
 	// Reconcile all resources, storing the error for later.
 	reconcileErr := r.reconcileResources(ctx, instance)
 
-	if result, done := r.handleSentinelErrors(ctx, instance, reconcileErr); done {
+	// Creates fail while the namespace is being deleted, so stop here instead
+	// of flapping between Failed and Initializing until the CR is removed.
+	if reconcileErr != nil && r.isNamespaceTerminatingError(ctx, instance.Namespace, reconcileErr) {
+		r.handleNamespaceTerminating(ctx, instance)
+		return ctrl.Result{}, nil
+	}
+	r.clearNamespaceTerminating(ctx, instance)
+	recordReconcileOutcome(instance, reconcileErr)
+
+	if result, done := r.handleSentinelErrors(ctx, instance, reconcileStart, reconcileErr); done {
 		return result, nil
 	}
 
+	backingOff := r.updateReconcileBackoff(ctx, req.NamespacedName, instance, reconcileErr)
+
 	// Update the status, passing in any reconciliation error.
-	if statusUpdateErr := r.updateStatus(ctx, instance, reconcileErr); statusUpdateErr != nil {
+	if statusUpdateErr := r.updateStatus(ctx, instance, reconcileStart, reconcileErr); statusUpdateErr != nil {
 		// Log the status update error, but prioritize the reconciliation error for return.
 		logger.Error(statusUpdateErr, "failed to update status")
 		if reconcileErr != nil {
@@ -171,25 +306,60 @@ func (r *OGXServerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return ctrl.Result{}, statusUpdateErr
 	}
 
-	// If reconciliation failed, return the error to trigger a requeue.
+	// If reconciliation failed, return the error to trigger a requeue, unless the
+	// circuit breaker is open, in which case only retry periodically.
 	if reconcileErr != nil {
+		if backingOff {
+			return ctrl.Result{RequeueAfter: r.backoffInterval()}, nil
+		}
 		return ctrl.Result{}, reconcileErr
 	}
 
 	// Check if requeue is needed based on phase
 	if instance.Status.Phase == ogxiov1beta1.OGXServerPhaseInitializing {
-		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+		return ctrl.Result{RequeueAfter: withJitter(r.initializingRequeueInterval(ctx))}, nil
 	}
 
 	logger.Info("Successfully reconciled OGXServer")
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	return ctrl.Result{RequeueAfter: r.readyRequeueAfter(req.NamespacedName)}, nil
+}
+
+// updateReconcileBackoff records the reconcile outcome and sets the
+// ReconcileBackingOff condition. It returns true when active retries should stop.
+// Transient API errors are retried as usual and leave the failure count as is.
+func (r *OGXServerReconciler) updateReconcileBackoff(
+	ctx context.Context, key types.NamespacedName, instance *ogxiov1beta1.OGXServer, reconcileErr error,
+) bool {
+	if isTransientError(reconcileErr) {
+		return false
+	}
+	count := r.failures.record(key, instance.Generation, reconcileErr)
+	if !r.shouldBackOff(count) {
+		if GetCondition(&instance.Status, ConditionTypeReconcileBackingOff) != nil {
+			SetReconcileBackingOffCondition(&instance.Status, false, "")
+		}
+		return false
+	}
+
+	interval := r.backoffInterval()
+	log.FromContext(ctx).Info("Reconcile keeps failing with the same error, backing off",
+		"failures", count, "retryInterval", interval)
+	SetReconcileBackingOffCondition(&instance.Status, true, fmt.Sprintf(
+		"Reconcile failed %d consecutive times with the same error; retrying every %s or on spec change: %v",
+		count, interval, reconcileErr))
+	return true
 }
 
 // refreshOperatorConfig re-reads the operator config ConfigMap via the direct
-// API client and updates image mapping overrides.
+// API client and updates image mapping overrides, default resources and the
+// Initializing requeue interval. The settings are only replaced when the
+// ConfigMap changed. Reconciles in progress keep working on their snapshot.
 func (r *OGXServerReconciler) refreshOperatorConfig(ctx context.Context) {
 	logger := log.FromContext(ctx)
 
+	r.refreshMu.Lock()
+	defer r.refreshMu.Unlock()
+
 	operatorNamespace := r.operatorNamespace
 	if operatorNamespace == "" {
 		var err error
@@ -209,8 +379,74 @@ func (r *OGXServerReconciler) refreshOperatorConfig(ctx context.Context) {
 		logger.Error(err, "failed to refresh operator config")
 		return
 	}
+	if configMap.ResourceVersion != "" && configMap.ResourceVersion == r.operatorConfigVersion {
+		return
+	}
+
+	r.configMu.Lock()
+	defer r.configMu.Unlock()
+	r.operatorConfigVersion = configMap.ResourceVersion
+	r.setImageMappingOverrides(ctx, configMap.Data)
+	r.DefaultResources = ParseDefaultResources(ctx, configMap.Data)
+	r.DistributionDefaults = ParseDistributionDefaults(ctx, configMap.Data)
+	r.InitializingRequeueInterval = ParseInitializingRequeueInterval(ctx, configMap.Data)
+	r.DisableClusterScopedResources = !ParseEnableClusterScopedResources(ctx, configMap.Data)
+	r.DefaultProxy = ParseDefaultProxy(ctx, configMap.Data)
+}
+
+// operatorSettings are the settings read from the operator config, as seen by
+// one reconcile.
+type operatorSettings struct {
+	imageMappingOverrides         map[string]string
+	defaultResources              *corev1.ResourceRequirements
+	distributionDefaults          map[string]DistributionDefaults
+	initializingRequeueInterval   time.Duration
+	disableClusterScopedResources bool
+	defaultProxy                  *ogxiov1beta1.ProxySpec
+}
+
+// operatorSettingsKey is the context key of the settings snapshot of a reconcile.
+type operatorSettingsKey struct{}
+
+// withOperatorSettings returns ctx carrying a snapshot of the operator config
+// settings. A refresh replaces the settings rather than modifying them, so the
+// snapshot only needs the lock while it is taken.
+func (r *OGXServerReconciler) withOperatorSettings(ctx context.Context) context.Context {
+	return context.WithValue(ctx, operatorSettingsKey{}, r.currentOperatorSettings())
+}
+
+// operatorSettings returns the settings snapshot of the reconcile in ctx, or
+// the current settings outside of a reconcile.
+func (r *OGXServerReconciler) operatorSettings(ctx context.Context) *operatorSettings {
+	if settings, ok := ctx.Value(operatorSettingsKey{}).(*operatorSettings); ok {
+		return settings
+	}
+	if r == nil {
+		return &operatorSettings{}
+	}
+	return r.currentOperatorSettings()
+}
 
-	r.ImageMappingOverrides = ParseImageMappingOverrides(ctx, configMap.Data)
+// currentOperatorSettings copies the current settings under configMu.
+func (r *OGXServerReconciler) currentOperatorSettings() *operatorSettings {
+	r.configMu.RLock()
+	defer r.configMu.RUnlock()
+	return &operatorSettings{
+		imageMappingOverrides:         r.ImageMappingOverrides,
+		defaultResources:              r.DefaultResources,
+		distributionDefaults:          r.DistributionDefaults,
+		initializingRequeueInterval:   r.InitializingRequeueInterval,
+		disableClusterScopedResources: r.DisableClusterScopedResources,
+		defaultProxy:                  r.DefaultProxy,
+	}
+}
+
+// initializingRequeueInterval returns how often an Initializing server is re-checked.
+func (r *OGXServerReconciler) initializingRequeueInterval(ctx context.Context) time.Duration {
+	if interval := r.operatorSettings(ctx).initializingRequeueInterval; interval > 0 {
+		return interval
+	}
+	return DefaultInitializingRequeueInterval
 }
 
 // directGet reads an object via the DirectClient (non-cached) if set, otherwise
@@ -222,6 +458,38 @@ func (r *OGXServerReconciler) directGet(ctx context.Context, key types.Namespace
 	return r.Get(ctx, key, obj)
 }
 
+// persistStatus writes the status of instance. On a conflict, e.g. with a
+// write by the previous leader, the computed status is reapplied to a fresh
+// copy of the instance and retried, so the reconcile does not fail.
+func (r *OGXServerReconciler) persistStatus(ctx context.Context, instance *ogxiov1beta1.OGXServer) error {
+	status := instance.Status.DeepCopy()
+	target := instance
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		err := r.Status().Update(ctx, target)
+		if !k8serrors.IsConflict(err) {
+			return err
+		}
+		latest := &ogxiov1beta1.OGXServer{}
+		if getErr := r.directGet(ctx, client.ObjectKeyFromObject(instance), latest); getErr != nil {
+			return getErr
+		}
+		status.DeepCopyInto(&latest.Status)
+		target = latest
+		return err
+	})
+	instance.ResourceVersion = target.ResourceVersion
+	return err
+}
+
+// directList lists objects via the DirectClient (non-cached) if set, otherwise
+// falls back to the cached client.
+func (r *OGXServerReconciler) directList(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	if r.DirectClient != nil {
+		return r.DirectClient.List(ctx, list, opts...)
+	}
+	return r.List(ctx, list, opts...)
+}
+
 // fetchInstance retrieves the OGXServer instance.
 func (r *OGXServerReconciler) fetchInstance(ctx context.Context, namespacedName types.NamespacedName) (*ogxiov1beta1.OGXServer, error) {
 	logger := log.FromContext(ctx)
@@ -236,40 +504,104 @@ func (r *OGXServerReconciler) fetchInstance(ctx context.Context, namespacedName
 	return instance, nil
 }
 
-// determineKindsToExclude returns a list of resource kinds that should be excluded
-// based on the instance specification and adoption annotations.
-func (r *OGXServerReconciler) determineKindsToExclude(instance *ogxiov1beta1.OGXServer, effectivePVCName string) []string {
-	var kinds []string
+// isNamespaceTerminatingError reports whether err was caused by namespace being
+// deleted. The namespace is only read for Forbidden errors without the
+// NamespaceTerminating cause, so healthy reconciles do not pay for the lookup.
+func (r *OGXServerReconciler) isNamespaceTerminatingError(ctx context.Context, namespace string, err error) bool {
+	if k8serrors.HasStatusCause(err, corev1.NamespaceTerminatingCause) {
+		return true
+	}
+	if !k8serrors.IsForbidden(err) {
+		return false
+	}
+	terminating, lookupErr := r.isNamespaceTerminating(ctx, namespace)
+	if lookupErr != nil {
+		log.FromContext(ctx).V(1).Info("failed to get namespace, assuming it is active", "error", lookupErr)
+	}
+	return terminating
+}
 
-	if shouldExcludePVC(instance, effectivePVCName) {
-		kinds = append(kinds, "PersistentVolumeClaim")
+// isNamespaceTerminating reports whether namespace is being deleted.
+func (r *OGXServerReconciler) isNamespaceTerminating(ctx context.Context, namespace string) (bool, error) {
+	ns := &corev1.Namespace{}
+	if err := r.directGet(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+		return false, fmt.Errorf("failed to get namespace: %w", err)
 	}
+	return ns.DeletionTimestamp != nil || ns.Status.Phase == corev1.NamespaceTerminating, nil
+}
 
-	// Per-CR NetworkPolicy toggle (default: enabled)
-	if instance.Spec.Network != nil && instance.Spec.Network.Policy != nil &&
-		instance.Spec.Network.Policy.Enabled != nil && !*instance.Spec.Network.Policy.Enabled {
-		kinds = append(kinds, "NetworkPolicy")
+// clearNamespaceTerminating clears a True NamespaceTerminating condition once
+// the namespace is seen active. The condition is kept when the lookup fails.
+func (r *OGXServerReconciler) clearNamespaceTerminating(ctx context.Context, instance *ogxiov1beta1.OGXServer) {
+	if !IsConditionTrue(&instance.Status, ConditionTypeNamespaceTerminating) {
+		return
+	}
+	terminating, err := r.isNamespaceTerminating(ctx, instance.Namespace)
+	if err != nil {
+		log.FromContext(ctx).V(1).Info("failed to check whether the namespace is still terminating", "error", err)
+		return
 	}
+	if !terminating {
+		SetNamespaceTerminatingCondition(&instance.Status, false)
+	}
+}
 
-	if !needsPodDisruptionBudget(instance) {
-		kinds = append(kinds, "PodDisruptionBudget")
+// handleNamespaceTerminating records the NamespaceTerminating condition without
+// touching owned resources or probing the server, which is being torn down.
+func (r *OGXServerReconciler) handleNamespaceTerminating(ctx context.Context, instance *ogxiov1beta1.OGXServer) {
+	logger := log.FromContext(ctx)
+	key := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+	r.failures.forget(key)
+	r.providerErrors.forget(key)
+	r.providerQueryFailures.forget(key)
+	r.serverReady.forget(key)
+	r.missingConfigMaps.forget(key)
+	r.servingTLSClients.forget(key)
+	forgetInstanceMetrics(key)
+
+	if IsConditionTrue(&instance.Status, ConditionTypeNamespaceTerminating) {
+		return
 	}
 
-	if instance.Spec.Workload == nil || instance.Spec.Workload.Autoscaling == nil {
-		kinds = append(kinds, "HorizontalPodAutoscaler")
+	logger.Info("Namespace is terminating, skipping reconciliation")
+	SetNamespaceTerminatingCondition(&instance.Status, true)
+	if err := r.persistStatus(ctx, instance); err != nil {
+		logger.V(1).Info("failed to record namespace termination in status", "error", err)
 	}
+}
 
-	return kinds
+// isReconcileSuspended reports whether the instance carries the suspend annotation.
+func isReconcileSuspended(instance *ogxiov1beta1.OGXServer) bool {
+	return instance.Annotations[ogxiov1beta1.SuspendReconcileAnnotation] == "true"
 }
 
-func shouldExcludePVC(instance *ogxiov1beta1.OGXServer, effectivePVCName string) bool {
-	// Suppress PVC creation when the deployment is using an adopted PVC
-	// (either via annotation or discovered by label after annotation removal).
-	if effectivePVCName != instance.Name+"-pvc" {
-		return true
+// handleReconcileSuspended records the ReconcileSuspended condition without
+// touching owned resources or probing the server.
+func (r *OGXServerReconciler) handleReconcileSuspended(ctx context.Context, instance *ogxiov1beta1.OGXServer) {
+	logger := log.FromContext(ctx)
+	if IsConditionTrue(&instance.Status, ConditionTypeReconcileSuspended) {
+		logger.V(1).Info("Reconciliation is suspended, skipping")
+		return
 	}
 
-	return instance.Spec.Workload == nil || instance.Spec.Workload.Storage == nil
+	logger.Info("Reconciliation suspended by annotation", "annotation", ogxiov1beta1.SuspendReconcileAnnotation)
+	SetReconcileSuspendedCondition(&instance.Status, true)
+	if err := r.persistStatus(ctx, instance); err != nil {
+		logger.V(1).Info("failed to record the suspension in status", "error", err)
+	}
+}
+
+// determineKindsToExclude returns the resource kinds that should be excluded
+// based on the instance specification, the adopted PVC, whether the
+// Deployment is held after a rollback and the operator's RBAC mode.
+func (r *OGXServerReconciler) determineKindsToExclude(
+	ctx context.Context, instance *ogxiov1beta1.OGXServer, effectivePVCName string, rolloutHeld bool,
+) []string {
+	return deploy.ExcludedKinds(instance, deploy.ExcludeOptions{
+		PVCName:                       effectivePVCName,
+		RolloutHeld:                   rolloutHeld,
+		DisableClusterScopedResources: r.operatorSettings(ctx).disableClusterScopedResources,
+	})
 }
 
 // reconcileAllManifestResources applies all manifest-based resources using kustomize.
@@ -280,6 +612,8 @@ func (r *OGXServerReconciler) reconcileAllManifestResources(ctx context.Context,
 		return fmt.Errorf("failed to resolve effective PVC name: %w", err)
 	}
 
+	renderStart := time.Now()
+
 	// Build manifest context for Deployment
 	manifestCtx, err := r.buildManifestContext(ctx, instance, effectivePVCName)
 	if err != nil {
@@ -292,12 +626,19 @@ func (r *OGXServerReconciler) reconcileAllManifestResources(ctx context.Context,
 		return fmt.Errorf("failed to render manifests: %w", err)
 	}
 
-	kindsToExclude := r.determineKindsToExclude(instance, effectivePVCName)
+	held, err := r.isRolloutHeld(ctx, instance)
+	if err != nil {
+		return fmt.Errorf("failed to check rollout hold: %w", err)
+	}
+	kindsToExclude := r.determineKindsToExclude(ctx, instance, effectivePVCName, held)
 	filteredResMap, err := deploy.FilterExcludeKinds(resMap, kindsToExclude)
 	if err != nil {
 		return fmt.Errorf("failed to filter manifests: %w", err)
 	}
 
+	recordPhaseDuration(instance, reconcilePhaseRender, renderStart)
+	applyStart := time.Now()
+
 	// Delete excluded resources that might exist from previous reconciliations
 	if err := r.deleteExcludedResources(ctx, instance, kindsToExclude); err != nil {
 		return fmt.Errorf("failed to delete excluded resources: %w", err)
@@ -307,10 +648,46 @@ func (r *OGXServerReconciler) reconcileAllManifestResources(ctx context.Context,
 	if err := deploy.ApplyResources(ctx, r.Client, r.Scheme, instance, filteredResMap); err != nil {
 		return fmt.Errorf("failed to apply manifests: %w", err)
 	}
+	instance.Status.ManagedResources = managedResourceRefs(*filteredResMap)
+	if held {
+		// A held Deployment is not re-applied, but it is still managed.
+		instance.Status.ManagedResources = append(instance.Status.ManagedResources, ogxiov1beta1.ManagedResourceRef{
+			Kind:      "Deployment",
+			Name:      instance.Name,
+			Namespace: instance.Namespace,
+		})
+		sortManagedResourceRefs(instance.Status.ManagedResources)
+	}
+	recordPhaseDuration(instance, reconcilePhaseApply, applyStart)
 
 	return nil
 }
 
+// managedResourceRefs lists the resources of resMap, sorted by kind and name.
+func managedResourceRefs(resMap resmap.ResMap) []ogxiov1beta1.ManagedResourceRef {
+	resources := resMap.Resources()
+	refs := make([]ogxiov1beta1.ManagedResourceRef, 0, len(resources))
+	for _, res := range resources {
+		refs = append(refs, ogxiov1beta1.ManagedResourceRef{
+			Kind:      res.GetKind(),
+			Name:      res.GetName(),
+			Namespace: res.GetNamespace(),
+		})
+	}
+	sortManagedResourceRefs(refs)
+	return refs
+}
+
+// sortManagedResourceRefs sorts refs by kind and name.
+func sortManagedResourceRefs(refs []ogxiov1beta1.ManagedResourceRef) {
+	slices.SortFunc(refs, func(a, b ogxiov1beta1.ManagedResourceRef) int {
+		if a.Kind != b.Kind {
+			return strings.Compare(a.Kind, b.Kind)
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+}
+
 // deleteExcludedResources deletes resources that are excluded from the current reconciliation
 // but might exist from previous reconciliations.
 func (r *OGXServerReconciler) deleteExcludedResources(ctx context.Context, instance *ogxiov1beta1.OGXServer, kindsToExclude []string) error {
@@ -431,13 +808,24 @@ func (r *OGXServerReconciler) buildManifestContext(ctx context.Context, instance
 		return nil, err
 	}
 
-	resolvedImage, err := r.resolveImage(instance.Spec.Distribution)
+	resolvedImage, err := r.resolveImage(ctx, instance.Spec.Distribution)
 	if err != nil {
 		return nil, err
 	}
+	resolvedImage = r.pinImage(ctx, instance, resolvedImage)
+	instance.Status.ResolvedDistribution.ImageSource = r.getImageSource(ctx, instance.Spec.Distribution)
 
 	container := buildContainerSpec(ctx, r, instance, resolvedImage)
+	if err := validateResources(container.Resources); err != nil {
+		return nil, err
+	}
 	podSpec := configurePodStorage(ctx, r, instance, container, effectivePVCName)
+	if err := validatePodVolumes(podSpec); err != nil {
+		return nil, err
+	}
+	if err := validateServerPort(instance, container); err != nil {
+		return nil, err
+	}
 
 	// Get override ConfigMap hash if needed
 	var configMapHash string
@@ -457,11 +845,43 @@ func (r *OGXServerReconciler) buildManifestContext(ctx context.Context, instance
 		}
 	}
 
+	// Get referenced Secret hash if needed
+	var secretHash string
+	if r.hasCACertificateSecrets(instance) {
+		secretHash, err = r.getCABundleSecretHash(ctx, instance)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get CA bundle Secret hash: %w", err)
+		}
+	}
+
+	// Get the hash of Secrets and ConfigMaps read by user env vars
+	envSourceHash, err := r.getEnvSourceHash(ctx, instance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get env source hash: %w", err)
+	}
+
+	// Get the custom startup script hash if needed
+	startupScriptHash, err := r.getStartupScriptHash(ctx, instance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get startup script hash: %w", err)
+	}
+
+	// Get the additional config mounts hash if needed
+	configMountsHash, err := r.getConfigMountsHash(ctx, instance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config mounts hash: %w", err)
+	}
+
 	podSpecMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&podSpec)
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert pod spec to map: %w", err)
 	}
 
+	pvcAccessModes, err := r.getStoragePVCAccessModes(ctx, instance, effectivePVCName)
+	if err != nil {
+		return nil, err
+	}
+
 	pdbSpec := buildPodDisruptionBudgetSpec(instance)
 	hpaSpec := buildHPASpec(instance)
 
@@ -469,12 +889,37 @@ func (r *OGXServerReconciler) buildManifestContext(ctx context.Context, instance
 		ResolvedImage:           resolvedImage,
 		ConfigMapHash:           configMapHash,
 		CABundleHash:            caBundleHash,
+		SecretHash:              secretHash,
+		EnvSourceHash:           envSourceHash,
+		StartupScriptHash:       startupScriptHash,
+		ConfigMountsHash:        configMountsHash,
+		RestartedAt:             instance.Annotations[ogxiov1beta1.RestartedAtAnnotation],
 		PodSpec:                 podSpecMap,
 		PodDisruptionBudgetSpec: pdbSpec,
 		HPASpec:                 hpaSpec,
+		PVCAccessModes:          pvcAccessModes,
 	}, nil
 }
 
+// getStoragePVCAccessModes returns the access modes of the existing storage
+// PVC pvcName, or nil when storage is not configured or the PVC does not
+// exist yet. Access modes are immutable, so these rather than
+// storage.accessModes decide whether the PVC can be shared during a rollout.
+func (r *OGXServerReconciler) getStoragePVCAccessModes(ctx context.Context, instance *ogxiov1beta1.OGXServer,
+	pvcName string) ([]corev1.PersistentVolumeAccessMode, error) {
+	if instance.Spec.Workload == nil || instance.Spec.Workload.Storage == nil {
+		return nil, nil
+	}
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := r.Get(ctx, types.NamespacedName{Name: pvcName, Namespace: instance.Namespace}, pvc); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get PVC %s/%s: %w", instance.Namespace, pvcName, err)
+	}
+	return pvc.Spec.AccessModes, nil
+}
+
 // reconcileResources reconciles all resources for the OGXServer instance.
 func (r *OGXServerReconciler) reconcileResources(ctx context.Context, instance *ogxiov1beta1.OGXServer) error {
 	// Run adoption logic before manifest reconciliation so that adopted
@@ -487,8 +932,36 @@ func (r *OGXServerReconciler) reconcileResources(ctx context.Context, instance *
 		return &requeueError{after: adoptResult.requeueAfter}
 	}
 
+	if err := r.validateDistributionCatalog(ctx, instance); err != nil {
+		return err
+	}
+
+	if err := r.validateWorkloadOverrides(ctx, instance); err != nil {
+		return err
+	}
+
+	if err := r.validateStartupScript(ctx, instance); err != nil {
+		return err
+	}
+
+	if err := validateServingTLS(ctx, instance); err != nil {
+		return err
+	}
+
+	if err := r.validateExternalAccess(ctx, instance); err != nil {
+		return err
+	}
+
+	if err := r.validateConfigMounts(ctx, instance); err != nil {
+		return err
+	}
+
+	if err := r.validateModelCache(ctx, instance); err != nil {
+		return err
+	}
+
 	// Reconcile ConfigMaps first
-	if err := r.reconcileConfigMaps(ctx, instance); err != nil {
+	if err := r.checkConfigMapMissingGrace(ctx, instance, r.reconcileConfigMaps(ctx, instance)); err != nil {
 		return err
 	}
 
@@ -498,9 +971,14 @@ func (r *OGXServerReconciler) reconcileResources(ctx context.Context, instance *
 		return err
 	}
 
-	// Reconcile Ingress for external access (not part of kustomize manifests)
-	if err := r.reconcileIngress(ctx, instance); err != nil {
-		return fmt.Errorf("failed to reconcile Ingress: %w", err)
+	// Grow the PVC if the storage size was increased, as manifests never patch PVCs.
+	if err := r.reconcilePVCExpansion(ctx, instance); err != nil {
+		return err
+	}
+
+	// Reconcile the Route or Ingress for external access (not part of kustomize manifests)
+	if err := r.reconcileExternalAccess(ctx, instance); err != nil {
+		return fmt.Errorf("failed to reconcile external access: %w", err)
 	}
 
 	// Clean up adopted networking resources if the annotation was removed.
@@ -513,6 +991,66 @@ func (r *OGXServerReconciler) reconcileResources(ctx context.Context, instance *
 	return nil
 }
 
+// validateWorkloadOverrides rejects override volumes and mounts that collide with
+// operator-managed ones. The conflict is reported on the WorkloadOverridesValid
+// condition and reconciliation stops until the spec changes.
+func (r *OGXServerReconciler) validateWorkloadOverrides(ctx context.Context, instance *ogxiov1beta1.OGXServer) error {
+	conflicts := findWorkloadOverrideConflicts(ctx, r, instance)
+	if len(conflicts) == 0 {
+		if GetCondition(&instance.Status, ConditionTypeWorkloadOverridesValid) != nil {
+			SetWorkloadOverridesValidCondition(&instance.Status, true, "")
+		}
+		return nil
+	}
+
+	msg := "workload overrides conflict with operator-managed volumes: " + strings.Join(conflicts, "; ")
+	log.FromContext(ctx).Error(nil, msg)
+	SetWorkloadOverridesValidCondition(&instance.Status, false, msg)
+	return &terminalError{message: msg}
+}
+
+// validateDistributionCatalog stops name-based servers when the operator has no
+// distribution catalog, reported by CatalogAvailable, or when the catalog does
+// not contain the name, reported by DistributionResolved. The catalog is loaded
+// at startup, so an operator restart is needed to recover from an empty one.
+// Servers without a distribution name or image are stopped as well, as there
+// is nothing to deploy.
+func (r *OGXServerReconciler) validateDistributionCatalog(ctx context.Context, instance *ogxiov1beta1.OGXServer) error {
+	if !hasDistributionSource(instance.Spec.Distribution) {
+		msg := "spec.distribution is empty: " + errDistributionUnset.Error()
+		log.FromContext(ctx).Error(nil, msg)
+		SetDistributionResolvedCondition(&instance.Status, false, ReasonDistributionUnset, msg)
+		return &terminalError{message: msg}
+	}
+	if err := validateDistributionImage(instance.Spec.Distribution); err != nil {
+		log.FromContext(ctx).Error(nil, err.Error())
+		SetDistributionResolvedCondition(&instance.Status, false, ReasonDistributionInvalidImage, err.Error())
+		return &terminalError{message: err.Error()}
+	}
+
+	name := instance.Spec.Distribution.Name
+	catalogLoaded := distributionCatalogSize(r.ClusterInfo) > 0
+	resolved := name == "" || hasDistribution(r.ClusterInfo, name)
+	if (resolved || catalogLoaded) && GetCondition(&instance.Status, ConditionTypeCatalogAvailable) != nil {
+		SetCatalogAvailableCondition(&instance.Status, true, "")
+	}
+	if resolved {
+		if GetCondition(&instance.Status, ConditionTypeDistributionResolved) != nil {
+			SetDistributionResolvedCondition(&instance.Status, true, "", "")
+		}
+		return nil
+	}
+
+	err := unknownDistributionError(r.ClusterInfo, name)
+	log.FromContext(ctx).Error(nil, err.Error())
+	if catalogLoaded {
+		SetDistributionResolvedCondition(&instance.Status, false, ReasonDistributionNotFound, err.Error())
+	} else {
+		SetCatalogAvailableCondition(&instance.Status, false, err.Error())
+	}
+	return &terminalError{message: err.Error()}
+}
+
 // requeueError signals that the reconciler should requeue after a delay
 // without reporting an error to the controller runtime.
 type requeueError struct {
@@ -534,21 +1072,30 @@ func (e *terminalError) Error() string {
 }
 
 func (r *OGXServerReconciler) handleSentinelErrors(
-	ctx context.Context, instance *ogxiov1beta1.OGXServer, reconcileErr error,
+	ctx context.Context, instance *ogxiov1beta1.OGXServer, reconcileStart time.Time, reconcileErr error,
 ) (ctrl.Result, bool) {
 	logger := log.FromContext(ctx)
 
 	var requeueErr *requeueError
 	if errors.As(reconcileErr, &requeueErr) {
-		if statusUpdateErr := r.updateStatus(ctx, instance, nil); statusUpdateErr != nil {
+		if statusUpdateErr := r.updateStatus(ctx, instance, reconcileStart, nil); statusUpdateErr != nil {
 			logger.Error(statusUpdateErr, "failed to update status during adoption requeue")
 		}
 		return ctrl.Result{RequeueAfter: requeueErr.after}, true
 	}
 
+	var missingErr *configMapMissingError
+	if errors.As(reconcileErr, &missingErr) {
+		// Only persist the Degraded condition; the status checks would clear it.
+		if statusUpdateErr := r.persistStatus(ctx, instance); statusUpdateErr != nil {
+			logger.Error(statusUpdateErr, "failed to update status for missing ConfigMap")
+		}
+		return ctrl.Result{RequeueAfter: missingErr.retryAfter}, true
+	}
+
 	var termErr *terminalError
 	if errors.As(reconcileErr, &termErr) {
-		if statusUpdateErr := r.updateStatus(ctx, instance, nil); statusUpdateErr != nil {
+		if statusUpdateErr := r.updateStatus(ctx, instance, reconcileStart, nil); statusUpdateErr != nil {
 			logger.Error(statusUpdateErr, "failed to update status for terminal error")
 		}
 		return ctrl.Result{}, true
@@ -558,11 +1105,46 @@ func (r *OGXServerReconciler) handleSentinelErrors(
 }
 
 func (r *OGXServerReconciler) reconcileConfigMaps(ctx context.Context, instance *ogxiov1beta1.OGXServer) error {
-	if err := r.reconcileOverrideAndCABundleConfigMaps(ctx, instance); err != nil {
-		return err
+	err := r.reconcileOverrideAndCABundleConfigMaps(ctx, instance)
+	if err == nil {
+		err = r.reconcileManagedCABundle(ctx, instance)
 	}
+	r.updateCABundleCondition(ctx, instance, err)
+	return err
+}
+
+// caBundleError is a CA bundle validation failure with the CABundleReady
+// condition reason that describes it.
+type caBundleError struct {
+	reason string
+	err    error
+}
+
+func (e *caBundleError) Error() string {
+	return e.err.Error()
+}
+
+func (e *caBundleError) Unwrap() error {
+	return e.err
+}
 
-	return r.reconcileManagedCABundle(ctx, instance)
+// updateCABundleCondition reports CA bundle validation failures in the
+// CABundleReady condition. Other errors leave the condition unchanged, and it
+// is removed together with the bundle expiry status when no CA bundle is configured.
+func (r *OGXServerReconciler) updateCABundleCondition(ctx context.Context, instance *ogxiov1beta1.OGXServer, err error) {
+	var bundleErr *caBundleError
+	switch {
+	case errors.As(err, &bundleErr):
+		SetCABundleReadyCondition(&instance.Status, false, bundleErr.reason, err.Error())
+	case err != nil:
+		return
+	case hasAnyCABundle(ctx, r, instance):
+		SetCABundleReadyCondition(&instance.Status, true, "", "")
+	default:
+		meta.RemoveStatusCondition(&instance.Status.Conditions, ConditionTypeCABundleReady)
+		meta.RemoveStatusCondition(&instance.Status.Conditions, ConditionTypeCABundleExpiring)
+		instance.Status.CABundle = nil
+	}
 }
 
 func (r *OGXServerReconciler) reconcileOverrideAndCABundleConfigMaps(ctx context.Context, instance *ogxiov1beta1.OGXServer) error {
@@ -570,6 +1152,8 @@ func (r *OGXServerReconciler) reconcileOverrideAndCABundleConfigMaps(ctx context
 		if err := r.reconcileOverrideConfigMap(ctx, instance); err != nil {
 			return fmt.Errorf("failed to reconcile override ConfigMap: %w", err)
 		}
+	} else {
+		meta.RemoveStatusCondition(&instance.Status.Conditions, ConditionTypeOverrideConfigValid)
 	}
 
 	if r.hasCACertificates(instance) {
@@ -578,6 +1162,12 @@ func (r *OGXServerReconciler) reconcileOverrideAndCABundleConfigMaps(ctx context
 		}
 	}
 
+	if r.hasCACertificateSecrets(instance) {
+		if err := r.reconcileCABundleSecrets(ctx, instance); err != nil {
+			return fmt.Errorf("failed to reconcile CA bundle Secrets: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -616,9 +1206,9 @@ func (r *OGXServerReconciler) reconcileManagedCABundle(ctx context.Context, inst
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *OGXServerReconciler) SetupWithManager(_ context.Context, mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&ogxiov1beta1.OGXServer{}, builder.WithPredicates(predicate.Funcs{
-			UpdateFunc: r.ogxServerUpdatePredicate(mgr),
+			UpdateFunc: r.ogxServerUpdatePredicate(mgr.GetLogger()),
 		})).
 		Owns(&appsv1.Deployment{}).
 		Owns(&policyv1.PodDisruptionBudget{}).
@@ -630,14 +1220,34 @@ func (r *OGXServerReconciler) SetupWithManager(_ context.Context, mgr ctrl.Manag
 			handler.EnqueueRequestsFromMapFunc(r.mapConfigMapToReconcileRequests),
 			builder.WithPredicates(r.userConfigMapPredicate()),
 		).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.mapSecretToReconcileRequests),
+			builder.WithPredicates(r.userSecretPredicate()),
+		).
 		Owns(&networkingv1.NetworkPolicy{}).
 		Owns(&networkingv1.Ingress{}).
-		Owns(&corev1.PersistentVolumeClaim{}).
-		Complete(r)
+		Owns(&corev1.PersistentVolumeClaim{})
+
+	// Routes can only be watched where the Route API is served.
+	if r.useRoutes() {
+		b = b.Owns(newRoute())
+	}
+
+	return b.WithOptions(r.controllerOptions()).Complete(r)
 }
 
+// controllerOptions returns the options of the OGXServer controller.
+func (r *OGXServerReconciler) controllerOptions() controller.Options {
+	return controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}
+}
+
+// ogxServerChanged passes OGXServer updates that change the spec, which bumps
+// the generation, or the annotations.
+var ogxServerChanged = predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{})
+
 // ogxServerUpdatePredicate returns a predicate function for OGXServer updates.
-func (r *OGXServerReconciler) ogxServerUpdatePredicate(mgr ctrl.Manager) func(event.UpdateEvent) bool {
+func (r *OGXServerReconciler) ogxServerUpdatePredicate(logger logr.Logger) func(event.UpdateEvent) bool {
 	return func(e event.UpdateEvent) bool {
 		// Safely type assert old object
 		oldObj, ok := e.ObjectOld.(*ogxiov1beta1.OGXServer)
@@ -655,8 +1265,7 @@ func (r *OGXServerReconciler) ogxServerUpdatePredicate(mgr ctrl.Manager) func(ev
 
 		// Compare only spec, ignoring metadata and status
 		if diff := cmp.Diff(oldObjCopy.Spec, newObjCopy.Spec); diff != "" {
-			logger := mgr.GetLogger().WithValues("namespace", newObjCopy.Namespace, "name", newObjCopy.Name)
-			logger.Info("OGXServer CR spec changed")
+			logger.WithValues("namespace", newObjCopy.Namespace, "name", newObjCopy.Name).Info("OGXServer CR spec changed")
 			// Note that both the logger and fmt.Printf could appear entangled in the output
 			// but there is no simple way to avoid this (forcing the logger to flush its output).
 			// When the logger is used to print the diff the output is hard to read,
@@ -664,6 +1273,11 @@ func (r *OGXServerReconciler) ogxServerUpdatePredicate(mgr ctrl.Manager) func(ev
 			fmt.Printf("%s\n", diff)
 		}
 
+		// While the circuit breaker is open, the status writes of backed-off
+		// attempts must not trigger another reconcile before the retry interval.
+		if IsConditionTrue(&newObj.Status, ConditionTypeReconcileBackingOff) {
+			return ogxServerChanged.Update(e)
+		}
 		return true
 	}
 }
@@ -727,11 +1341,28 @@ func (r *OGXServerReconciler) instanceReferencesConfigMap(
 		return true
 	}
 
+	// Custom startup script ConfigMap (always in the CR namespace).
+	if ref := getStartupScriptRef(instance); ref != nil && ref.Name == cmName && instance.Namespace == cmNamespace {
+		return true
+	}
+
+	// Additional config mount ConfigMaps (always in the CR namespace).
+	if referencesConfigMountConfigMap(instance, cmName, cmNamespace) {
+		return true
+	}
+
 	// CA certificate source ConfigMaps.
 	if r.referencesCACertificateConfigMap(instance, cmName, cmNamespace) {
 		return true
 	}
 
+	// ConfigMaps read by user env vars.
+	if cmNamespace == instance.Namespace {
+		if _, configMaps := getEnvSourceNames(instance); slices.Contains(configMaps, cmName) {
+			return true
+		}
+	}
+
 	// ODH trusted CA bundle well-known ConfigMap (same namespace as instance).
 	if cmName == odhTrustedCABundleConfigMap && cmNamespace == instance.Namespace {
 		return true
@@ -753,6 +1384,69 @@ func (r *OGXServerReconciler) referencesCACertificateConfigMap(instance *ogxiov1
 	return false
 }
 
+// mapSecretToReconcileRequests maps a user-opted-in Secret change to the
+// OGXServer CR(s) that source CA certificates or env vars from it.
+func (r *OGXServerReconciler) mapSecretToReconcileRequests(ctx context.Context, obj client.Object) []reconcile.Request {
+	logger := log.FromContext(ctx)
+
+	secret, ok := obj.(*corev1.Secret)
+	if !ok {
+		return nil
+	}
+
+	// Referenced Secrets are namespace-scoped, same as user ConfigMaps.
+	var instances ogxiov1beta1.OGXServerList
+	if err := r.List(ctx, &instances, client.InNamespace(secret.Namespace)); err != nil {
+		logger.Error(err, "failed to list OGXServer instances for Secret mapping")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range instances.Items {
+		instance := &instances.Items[i]
+		if r.isSecretReferenced(instance, secret.Name, secret.Namespace) {
+			logger.Info("Secret change mapped to OGXServer",
+				"secret", secret.Name, "secretNamespace", secret.Namespace,
+				"instance", instance.Name, "instanceNamespace", instance.Namespace)
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      instance.Name,
+					Namespace: instance.Namespace,
+				},
+			})
+		}
+	}
+
+	return requests
+}
+
+// isSecretReferenced reports whether instance sources CA certificates, provider
+// secrets or user env vars from the named Secret.
+func (r *OGXServerReconciler) isSecretReferenced(instance *ogxiov1beta1.OGXServer, secretName, secretNamespace string) bool {
+	if secretNamespace != instance.Namespace {
+		return false
+	}
+	if secrets, _ := getEnvSourceNames(instance); slices.Contains(secrets, secretName) {
+		return true
+	}
+	if !r.hasCACertificateSecrets(instance) {
+		return false
+	}
+	for _, ref := range instance.Spec.TLS.Trust.CACertificateSecrets {
+		if ref.Name == secretName {
+			return true
+		}
+	}
+	return false
+}
+
+// userSecretPredicate returns a predicate that accepts only Secrets with the watch label.
+func (r *OGXServerReconciler) userSecretPredicate() predicate.Funcs {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return obj.GetLabels()[WatchLabelKey] == WatchLabelValue
+	})
+}
+
 // userConfigMapPredicate returns a predicate that accepts only ConfigMaps with
 // the watch label and rejects operator-managed ConfigMaps (handled by Owns()).
 func (r *OGXServerReconciler) userConfigMapPredicate() predicate.Funcs {
@@ -792,7 +1486,7 @@ func (r *OGXServerReconciler) getServerURL(instance *ogxiov1beta1.OGXServer, pat
 	port := deploy.GetServicePort(instance)
 
 	return &url.URL{
-		Scheme: "http",
+		Scheme: getServerScheme(instance),
 		Host:   fmt.Sprintf("%s.%s.svc.cluster.local:%d", serviceName, instance.Namespace, port),
 		Path:   path,
 	}
@@ -800,14 +1494,21 @@ func (r *OGXServerReconciler) getServerURL(instance *ogxiov1beta1.OGXServer, pat
 
 // getProviderInfo makes an HTTP request to the providers endpoint.
 func (r *OGXServerReconciler) getProviderInfo(ctx context.Context, instance *ogxiov1beta1.OGXServer) ([]ogxiov1beta1.ProviderInfo, error) {
-	u := r.getServerURL(instance, "/v1/providers")
+	u := r.getServerURL(instance, getAPIPath(instance, "providers"))
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create providers request: %w", err)
 	}
 
-	resp, err := r.httpClient.Do(req)
+	if err := r.waitForServerQuery(ctx); err != nil {
+		return nil, err
+	}
+	httpClient, err := r.serverHTTPClient(ctx, instance)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make providers request: %w", err)
 	}
@@ -835,14 +1536,21 @@ func (r *OGXServerReconciler) getProviderInfo(ctx context.Context, instance *ogx
 
 // getVersionInfo makes an HTTP request to the version endpoint.
 func (r *OGXServerReconciler) getVersionInfo(ctx context.Context, instance *ogxiov1beta1.OGXServer) (string, error) {
-	u := r.getServerURL(instance, "/v1/version")
+	u := r.getServerURL(instance, getAPIPath(instance, "version"))
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
 	if err != nil {
 		return "", fmt.Errorf("failed to create version request: %w", err)
 	}
 
-	resp, err := r.httpClient.Do(req)
+	if err := r.waitForServerQuery(ctx); err != nil {
+		return "", err
+	}
+	httpClient, err := r.serverHTTPClient(ctx, instance)
+	if err != nil {
+		return "", err
+	}
+	resp, err := httpClient.Do(req)
 	if err != nil {
 		return "", fmt.Errorf("failed to make version request: %w", err)
 	}
@@ -868,8 +1576,10 @@ func (r *OGXServerReconciler) getVersionInfo(ctx context.Context, instance *ogxi
 	return response.Version, nil
 }
 
-// updateStatus refreshes the OGXServer status.
-func (r *OGXServerReconciler) updateStatus(ctx context.Context, instance *ogxiov1beta1.OGXServer, reconcileErr error) error {
+// updateStatus refreshes the OGXServer status of the reconcile that started at reconcileStart.
+func (r *OGXServerReconciler) updateStatus(
+	ctx context.Context, instance *ogxiov1beta1.OGXServer, reconcileStart time.Time, reconcileErr error,
+) error {
 	logger := log.FromContext(ctx)
 	instance.Status.Version.OperatorVersion = os.Getenv("OPERATOR_VERSION")
 	// A reconciliation error is the highest priority. It overrides all other status checks.
@@ -889,14 +1599,11 @@ func (r *OGXServerReconciler) updateStatus(ctx context.Context, instance *ogxiov
 
 		if deploymentReady {
 			instance.Status.Phase = ogxiov1beta1.OGXServerPhaseReady
+			r.serverReady.observe(types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}, true, time.Now())
 
-			providers, err := r.getProviderInfo(ctx, instance)
-			if err != nil {
-				logger.Error(err, "failed to get provider info, clearing provider list")
-				instance.Status.DistributionConfig.Providers = nil
-			} else {
-				instance.Status.DistributionConfig.Providers = providers
-			}
+			probeStart := time.Now()
+			r.refreshProviderHealth(ctx, instance)
+			r.refreshModels(ctx, instance)
 
 			version, err := r.getVersionInfo(ctx, instance)
 			if err != nil {
@@ -906,18 +1613,34 @@ func (r *OGXServerReconciler) updateStatus(ctx context.Context, instance *ogxiov
 				instance.Status.Version.ServerVersion = version
 				logger.V(1).Info("Updated server version from API endpoint", "version", version)
 			}
+			recordPhaseDuration(instance, reconcilePhaseHealthProbe, probeStart)
 
 			SetHealthCheckCondition(&instance.Status, true, MessageHealthCheckPassed)
 		} else {
 			// If not ready, health can't be checked. Set condition appropriately.
-			SetHealthCheckCondition(&instance.Status, false, "Deployment not ready")
+			healthMessage := "Deployment not ready"
+			if instance.Status.Phase == ogxiov1beta1.OGXServerPhaseSuspended {
+				healthMessage = "Server is suspended"
+			}
+			SetHealthCheckCondition(&instance.Status, false, healthMessage)
 			instance.Status.DistributionConfig.Providers = nil // Clear providers
+			instance.Status.DistributionConfig.ProvidersStale = false
+			instance.Status.DistributionConfig.Models = nil
+			key := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+			r.providerErrors.forget(key)
+			r.providerQueryFailures.forget(key)
+			r.serverReady.forget(key)
 		}
+
+		r.updateServerCapabilities(ctx, instance)
 	}
 
 	// Always update the status at the end of the function.
 	instance.Status.Version.LastUpdated = metav1.NewTime(metav1.Now().UTC())
-	if err := r.Status().Update(ctx, instance); err != nil {
+	recordPhaseDuration(instance, reconcilePhaseTotal, time.Time{})
+	recordLastReconcile(instance, reconcileStart, time.Now())
+	recordStatusMetrics(instance)
+	if err := r.persistStatus(ctx, instance); err != nil {
 		return fmt.Errorf("failed to update status: %w", err)
 	}
 
@@ -931,22 +1654,28 @@ func (r *OGXServerReconciler) updateDeploymentStatus(ctx context.Context, instan
 		return false, fmt.Errorf("failed to fetch deployment for status: %w", deploymentErr)
 	}
 
+	pods, podsListed := r.listServerPods(ctx, instance)
 	deploymentReady := false
+	desiredReplicas := deploy.GetEffectiveReplicas(instance)
 
 	switch {
 	case deploymentErr != nil: // This case covers when the deployment is not found
 		instance.Status.Phase = ogxiov1beta1.OGXServerPhasePending
 		SetDeploymentReadyCondition(&instance.Status, false, MessageDeploymentPending)
+	case isSuspended(instance) && deployment.Status.ReadyReplicas == 0:
+		instance.Status.Phase = ogxiov1beta1.OGXServerPhaseSuspended
+		SetDeploymentSuspendedCondition(&instance.Status)
 	case deployment.Status.ReadyReplicas == 0:
 		instance.Status.Phase = ogxiov1beta1.OGXServerPhaseInitializing
-		SetDeploymentReadyCondition(&instance.Status, false, MessageDeploymentPending)
-	case deployment.Status.ReadyReplicas < deploy.GetEffectiveReplicas(instance):
+		SetDeploymentReadyCondition(&instance.Status, false,
+			describeRolloutProblems(MessageDeploymentPending, deployment, pods))
+	case deployment.Status.ReadyReplicas < desiredReplicas:
 		instance.Status.Phase = ogxiov1beta1.OGXServerPhaseInitializing
-		deploymentMessage := fmt.Sprintf("Deployment is scaling: %d/%d replicas ready", deployment.Status.ReadyReplicas, deploy.GetEffectiveReplicas(instance))
-		SetDeploymentReadyCondition(&instance.Status, false, deploymentMessage)
-	case deployment.Status.ReadyReplicas > deploy.GetEffectiveReplicas(instance):
+		deploymentMessage := fmt.Sprintf("Deployment is scaling: %d/%d replicas ready", deployment.Status.ReadyReplicas, desiredReplicas)
+		SetDeploymentReadyCondition(&instance.Status, false, describeRolloutProblems(deploymentMessage, deployment, pods))
+	case deployment.Status.ReadyReplicas > desiredReplicas:
 		instance.Status.Phase = ogxiov1beta1.OGXServerPhaseInitializing
-		deploymentMessage := fmt.Sprintf("Deployment is scaling down: %d/%d replicas ready", deployment.Status.ReadyReplicas, deploy.GetEffectiveReplicas(instance))
+		deploymentMessage := fmt.Sprintf("Deployment is scaling down: %d/%d replicas ready", deployment.Status.ReadyReplicas, desiredReplicas)
 		SetDeploymentReadyCondition(&instance.Status, false, deploymentMessage)
 	default:
 		instance.Status.Phase = ogxiov1beta1.OGXServerPhaseReady
@@ -954,9 +1683,107 @@ func (r *OGXServerReconciler) updateDeploymentStatus(ctx context.Context, instan
 		SetDeploymentReadyCondition(&instance.Status, true, MessageDeploymentReady)
 	}
 	instance.Status.AvailableReplicas = deployment.Status.ReadyReplicas
+	if podsListed {
+		r.updatePlacementStatus(ctx, instance, pods)
+	}
 	return deploymentReady, nil
 }
 
+// listServerPods returns the pods of instance and whether they could be listed.
+// Pods only add detail to the status, so failures are logged.
+func (r *OGXServerReconciler) listServerPods(ctx context.Context, instance *ogxiov1beta1.OGXServer) ([]corev1.Pod, bool) {
+	podList := &corev1.PodList{}
+	if err := r.directList(ctx, podList,
+		client.InNamespace(instance.Namespace),
+		client.MatchingLabels{instanceLabelKey: instance.Name},
+	); err != nil {
+		log.FromContext(ctx).V(1).Info("failed to list pods for status", "error", err)
+		return nil, false
+	}
+	return podList.Items, true
+}
+
+// updatePlacementStatus records the node of each Ready server pod when
+// spec.workload.placementStatus is set. Nodes are only read when node labels
+// are requested. Placement is informational, so node lookup failures are logged.
+func (r *OGXServerReconciler) updatePlacementStatus(ctx context.Context, instance *ogxiov1beta1.OGXServer, pods []corev1.Pod) {
+	if instance.Spec.Workload == nil || instance.Spec.Workload.PlacementStatus == nil {
+		instance.Status.Placement = nil
+		return
+	}
+	logger := log.FromContext(ctx)
+	labelKeys := instance.Spec.Workload.PlacementStatus.NodeLabels
+
+	nodeLabels := make(map[string]map[string]string)
+	instance.Status.Placement = buildPodPlacements(pods, func(nodeName string) map[string]string {
+		if len(labelKeys) == 0 {
+			return nil
+		}
+		if values, ok := nodeLabels[nodeName]; ok {
+			return values
+		}
+		node := &corev1.Node{}
+		if err := r.directGet(ctx, types.NamespacedName{Name: nodeName}, node); err != nil {
+			logger.V(1).Info("failed to get node for placement status", "node", nodeName, "error", err)
+		}
+		nodeLabels[nodeName] = selectLabels(node.Labels, labelKeys)
+		return nodeLabels[nodeName]
+	})
+}
+
+// selectLabels returns the entries of labels whose keys are listed in keys, or
+// nil when there are none.
+func selectLabels(labels map[string]string, keys []string) map[string]string {
+	var selected map[string]string
+	for _, key := range keys {
+		value, ok := labels[key]
+		if !ok {
+			continue
+		}
+		if selected == nil {
+			selected = make(map[string]string, len(keys))
+		}
+		selected[key] = value
+	}
+	return selected
+}
+
+// buildPodPlacements returns the placement of scheduled, Ready pods sorted by
+// pod name and capped at maxPlacementEntries. labelsFor resolves a node name
+// to the node labels recorded for it.
+func buildPodPlacements(pods []corev1.Pod, labelsFor func(nodeName string) map[string]string) []ogxiov1beta1.PodPlacement {
+	var placement []ogxiov1beta1.PodPlacement
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Spec.NodeName == "" || !isPodReady(pod) {
+			continue
+		}
+		placement = append(placement, ogxiov1beta1.PodPlacement{
+			PodName:    pod.Name,
+			NodeName:   pod.Spec.NodeName,
+			NodeLabels: labelsFor(pod.Spec.NodeName),
+		})
+	}
+
+	slices.SortFunc(placement, func(a, b ogxiov1beta1.PodPlacement) int {
+		return strings.Compare(a.PodName, b.PodName)
+	})
+	if len(placement) > maxPlacementEntries {
+		placement = placement[:maxPlacementEntries]
+	}
+	return placement
+}
+
+// isPodReady reports whether the pod's Ready condition is true.
+func isPodReady(pod *corev1.Pod) bool {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
 func (r *OGXServerReconciler) updateStorageStatus(ctx context.Context, instance *ogxiov1beta1.OGXServer) {
 	if instance.Spec.Workload == nil || instance.Spec.Workload.Storage == nil {
 		return
@@ -997,7 +1824,8 @@ func (r *OGXServerReconciler) updateServiceStatus(ctx context.Context, instance
 	instance.Status.ServiceURL = serviceURL.String()
 
 	// Set the external URL if external access is enabled
-	instance.Status.ExternalURL = r.getIngressURL(ctx, instance)
+	instance.Status.ExternalURL = r.getExternalURL(ctx, instance)
+	instance.Status.LoadBalancerAddress = getLoadBalancerAddress(service)
 
 	SetServiceReadyCondition(&instance.Status, true, MessageServiceReady)
 }
@@ -1013,7 +1841,9 @@ func (r *OGXServerReconciler) updateDistributionConfig(instance *ogxiov1beta1.OG
 	instance.Status.DistributionConfig.ActiveDistribution = activeDistribution
 }
 
-// reconcileOverrideConfigMap validates that the referenced override ConfigMap exists.
+// reconcileOverrideConfigMap validates that the referenced override ConfigMap
+// exists and holds a parseable config under the referenced key. The result is
+// reported on the OverrideConfigValid condition.
 func (r *OGXServerReconciler) reconcileOverrideConfigMap(ctx context.Context, instance *ogxiov1beta1.OGXServer) error {
 	logger := log.FromContext(ctx)
 
@@ -1040,27 +1870,56 @@ func (r *OGXServerReconciler) reconcileOverrideConfigMap(ctx context.Context, in
 			logger.Error(err, "Referenced override ConfigMap not found",
 				"configMapName", instance.Spec.OverrideConfig.Name,
 				"configMapNamespace", configMapNamespace)
-			return fmt.Errorf("failed to find referenced ConfigMap %s/%s", configMapNamespace, instance.Spec.OverrideConfig.Name)
+			return fmt.Errorf("%w %s/%s", errOverrideConfigMapNotFound, configMapNamespace, instance.Spec.OverrideConfig.Name)
 		}
 		return fmt.Errorf("failed to fetch ConfigMap %s/%s: %w", configMapNamespace, instance.Spec.OverrideConfig.Name, err)
 	}
-	if _, exists := configMap.Data[instance.Spec.OverrideConfig.Key]; !exists {
-		return fmt.Errorf(
+	data, exists := configMap.Data[instance.Spec.OverrideConfig.Key]
+	if !exists {
+		err := fmt.Errorf(
 			"failed to find override ConfigMap key '%s' in ConfigMap %s/%s",
 			instance.Spec.OverrideConfig.Key,
 			configMapNamespace,
 			instance.Spec.OverrideConfig.Name,
 		)
+		SetOverrideConfigValidCondition(&instance.Status, false, err.Error())
+		return err
 	}
+	if err := validateOverrideConfig(data); err != nil {
+		msg := fmt.Sprintf("override ConfigMap %s/%s key '%s' is not a valid config: %v",
+			configMapNamespace, instance.Spec.OverrideConfig.Name, instance.Spec.OverrideConfig.Key, err)
+		SetOverrideConfigValidCondition(&instance.Status, false, msg)
+		return errors.New(msg)
+	}
+	SetOverrideConfigValidCondition(&instance.Status, true, "")
 
 	logger.V(1).Info("Override ConfigMap found and validated",
 		"configMap", configMap.Name,
 		"namespace", configMap.Namespace,
 		"key", instance.Spec.OverrideConfig.Key,
 		"dataKeys", len(configMap.Data))
+	r.warnOnConfigKeyMismatch(ctx, instance)
 	return nil
 }
 
+// warnOnConfigKeyMismatch emits a Warning Event when the override ConfigMap key
+// is not the config filename the distribution expects. The key is still mounted
+// under the expected filename, but commands that read the key name would
+// silently run without the override.
+func (r *OGXServerReconciler) warnOnConfigKeyMismatch(ctx context.Context, instance *ogxiov1beta1.OGXServer) {
+	key := instance.Spec.OverrideConfig.Key
+	expected := getConfigFileName(r, instance)
+	if key == expected {
+		return
+	}
+
+	msg := fmt.Sprintf("override ConfigMap %q key %q does not match the config file %q expected by the distribution; "+
+		"it is mounted as %s", instance.Spec.OverrideConfig.Name, key, expected, getConfigPath(r, instance))
+	log.FromContext(ctx).Info("Override config key does not match the expected config filename",
+		"key", key, "expectedFileName", expected)
+	r.recordEvent(instance, corev1.EventTypeWarning, ReasonConfigKeyMismatch, msg)
+}
+
 // reconcileCABundleConfigMap validates that referenced CA certificate ConfigMaps exist.
 func (r *OGXServerReconciler) reconcileCABundleConfigMap(ctx context.Context, instance *ogxiov1beta1.OGXServer) error {
 	logger := log.FromContext(ctx)
@@ -1086,7 +1945,9 @@ func (r *OGXServerReconciler) reconcileCABundleConfigMap(ctx context.Context, in
 				logger.Error(err, "Referenced CA certificate ConfigMap not found",
 					"configMapName", ref.Name,
 					"configMapNamespace", instance.Namespace)
-				return fmt.Errorf("failed to find referenced CA certificate ConfigMap %s/%s", instance.Namespace, ref.Name)
+				recordCABundleValidationFailure(instance)
+				return &caBundleError{reason: ReasonCABundleNotFound, err: fmt.Errorf(
+					"failed to find referenced CA certificate ConfigMap %s/%s", instance.Namespace, ref.Name)}
 			}
 			return fmt.Errorf("failed to fetch CA certificate ConfigMap %s/%s: %w", instance.Namespace, ref.Name, err)
 		}
@@ -1097,7 +1958,9 @@ func (r *OGXServerReconciler) reconcileCABundleConfigMap(ctx context.Context, in
 				"configMapName", ref.Name,
 				"configMapNamespace", instance.Namespace,
 				"key", ref.Key)
-			return fmt.Errorf("failed to find CA certificate key '%s' in ConfigMap %s/%s", ref.Key, instance.Namespace, ref.Name)
+			recordCABundleValidationFailure(instance)
+			return &caBundleError{reason: ReasonCABundleKeyMissing, err: fmt.Errorf(
+				"failed to find CA certificate key '%s' in ConfigMap %s/%s", ref.Key, instance.Namespace, ref.Name)}
 		}
 
 		logger.V(1).Info("CA certificate ConfigMap key found",
@@ -1111,6 +1974,45 @@ func (r *OGXServerReconciler) reconcileCABundleConfigMap(ctx context.Context, in
 	return nil
 }
 
+// reconcileCABundleSecrets validates that referenced CA certificate Secrets exist.
+func (r *OGXServerReconciler) reconcileCABundleSecrets(ctx context.Context, instance *ogxiov1beta1.OGXServer) error {
+	logger := log.FromContext(ctx)
+
+	for _, ref := range instance.Spec.TLS.Trust.CACertificateSecrets {
+		logger.V(1).Info("Validating referenced CA certificate Secret exists",
+			"secretName", ref.Name,
+			"secretKey", ref.Key,
+			"secretNamespace", instance.Namespace)
+
+		secret := &corev1.Secret{}
+		err := r.directGet(ctx, types.NamespacedName{
+			Name:      ref.Name,
+			Namespace: instance.Namespace,
+		}, secret)
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				logger.Error(err, "Referenced CA certificate Secret not found",
+					"secretName", ref.Name,
+					"secretNamespace", instance.Namespace)
+				recordCABundleValidationFailure(instance)
+				return &caBundleError{reason: ReasonCABundleNotFound, err: fmt.Errorf(
+					"failed to find referenced CA certificate Secret %s/%s", instance.Namespace, ref.Name)}
+			}
+			return fmt.Errorf("failed to fetch CA certificate Secret %s/%s: %w", instance.Namespace, ref.Name, err)
+		}
+
+		if _, exists := secret.Data[ref.Key]; !exists {
+			recordCABundleValidationFailure(instance)
+			return &caBundleError{reason: ReasonCABundleKeyMissing, err: fmt.Errorf(
+				"failed to find CA certificate key '%s' in Secret %s/%s", ref.Key, instance.Namespace, ref.Name)}
+		}
+	}
+
+	logger.V(1).Info("All CA certificate Secrets validated",
+		"count", len(instance.Spec.TLS.Trust.CACertificateSecrets))
+	return nil
+}
+
 // getConfigMapHash calculates a hash of the ConfigMap data to detect changes.
 func (r *OGXServerReconciler) getConfigMapHash(ctx context.Context, instance *ogxiov1beta1.OGXServer) (string, error) {
 	if !r.hasOverrideConfig(instance) {
@@ -1128,8 +2030,115 @@ func (r *OGXServerReconciler) getConfigMapHash(ctx context.Context, instance *og
 		return "", err
 	}
 
-	// Create a content-based hash that will change when the ConfigMap data changes
-	return fmt.Sprintf("%s-%s", configMap.ResourceVersion, configMap.Name), nil
+	return configMapContentHash(configMap), nil
+}
+
+// configMapContentHash returns a sha256 hash of the data and binary data of a
+// ConfigMap. Unlike the resourceVersion, it does not change on metadata-only
+// updates such as new labels, which would otherwise restart the server.
+func configMapContentHash(configMap *corev1.ConfigMap) string {
+	hash := sha256.New()
+	writeSourceContent(hash, configMap.Data, configMap.BinaryData)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// writeSourceContent writes the data and binary data of a ConfigMap or Secret
+// to w in key order, with the length of each value, so that equal content
+// always hashes the same and different content cannot collide.
+func writeSourceContent(w io.Writer, data map[string]string, binaryData map[string][]byte) {
+	for _, key := range slices.Sorted(maps.Keys(data)) {
+		fmt.Fprintf(w, "data\x00%s\x00%d\x00%s", key, len(data[key]), data[key])
+	}
+	for _, key := range slices.Sorted(maps.Keys(binaryData)) {
+		fmt.Fprintf(w, "binaryData\x00%s\x00%d\x00", key, len(binaryData[key]))
+		_, _ = w.Write(binaryData[key])
+	}
+}
+
+// sourceContentHasher builds a sha256 hash of the content of several
+// ConfigMaps and Secrets. Like configMapContentHash, it ignores metadata.
+type sourceContentHasher struct {
+	hash    hash.Hash
+	sources int
+}
+
+func newSourceContentHasher() *sourceContentHasher {
+	return &sourceContentHasher{hash: sha256.New()}
+}
+
+func (h *sourceContentHasher) addConfigMap(configMap *corev1.ConfigMap) {
+	fmt.Fprintf(h.hash, "configmap\x00%s\x00%d\x00%d\x00",
+		configMap.Name, len(configMap.Data), len(configMap.BinaryData))
+	writeSourceContent(h.hash, configMap.Data, configMap.BinaryData)
+	h.sources++
+}
+
+func (h *sourceContentHasher) addSecret(secret *corev1.Secret) {
+	fmt.Fprintf(h.hash, "secret\x00%s\x00%d\x00", secret.Name, len(secret.Data))
+	writeSourceContent(h.hash, nil, secret.Data)
+	h.sources++
+}
+
+// sum returns the hex-encoded hash, or "" when no source was added.
+func (h *sourceContentHasher) sum() string {
+	if h.sources == 0 {
+		return ""
+	}
+	return hex.EncodeToString(h.hash.Sum(nil))
+}
+
+// getCABundleSecretHash returns a sha256 hash of the data of the referenced CA
+// certificate Secrets, so that changes to their data restart the server.
+func (r *OGXServerReconciler) getCABundleSecretHash(ctx context.Context, instance *ogxiov1beta1.OGXServer) (string, error) {
+	if !r.hasCACertificateSecrets(instance) {
+		return "", nil
+	}
+
+	hasher := newSourceContentHasher()
+	for _, ref := range instance.Spec.TLS.Trust.CACertificateSecrets {
+		secret := &corev1.Secret{}
+		err := r.directGet(ctx, types.NamespacedName{
+			Name:      ref.Name,
+			Namespace: instance.Namespace,
+		}, secret)
+		if err != nil {
+			return "", err
+		}
+		hasher.addSecret(secret)
+	}
+
+	return hasher.sum(), nil
+}
+
+// getEnvSourceHash returns a sha256 hash of the data of the Secrets and
+// ConfigMaps read by provider secrets and user env vars. Missing sources are
+// skipped, so that creating one later also changes the hash.
+func (r *OGXServerReconciler) getEnvSourceHash(ctx context.Context, instance *ogxiov1beta1.OGXServer) (string, error) {
+	secrets, configMaps := getEnvSourceNames(instance)
+
+	hasher := newSourceContentHasher()
+	for _, name := range secrets {
+		secret := &corev1.Secret{}
+		if err := r.directGet(ctx, types.NamespacedName{Name: name, Namespace: instance.Namespace}, secret); err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return "", err
+		}
+		hasher.addSecret(secret)
+	}
+	for _, name := range configMaps {
+		configMap := &corev1.ConfigMap{}
+		if err := r.directGet(ctx, types.NamespacedName{Name: name, Namespace: instance.Namespace}, configMap); err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return "", err
+		}
+		hasher.addConfigMap(configMap)
+	}
+
+	return hasher.sum(), nil
 }
 
 // getCABundleConfigMapHash calculates a hash of the managed CA bundle ConfigMap to detect changes.
@@ -1154,8 +2163,7 @@ func (r *OGXServerReconciler) getCABundleConfigMapHash(ctx context.Context, inst
 		return "", err
 	}
 
-	// Create a content-based hash that will change when the ConfigMap data changes
-	return fmt.Sprintf("%s-%s", configMap.ResourceVersion, configMap.Name), nil
+	return configMapContentHash(configMap), nil
 }
 
 // hasODHTrustedCABundle checks if the ODH trusted CA bundle ConfigMap exists and has valid keys.
@@ -1165,63 +2173,172 @@ func (r *OGXServerReconciler) hasODHTrustedCABundle(ctx context.Context, instanc
 }
 
 // gatherCABundleData collects all CA certificate data from source ConfigMaps and concatenates them.
+// When PreserveKeys is set, the certificates of each explicit source key are also kept under
+// that key. The returned map is the data of the managed CA bundle ConfigMap.
+// It returns the managed ConfigMap data, which leaves out certificates
+// sourced from Secrets, and the full bundle the server ends up trusting.
 // This function implements security measures to prevent injection attacks:
 // - Validates PEM structure and X.509 certificate format during processing.
 // - Enforces size limits to prevent resource exhaustion.
 // - Only extracts valid CERTIFICATE blocks using PEM decoder and X.509 parser.
-func (r *OGXServerReconciler) gatherCABundleData(ctx context.Context, instance *ogxiov1beta1.OGXServer) (string, error) {
+func (r *OGXServerReconciler) gatherCABundleData(ctx context.Context, instance *ogxiov1beta1.OGXServer) (map[string]string, string, error) {
 	logger := log.FromContext(ctx)
-	collector := &certificateCollector{logger: logger}
+	collector := &certificateCollector{logger: logger, preserveKeys: preservesCABundleKeys(instance)}
 
 	if err := r.gatherExplicitCABundle(ctx, instance, collector); err != nil {
-		return "", err
+		return nil, "", err
 	}
 
 	if err := r.gatherODHCABundle(ctx, instance, collector); err != nil {
-		return "", err
+		return nil, "", err
 	}
 
-	return collector.concatenate()
+	bundle, err := collector.concatenate()
+	if err != nil {
+		return nil, "", &caBundleError{reason: ReasonCABundleInvalid, err: err}
+	}
+	data, err := collector.bundleData()
+	if err != nil {
+		return nil, "", &caBundleError{reason: ReasonCABundleInvalid, err: err}
+	}
+	return data, bundle, nil
 }
 
 type certificateCollector struct {
-	logger           logr.Logger
-	certificates     []string
-	totalSize        int
-	certificateCount int
+	logger       logr.Logger
+	certificates []string
+	// secretCertificates holds the certificates sourced from Secrets. They
+	// count towards the limits but are kept out of the managed ConfigMap, as
+	// the pod mounts the Secrets directly.
+	secretCertificates []string
+	totalSize          int
+	certificateCount   int
+	// preserveKeys enables keeping the certificates of each explicit source key.
+	preserveKeys bool
+	preserved    map[string]string
+	// preservedKeys holds every preserved key, including those of Secrets.
+	preservedKeys map[string]struct{}
+	// seen holds the certificates already collected, so that a certificate
+	// present in several sources appears only once in the combined bundle.
+	seen map[string]struct{}
 }
 
-func (c *certificateCollector) add(certs []string, size, count int, configMapName, key string) error {
+// add appends certs from one source key to the combined bundle, skipping
+// certificates already collected from another source.
+func (c *certificateCollector) add(certs []string, size, count int, sourceName, key string) error {
+	unique, err := c.collect(certs, size, count, sourceName, key)
+	if err != nil {
+		return err
+	}
+	c.certificates = append(c.certificates, unique...)
+	return nil
+}
+
+// addSecret is add for certs sourced from a Secret.
+func (c *certificateCollector) addSecret(certs []string, size, count int, sourceName, key string) error {
+	unique, err := c.collect(certs, size, count, sourceName, key)
+	if err != nil {
+		return err
+	}
+	c.secretCertificates = append(c.secretCertificates, unique...)
+	return nil
+}
+
+// collect enforces the bundle limits on certs and returns those not already collected.
+func (c *certificateCollector) collect(certs []string, size, count int, sourceName, key string) ([]string, error) {
+	if c.seen == nil {
+		c.seen = make(map[string]struct{})
+	}
+	unique := make([]string, 0, len(certs))
+	for _, cert := range certs {
+		if _, dup := c.seen[cert]; dup {
+			size -= len(cert)
+			count--
+			continue
+		}
+		c.seen[cert] = struct{}{}
+		unique = append(unique, cert)
+	}
+
 	c.totalSize += size
 	c.certificateCount += count
 
 	if c.totalSize > MaxCABundleSize {
-		return fmt.Errorf("failed to process CA bundle: total size exceeds maximum allowed size of %d bytes", MaxCABundleSize)
+		return nil, fmt.Errorf("failed to process CA bundle: total size exceeds maximum allowed size of %d bytes", MaxCABundleSize)
 	}
 
 	if c.certificateCount > MaxCABundleCertificates {
-		return fmt.Errorf("failed to process CA bundle: contains more than %d certificates (maximum allowed)", MaxCABundleCertificates)
+		return nil, fmt.Errorf("failed to process CA bundle: contains more than %d certificates (maximum allowed)", MaxCABundleCertificates)
 	}
 
-	c.certificates = append(c.certificates, certs...)
 	c.logger.V(1).Info("Processed CA bundle key",
-		"configMap", configMapName,
+		"source", sourceName,
 		"key", key,
 		"certificates", count,
+		"duplicates", len(certs)-len(unique),
 		"size", size)
 
+	return unique, nil
+}
+
+// preserve keeps certs under key when key preservation is enabled. ODH
+// auto-detected keys are never preserved, as they would shadow the combined bundle.
+func (c *certificateCollector) preserve(key string, certs []string) error {
+	if err := c.reserveKey(key); err != nil || !c.preserveKeys {
+		return err
+	}
+	if c.preserved == nil {
+		c.preserved = make(map[string]string)
+	}
+	c.preserved[key] = strings.Join(certs, "\n")
+	return nil
+}
+
+// reserveKey claims key for a preserved source when key preservation is
+// enabled. Keys of Secrets are only reserved, as the pod mounts them directly.
+func (c *certificateCollector) reserveKey(key string) error {
+	if !c.preserveKeys {
+		return nil
+	}
+	if key == ManagedCABundleKey {
+		return fmt.Errorf("failed to preserve CA bundle key '%s': the name is reserved for the combined bundle", key)
+	}
+	if _, exists := c.preservedKeys[key]; exists {
+		return fmt.Errorf("failed to preserve CA bundle key '%s': the key is referenced by more than one source", key)
+	}
+	if c.preservedKeys == nil {
+		c.preservedKeys = make(map[string]struct{})
+	}
+	c.preservedKeys[key] = struct{}{}
 	return nil
 }
 
+// bundleData returns the managed ConfigMap data: the bundle of the ConfigMap
+// sources plus any preserved keys. Certificates sourced from Secrets are left
+// out, so the bundle is empty when Secrets are the only source.
+func (c *certificateCollector) bundleData() (map[string]string, error) {
+	if len(c.certificates) == 0 && len(c.secretCertificates) == 0 {
+		return nil, errors.New("failed to find valid certificates in CA bundle sources")
+	}
+
+	data := map[string]string{ManagedCABundleKey: strings.Join(c.certificates, "\n")}
+	maps.Copy(data, c.preserved)
+	return data, nil
+}
+
+// concatenate returns the combined bundle the server trusts: the ConfigMap
+// sources followed by the Secret sources, as the ca-bundle init container
+// assembles it.
 func (c *certificateCollector) concatenate() (string, error) {
-	if len(c.certificates) == 0 {
-		return "", errors.New("failed to find valid certificates in CA bundle ConfigMaps")
+	certificates := slices.Concat(c.certificates, c.secretCertificates)
+	if len(certificates) == 0 {
+		return "", errors.New("failed to find valid certificates in CA bundle sources")
 	}
 
 	// Use strings.Builder for efficient memory usage with large bundles
 	var builder strings.Builder
-	builder.Grow(c.totalSize + len(c.certificates)) // Pre-allocate with space for newlines
-	for i, cert := range c.certificates {
+	builder.Grow(c.totalSize + len(certificates)) // Pre-allocate with space for newlines
+	for i, cert := range certificates {
 		if i > 0 {
 			builder.WriteString("\n")
 		}
@@ -1257,6 +2374,22 @@ func (r *OGXServerReconciler) gatherExplicitCABundle(ctx context.Context, instan
 		}
 	}
 
+	for _, ref := range instance.Spec.TLS.Trust.CACertificateSecrets {
+		secret := &corev1.Secret{}
+		err := r.directGet(ctx, types.NamespacedName{
+			Name:      ref.Name,
+			Namespace: instance.Namespace,
+		}, secret)
+		if err != nil {
+			return fmt.Errorf("failed to get CA certificate Secret %s/%s: %w",
+				instance.Namespace, ref.Name, err)
+		}
+
+		if err := r.processSecretKey(secret, ref.Key, collector); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -1278,22 +2411,51 @@ func (r *OGXServerReconciler) processConfigMapKeys(configMap *corev1.ConfigMap,
 	for _, key := range keys {
 		data, exists := configMap.Data[key]
 		if !exists {
-			return fmt.Errorf("failed to find CA bundle key '%s' in ConfigMap %s/%s", key, namespace, name)
+			return &caBundleError{reason: ReasonCABundleKeyMissing, err: fmt.Errorf(
+				"failed to find CA bundle key '%s' in ConfigMap %s/%s", key, namespace, name)}
 		}
 
 		certs, size, count, err := extractValidCertificates([]byte(data), key)
 		if err != nil {
-			return fmt.Errorf("failed to process CA bundle key '%s' from ConfigMap %s/%s: %w", key, namespace, name, err)
+			return &caBundleError{reason: ReasonCABundleInvalid, err: fmt.Errorf(
+				"failed to process CA bundle key '%s' from ConfigMap %s/%s: %w", key, namespace, name, err)}
 		}
 
 		if err := collector.add(certs, size, count, configMap.Name, key); err != nil {
-			return err
+			return &caBundleError{reason: ReasonCABundleInvalid, err: err}
+		}
+
+		if err := collector.preserve(key, certs); err != nil {
+			return &caBundleError{reason: ReasonCABundleInvalid, err: err}
 		}
 	}
 
 	return nil
 }
 
+func (r *OGXServerReconciler) processSecretKey(secret *corev1.Secret, key string, collector *certificateCollector) error {
+	data, exists := secret.Data[key]
+	if !exists {
+		return &caBundleError{reason: ReasonCABundleKeyMissing, err: fmt.Errorf(
+			"failed to find CA bundle key '%s' in Secret %s/%s", key, secret.Namespace, secret.Name)}
+	}
+
+	certs, size, count, err := extractValidCertificates(data, key)
+	if err != nil {
+		return &caBundleError{reason: ReasonCABundleInvalid, err: fmt.Errorf(
+			"failed to process CA bundle key '%s' from Secret %s/%s: %w", key, secret.Namespace, secret.Name, err)}
+	}
+
+	if err := collector.addSecret(certs, size, count, secret.Name, key); err != nil {
+		return &caBundleError{reason: ReasonCABundleInvalid, err: err}
+	}
+
+	if err := collector.reserveKey(key); err != nil {
+		return &caBundleError{reason: ReasonCABundleInvalid, err: err}
+	}
+	return nil
+}
+
 func (r *OGXServerReconciler) processODHConfigMapKeys(configMap *corev1.ConfigMap, keys []string, collector *certificateCollector) error {
 	for _, key := range keys {
 		data, exists := configMap.Data[key]
@@ -1311,7 +2473,7 @@ func (r *OGXServerReconciler) processODHConfigMapKeys(configMap *corev1.ConfigMa
 		}
 
 		if err := collector.add(certs, size, count, configMap.Name, key); err != nil {
-			return err
+			return &caBundleError{reason: ReasonCABundleInvalid, err: err}
 		}
 	}
 
@@ -1376,10 +2538,11 @@ func (r *OGXServerReconciler) reconcileManagedCABundleConfigMap(ctx context.Cont
 	logger := log.FromContext(ctx)
 
 	// Gather all CA certificate data
-	caBundleData, err := r.gatherCABundleData(ctx, instance)
+	caBundleData, caBundle, err := r.gatherCABundleData(ctx, instance)
 	if err != nil {
 		return fmt.Errorf("failed to gather CA bundle data: %w", err)
 	}
+	r.updateCABundleExpiry(instance, caBundle, time.Now())
 
 	managedConfigMapName := getManagedCABundleConfigMapName(instance)
 
@@ -1406,9 +2569,7 @@ func (r *OGXServerReconciler) reconcileManagedCABundleConfigMap(ctx context.Cont
 				WatchLabelKey:                  WatchLabelValue,
 			},
 		},
-		Data: map[string]string{
-			ManagedCABundleKey: caBundleData,
-		},
+		Data: caBundleData,
 	}
 
 	// Set owner reference so the ConfigMap is deleted when the OGXServer is deleted
@@ -1425,7 +2586,7 @@ func (r *OGXServerReconciler) reconcileManagedCABundleConfigMap(ctx context.Cont
 		logger.Info("Successfully created managed CA bundle ConfigMap", "configMap", managedConfigMapName)
 	} else {
 		// ConfigMap exists, update it if the data has changed
-		if existingConfigMap.Data[ManagedCABundleKey] != caBundleData {
+		if !maps.Equal(existingConfigMap.Data, caBundleData) {
 			logger.Info("Updating managed CA bundle ConfigMap", "configMap", managedConfigMapName)
 			// Use Patch instead of Update to avoid race conditions
 			patch := client.MergeFrom(existingConfigMap.DeepCopy())
@@ -1482,6 +2643,9 @@ func (r *OGXServerReconciler) detectODHTrustedCABundle(ctx context.Context, inst
 			"namespace", instance.Namespace,
 			"key", key)
 	}
+	// Sort the keys so that the combined bundle, and with it the pod template
+	// hash, does not depend on map iteration order.
+	slices.Sort(keys)
 
 	logger.V(1).Info("ODH trusted CA bundle ConfigMap detected",
 		"configMapName", odhTrustedCABundleConfigMap,
@@ -1504,17 +2668,26 @@ func NewOGXServerReconciler(ctx context.Context, client client.Client, scheme *r
 		return nil, err
 	}
 
-	imageMappingOverrides := ParseImageMappingOverrides(ctx, configMap.Data)
+	recordDistributionCatalog(ctx, clusterInfo)
 
-	return &OGXServerReconciler{
-		Client:                client,
-		Scheme:                scheme,
-		DirectClient:          directClient,
-		ImageMappingOverrides: imageMappingOverrides,
-		ClusterInfo:           clusterInfo,
-		httpClient:            &http.Client{Timeout: 5 * time.Second},
-		operatorNamespace:     operatorNamespace,
-	}, nil
+	defaultResources := ParseDefaultResources(ctx, configMap.Data)
+
+	reconciler := &OGXServerReconciler{
+		Client:                        client,
+		Scheme:                        scheme,
+		DirectClient:                  directClient,
+		DefaultResources:              defaultResources,
+		DistributionDefaults:          ParseDistributionDefaults(ctx, configMap.Data),
+		InitializingRequeueInterval:   ParseInitializingRequeueInterval(ctx, configMap.Data),
+		DisableClusterScopedResources: !ParseEnableClusterScopedResources(ctx, configMap.Data),
+		DefaultProxy:                  ParseDefaultProxy(ctx, configMap.Data),
+		ClusterInfo:                   clusterInfo,
+		httpClient:                    &http.Client{Timeout: 5 * time.Second},
+		serverQueryLimiter:            newServerQueryLimiter(),
+		operatorNamespace:             operatorNamespace,
+	}
+	reconciler.setImageMappingOverrides(ctx, configMap.Data)
+	return reconciler, nil
 }
 
 // initializeOperatorConfigMap gets or creates the operator config ConfigMap.
@@ -1561,37 +2734,82 @@ func initializeOperatorConfigMap(ctx context.Context, c client.Client, operatorN
 	return configMap, nil
 }
 
-func ParseImageMappingOverrides(ctx context.Context, configMapData map[string]string) map[string]string {
-	imageMappingOverrides := make(map[string]string)
+// ParseDefaultResources parses the default-resources key of the operator config
+// ConfigMap into container resource requirements. It returns nil when the key
+// is absent or invalid.
+func ParseDefaultResources(ctx context.Context, configMapData map[string]string) *corev1.ResourceRequirements {
 	logger := log.FromContext(ctx)
 
-	// Look for the image-overrides key in the ConfigMap data
-	if overridesYAML, exists := configMapData["image-overrides"]; exists {
-		// Parse the YAML content
-		var overrides map[string]string
-		if err := yaml.Unmarshal([]byte(overridesYAML), &overrides); err != nil {
-			// Log error but continue with empty overrides
-			logger.V(1).Info("failed to parse image-overrides YAML", "error", err)
-			return imageMappingOverrides
-		}
-
-		// Validate and copy the parsed overrides to our result map
-		for version, image := range overrides {
-			// Validate the image reference format
-			if _, err := name.ParseReference(image); err != nil {
-				logger.V(1).Info(
-					"skipping invalid image override",
-					"version", version,
-					"image", image,
-					"error", err,
-				)
-				continue
-			}
-			imageMappingOverrides[version] = image
-		}
+	resourcesYAML, exists := configMapData["default-resources"]
+	if !exists {
+		return nil
 	}
 
-	return imageMappingOverrides
+	var resources corev1.ResourceRequirements
+	if err := sigsyaml.UnmarshalStrict([]byte(resourcesYAML), &resources); err != nil {
+		// Log error but continue without operator defaults
+		logger.V(1).Info("failed to parse default-resources YAML", "error", err)
+		return nil
+	}
+
+	if err := validateResources(resources); err != nil {
+		logger.V(1).Info("ignoring invalid default-resources", "error", err)
+		return nil
+	}
+
+	return &resources
+}
+
+// ParseDefaultProxy parses the proxy key of the operator config ConfigMap.
+// It returns nil when the key is absent or invalid.
+func ParseDefaultProxy(ctx context.Context, configMapData map[string]string) *ogxiov1beta1.ProxySpec {
+	proxyYAML, exists := configMapData["proxy"]
+	if !exists {
+		return nil
+	}
+
+	var proxy ogxiov1beta1.ProxySpec
+	if err := sigsyaml.UnmarshalStrict([]byte(proxyYAML), &proxy); err != nil {
+		log.FromContext(ctx).V(1).Info("failed to parse proxy YAML", "error", err)
+		return nil
+	}
+	return &proxy
+}
+
+// ParseInitializingRequeueInterval parses the initializing-requeue-seconds key
+// of the operator config ConfigMap. It returns DefaultInitializingRequeueInterval
+// when the key is absent or not a positive number of seconds.
+func ParseInitializingRequeueInterval(ctx context.Context, configMapData map[string]string) time.Duration {
+	value, exists := configMapData["initializing-requeue-seconds"]
+	if !exists {
+		return DefaultInitializingRequeueInterval
+	}
+
+	seconds, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || seconds <= 0 {
+		log.FromContext(ctx).V(1).Info("ignoring invalid initializing-requeue-seconds, must be a positive integer",
+			"value", value)
+		return DefaultInitializingRequeueInterval
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// ParseEnableClusterScopedResources parses the enable-cluster-scoped-resources
+// key of the operator config ConfigMap. It returns true when the key is absent
+// or not a boolean.
+func ParseEnableClusterScopedResources(ctx context.Context, configMapData map[string]string) bool {
+	value, exists := configMapData["enable-cluster-scoped-resources"]
+	if !exists {
+		return true
+	}
+
+	enabled, err := strconv.ParseBool(strings.TrimSpace(value))
+	if err != nil {
+		log.FromContext(ctx).V(1).Info("ignoring invalid enable-cluster-scoped-resources, must be a boolean",
+			"value", value)
+		return true
+	}
+	return enabled
 }
 
 // NewTestReconciler creates a reconciler for testing, allowing injection of a custom http client.
@@ -1611,6 +2829,11 @@ func (r *OGXServerReconciler) MapConfigMapToReconcileRequests(ctx context.Contex
 	return r.mapConfigMapToReconcileRequests(ctx, obj)
 }
 
+// MapSecretToReconcileRequests is an exported wrapper for mapSecretToReconcileRequests, for testing.
+func (r *OGXServerReconciler) MapSecretToReconcileRequests(ctx context.Context, obj client.Object) []reconcile.Request {
+	return r.mapSecretToReconcileRequests(ctx, obj)
+}
+
 // UserConfigMapPredicate is an exported wrapper for userConfigMapPredicate, for testing.
 func (r *OGXServerReconciler) UserConfigMapPredicate() predicate.Funcs {
 	return r.userConfigMapPredicate()
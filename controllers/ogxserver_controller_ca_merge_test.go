@@ -0,0 +1,190 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestCertificateCollectorMergesSources(t *testing.T) {
+	r := &OGXServerReconciler{}
+	corporateRoot := generateTestCertPEM(t)
+	vendorIntermediate := generateTestCertPEM(t)
+
+	corporate := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "corporate-root", Namespace: "default"},
+		Data:       map[string]string{"root.crt": corporateRoot},
+	}
+	vendor := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "vendor-intermediate", Namespace: "default"},
+		Data:       map[string]string{"intermediate.crt": vendorIntermediate},
+	}
+	// The ODH bundle commonly repeats the corporate root.
+	odh := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: odhTrustedCABundleConfigMap, Namespace: "default"},
+		Data:       map[string]string{"ca-bundle.crt": corporateRoot},
+	}
+
+	collector := &certificateCollector{logger: logr.Discard()}
+	require.NoError(t, r.processConfigMapKeys(corporate, []string{"root.crt"}, "default", corporate.Name, collector))
+	require.NoError(t, r.processConfigMapKeys(vendor, []string{"intermediate.crt"}, "default", vendor.Name, collector))
+	require.NoError(t, r.processODHConfigMapKeys(odh, []string{"ca-bundle.crt"}, collector))
+
+	assert.Equal(t, []string{corporateRoot, vendorIntermediate}, collector.certificates,
+		"certificates from all sources should be merged once each, in source order")
+	assert.Equal(t, 2, collector.certificateCount)
+	assert.Equal(t, len(corporateRoot)+len(vendorIntermediate), collector.totalSize)
+
+	data, err := collector.bundleData()
+	require.NoError(t, err)
+	assert.Equal(t, corporateRoot+"\n"+vendorIntermediate, data[ManagedCABundleKey])
+}
+
+func TestCertificateCollectorComposesAllSources(t *testing.T) {
+	r := &OGXServerReconciler{}
+	configMapCert := generateTestCertPEM(t)
+	secretCert := generateTestCertPEM(t)
+	odhCert := generateTestCertPEM(t)
+
+	explicit := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "corporate-ca", Namespace: "default"},
+		Data:       map[string]string{"ca.crt": configMapCert},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "vendor-ca", Namespace: "default"},
+		Data:       map[string][]byte{"vendor.crt": []byte(secretCert)},
+	}
+	// The ODH bundle uses the same key as the explicit ConfigMap.
+	odh := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: odhTrustedCABundleConfigMap, Namespace: "default"},
+		Data:       map[string]string{"ca.crt": odhCert},
+	}
+
+	// Secret certificates are mounted rather than copied, so they are left
+	// out of the managed ConfigMap but follow its bundle in the combined one.
+	tests := []struct {
+		name          string
+		explicit      bool
+		secret        bool
+		odh           bool
+		wantConfigMap []string
+		want          []string
+	}{
+		{name: "explicit and ODH", explicit: true, odh: true,
+			wantConfigMap: []string{configMapCert, odhCert}, want: []string{configMapCert, odhCert}},
+		{name: "explicit and Secret", explicit: true, secret: true,
+			wantConfigMap: []string{configMapCert}, want: []string{configMapCert, secretCert}},
+		{name: "Secret only", secret: true,
+			wantConfigMap: nil, want: []string{secretCert}},
+		{name: "Secret and ODH", secret: true, odh: true,
+			wantConfigMap: []string{odhCert}, want: []string{odhCert, secretCert}},
+		{name: "all three", explicit: true, secret: true, odh: true,
+			wantConfigMap: []string{configMapCert, odhCert}, want: []string{configMapCert, odhCert, secretCert}},
+	}
+
+	for _, tt := range tests {
+		for _, preserveKeys := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s (preserveKeys=%t)", tt.name, preserveKeys), func(t *testing.T) {
+				collector := &certificateCollector{logger: logr.Discard(), preserveKeys: preserveKeys}
+				if tt.explicit {
+					require.NoError(t, r.processConfigMapKeys(explicit, []string{"ca.crt"}, "default", explicit.Name, collector))
+				}
+				if tt.secret {
+					require.NoError(t, r.processSecretKey(secret, "vendor.crt", collector))
+				}
+				if tt.odh {
+					require.NoError(t, r.processODHConfigMapKeys(odh, []string{"ca.crt"}, collector))
+				}
+
+				data, err := collector.bundleData()
+				require.NoError(t, err)
+				assert.Equal(t, strings.Join(tt.wantConfigMap, "\n"), data[ManagedCABundleKey])
+				assert.NotContains(t, data, "vendor.crt", "Secret keys should never be copied into the ConfigMap")
+				bundle, err := collector.concatenate()
+				require.NoError(t, err)
+				assert.Equal(t, strings.Join(tt.want, "\n"), bundle)
+				// ODH keys are never preserved, so they cannot clash with explicit keys.
+				if preserveKeys && tt.explicit {
+					assert.Equal(t, configMapCert, data["ca.crt"])
+				} else {
+					assert.NotContains(t, data, "ca.crt")
+				}
+			})
+		}
+	}
+}
+
+func TestGatherCABundleDataOrder(t *testing.T) {
+	root := generateTestCertPEM(t)
+	intermediate := generateTestCertPEM(t)
+	odhCerts := []string{generateTestCertPEM(t), generateTestCertPEM(t), generateTestCertPEM(t)}
+
+	objects := []corev1.ConfigMap{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "intermediate", Namespace: "default"},
+			Data:       map[string]string{"ca.crt": intermediate},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "root", Namespace: "default"},
+			Data:       map[string]string{"ca.crt": root},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: odhTrustedCABundleConfigMap, Namespace: "default"},
+			Data: map[string]string{
+				"c.crt": odhCerts[2],
+				"a.crt": odhCerts[0],
+				"b.crt": odhCerts[1],
+			},
+		},
+	}
+	builder := fake.NewClientBuilder()
+	for i := range objects {
+		builder = builder.WithObjects(&objects[i])
+	}
+	r := &OGXServerReconciler{Client: builder.Build()}
+
+	instance := &ogxiov1beta1.OGXServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: ogxiov1beta1.OGXServerSpec{
+			TLS: &ogxiov1beta1.TLSClientConfig{Trust: &ogxiov1beta1.TrustConfig{
+				CACertificates: []ogxiov1beta1.ConfigMapKeyRef{
+					{Name: "intermediate", Key: "ca.crt"},
+					{Name: "root", Key: "ca.crt"},
+				},
+			}},
+		},
+	}
+
+	want := strings.Join(append([]string{intermediate, root}, odhCerts...), "\n")
+	// Map iteration order varies between calls, so repeat to catch unsorted keys.
+	for range 10 {
+		data, _, err := r.gatherCABundleData(t.Context(), instance)
+		require.NoError(t, err)
+		assert.Equal(t, want, data[ManagedCABundleKey],
+			"explicit sources should keep their listed order, followed by ODH keys sorted by name")
+	}
+}
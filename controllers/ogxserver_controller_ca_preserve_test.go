@@ -0,0 +1,85 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCertificateCollectorBundleData(t *testing.T) {
+	r := &OGXServerReconciler{}
+	rootCert := generateTestCertPEM(t)
+	intermediateCert := generateTestCertPEM(t)
+
+	newConfigMap := func(name string, data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Data:       data,
+		}
+	}
+	root := newConfigMap("root", map[string]string{"root-ca.crt": rootCert})
+	intermediate := newConfigMap("intermediate", map[string]string{"intermediate.crt": intermediateCert})
+
+	t.Run("concatenates into a single key", func(t *testing.T) {
+		collector := &certificateCollector{logger: logr.Discard()}
+		require.NoError(t, r.processConfigMapKeys(root, []string{"root-ca.crt"}, "default", root.Name, collector))
+		require.NoError(t, r.processConfigMapKeys(intermediate, []string{"intermediate.crt"}, "default", intermediate.Name, collector))
+
+		data, err := collector.bundleData()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{ManagedCABundleKey: rootCert + "\n" + intermediateCert}, data)
+	})
+
+	t.Run("preserves source keys", func(t *testing.T) {
+		collector := &certificateCollector{logger: logr.Discard(), preserveKeys: true}
+		require.NoError(t, r.processConfigMapKeys(root, []string{"root-ca.crt"}, "default", root.Name, collector))
+		require.NoError(t, r.processConfigMapKeys(intermediate, []string{"intermediate.crt"}, "default", intermediate.Name, collector))
+
+		data, err := collector.bundleData()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			ManagedCABundleKey: rootCert + "\n" + intermediateCert,
+			"root-ca.crt":      rootCert,
+			"intermediate.crt": intermediateCert,
+		}, data)
+	})
+
+	t.Run("rejects duplicate keys", func(t *testing.T) {
+		other := newConfigMap("other", map[string]string{"root-ca.crt": intermediateCert})
+		collector := &certificateCollector{logger: logr.Discard(), preserveKeys: true}
+		require.NoError(t, r.processConfigMapKeys(root, []string{"root-ca.crt"}, "default", root.Name, collector))
+
+		err := r.processConfigMapKeys(other, []string{"root-ca.crt"}, "default", other.Name, collector)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "referenced by more than one source")
+	})
+
+	t.Run("rejects the combined bundle key", func(t *testing.T) {
+		bundle := newConfigMap("bundle", map[string]string{ManagedCABundleKey: rootCert})
+		collector := &certificateCollector{logger: logr.Discard(), preserveKeys: true}
+
+		err := r.processConfigMapKeys(bundle, []string{ManagedCABundleKey}, "default", bundle.Name, collector)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "reserved for the combined bundle")
+	})
+}
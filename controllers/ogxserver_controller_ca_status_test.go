@@ -0,0 +1,117 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-logr/logr"
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCABundleErrorReasons(t *testing.T) {
+	r := &OGXServerReconciler{}
+	invalidPEM := "-----BEGIN CERTIFICATE-----\nnot a certificate\n-----END CERTIFICATE-----\n"
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "corporate-ca", Namespace: "default"},
+		Data:       map[string]string{"ca.crt": generateTestCertPEM(t), "invalid.crt": invalidPEM},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "vendor-ca", Namespace: "default"},
+		Data:       map[string][]byte{"invalid.crt": []byte(invalidPEM)},
+	}
+
+	tests := []struct {
+		name       string
+		process    func(*certificateCollector) error
+		wantReason string
+	}{
+		{
+			name: "ConfigMap key missing",
+			process: func(c *certificateCollector) error {
+				return r.processConfigMapKeys(configMap, []string{"missing.crt"}, "default", configMap.Name, c)
+			},
+			wantReason: ReasonCABundleKeyMissing,
+		},
+		{
+			name: "ConfigMap key with invalid PEM",
+			process: func(c *certificateCollector) error {
+				return r.processConfigMapKeys(configMap, []string{"invalid.crt"}, "default", configMap.Name, c)
+			},
+			wantReason: ReasonCABundleInvalid,
+		},
+		{
+			name:       "Secret key missing",
+			process:    func(c *certificateCollector) error { return r.processSecretKey(secret, "missing.crt", c) },
+			wantReason: ReasonCABundleKeyMissing,
+		},
+		{
+			name:       "Secret key with invalid PEM",
+			process:    func(c *certificateCollector) error { return r.processSecretKey(secret, "invalid.crt", c) },
+			wantReason: ReasonCABundleInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.process(&certificateCollector{logger: logr.Discard()})
+			var bundleErr *caBundleError
+			require.ErrorAs(t, err, &bundleErr)
+			assert.Equal(t, tt.wantReason, bundleErr.reason)
+		})
+	}
+
+	require.NoError(t, r.processConfigMapKeys(configMap, []string{"ca.crt"}, "default", configMap.Name,
+		&certificateCollector{logger: logr.Discard()}))
+}
+
+func TestUpdateCABundleCondition(t *testing.T) {
+	r := &OGXServerReconciler{}
+	instance := &ogxiov1beta1.OGXServer{
+		Spec: ogxiov1beta1.OGXServerSpec{
+			TLS: &ogxiov1beta1.TLSClientConfig{Trust: &ogxiov1beta1.TrustConfig{
+				CACertificates: []ogxiov1beta1.ConfigMapKeyRef{{Name: "corporate-ca", Key: "ca.crt"}},
+			}},
+		},
+	}
+
+	notFound := fmt.Errorf("failed to reconcile CA bundle ConfigMap: %w", &caBundleError{
+		reason: ReasonCABundleNotFound,
+		err:    errors.New("failed to find referenced CA certificate ConfigMap default/corporate-ca"),
+	})
+	r.updateCABundleCondition(t.Context(), instance, notFound)
+	condition := GetCondition(&instance.Status, ConditionTypeCABundleReady)
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, ReasonCABundleNotFound, condition.Reason)
+	assert.Equal(t, notFound.Error(), condition.Message)
+
+	r.updateCABundleCondition(t.Context(), instance, errors.New("failed to reconcile override ConfigMap"))
+	assert.Equal(t, ReasonCABundleNotFound, GetCondition(&instance.Status, ConditionTypeCABundleReady).Reason,
+		"unrelated errors leave the condition unchanged")
+
+	r.updateCABundleCondition(t.Context(), instance, nil)
+	condition = GetCondition(&instance.Status, ConditionTypeCABundleReady)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, ReasonCABundleReady, condition.Reason)
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/ogx-ai/ogx-k8s-operator/pkg/deploy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+)
+
+func TestManagedResourceRefs(t *testing.T) {
+	instance := &ogxiov1beta1.OGXServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: ogxiov1beta1.OGXServerSpec{
+			Workload: &ogxiov1beta1.WorkloadSpec{Storage: &ogxiov1beta1.PVCStorageSpec{}},
+		},
+	}
+	resMap, err := deploy.RenderManifest(filesys.MakeFsOnDisk(), manifestsBasePath, instance)
+	require.NoError(t, err)
+	filtered, err := deploy.FilterExcludeKinds(resMap, []string{"PodDisruptionBudget", "HorizontalPodAutoscaler"})
+	require.NoError(t, err)
+
+	refs := managedResourceRefs(*filtered)
+
+	assert.Contains(t, refs, ogxiov1beta1.ManagedResourceRef{Kind: "Deployment", Name: "test", Namespace: "default"})
+	assert.Contains(t, refs, ogxiov1beta1.ManagedResourceRef{Kind: "Service", Name: "test-service", Namespace: "default"})
+	assert.Contains(t, refs, ogxiov1beta1.ManagedResourceRef{Kind: "PersistentVolumeClaim", Name: "test-pvc", Namespace: "default"},
+		"the PVC has no owner reference, so it must be listed")
+	kinds := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		kinds = append(kinds, ref.Kind)
+	}
+	assert.Contains(t, kinds, "RoleBinding")
+	assert.NotContains(t, kinds, "PodDisruptionBudget", "excluded resources are not applied")
+	assert.IsNonDecreasing(t, kinds, "refs should be sorted by kind")
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func newPlacementTestPod(name, nodeName string, ready bool) corev1.Pod {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       corev1.PodSpec{NodeName: nodeName},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+		},
+	}
+}
+
+func TestBuildPodPlacements(t *testing.T) {
+	nodeLabels := map[string]map[string]string{
+		"node-a": {"topology.kubernetes.io/zone": "zone-1"},
+		"node-b": {"topology.kubernetes.io/zone": "zone-2"},
+	}
+	labelsFor := func(nodeName string) map[string]string { return nodeLabels[nodeName] }
+
+	t.Run("records ready scheduled pods sorted by name", func(t *testing.T) {
+		pods := []corev1.Pod{
+			newPlacementTestPod("pod-b", "node-b", true),
+			newPlacementTestPod("pod-a", "node-a", true),
+			newPlacementTestPod("pod-unready", "node-a", false),
+			newPlacementTestPod("pod-pending", "", true),
+		}
+
+		placement := buildPodPlacements(pods, labelsFor)
+
+		assert.Equal(t, []ogxiov1beta1.PodPlacement{
+			{PodName: "pod-a", NodeName: "node-a", NodeLabels: map[string]string{"topology.kubernetes.io/zone": "zone-1"}},
+			{PodName: "pod-b", NodeName: "node-b", NodeLabels: map[string]string{"topology.kubernetes.io/zone": "zone-2"}},
+		}, placement)
+	})
+
+	t.Run("node without requested labels", func(t *testing.T) {
+		placement := buildPodPlacements([]corev1.Pod{newPlacementTestPod("pod-a", "node-c", true)}, labelsFor)
+
+		require.Len(t, placement, 1)
+		assert.Equal(t, "node-c", placement[0].NodeName)
+		assert.Empty(t, placement[0].NodeLabels)
+	})
+
+	t.Run("no ready pods", func(t *testing.T) {
+		assert.Nil(t, buildPodPlacements([]corev1.Pod{newPlacementTestPod("pod-a", "node-a", false)}, labelsFor))
+	})
+
+	t.Run("bounded number of entries", func(t *testing.T) {
+		pods := make([]corev1.Pod, 0, maxPlacementEntries+10)
+		for i := range maxPlacementEntries + 10 {
+			pods = append(pods, newPlacementTestPod(fmt.Sprintf("pod-%03d", i), "node-a", true))
+		}
+
+		placement := buildPodPlacements(pods, labelsFor)
+
+		assert.Len(t, placement, maxPlacementEntries)
+		assert.Equal(t, "pod-000", placement[0].PodName)
+	})
+}
+
+func TestUpdatePlacementStatus(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name: "node-a",
+		Labels: map[string]string{
+			"topology.kubernetes.io/zone":   "zone-1",
+			"topology.kubernetes.io/region": "region-1",
+		},
+	}}
+	pods := []corev1.Pod{newPlacementTestPod("pod-a", "node-a", true)}
+
+	t.Run("not requested", func(t *testing.T) {
+		r := &OGXServerReconciler{Client: fake.NewClientBuilder().Build()}
+		instance := &ogxiov1beta1.OGXServer{
+			Status: ogxiov1beta1.OGXServerStatus{Placement: []ogxiov1beta1.PodPlacement{{PodName: "old"}}},
+		}
+
+		r.updatePlacementStatus(t.Context(), instance, pods)
+
+		assert.Nil(t, instance.Status.Placement)
+	})
+
+	t.Run("node names only", func(t *testing.T) {
+		// No Node object exists, so a lookup would leave the labels empty as well;
+		// the client interceptor fails the test if one is attempted.
+		r := &OGXServerReconciler{Client: fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(_ context.Context, _ client.WithWatch, key client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
+				t.Errorf("unexpected Get of %s", key)
+				return nil
+			},
+		}).Build()}
+		instance := &ogxiov1beta1.OGXServer{Spec: ogxiov1beta1.OGXServerSpec{
+			Workload: &ogxiov1beta1.WorkloadSpec{PlacementStatus: &ogxiov1beta1.PlacementStatusSpec{}},
+		}}
+
+		r.updatePlacementStatus(t.Context(), instance, pods)
+
+		assert.Equal(t, []ogxiov1beta1.PodPlacement{{PodName: "pod-a", NodeName: "node-a"}}, instance.Status.Placement)
+	})
+
+	t.Run("requested node labels", func(t *testing.T) {
+		r := &OGXServerReconciler{Client: fake.NewClientBuilder().WithObjects(node).Build()}
+		instance := &ogxiov1beta1.OGXServer{Spec: ogxiov1beta1.OGXServerSpec{
+			Workload: &ogxiov1beta1.WorkloadSpec{PlacementStatus: &ogxiov1beta1.PlacementStatusSpec{
+				NodeLabels: []string{"topology.kubernetes.io/zone", "example.com/missing"},
+			}},
+		}}
+
+		r.updatePlacementStatus(t.Context(), instance, pods)
+
+		assert.Equal(t, []ogxiov1beta1.PodPlacement{{
+			PodName:    "pod-a",
+			NodeName:   "node-a",
+			NodeLabels: map[string]string{"topology.kubernetes.io/zone": "zone-1"},
+		}}, instance.Status.Placement)
+	})
+}
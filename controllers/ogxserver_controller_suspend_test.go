@@ -0,0 +1,83 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestIsReconcileSuspended(t *testing.T) {
+	testCases := []struct {
+		name        string
+		annotations map[string]string
+		want        bool
+	}{
+		{name: "no annotations", want: false},
+		{name: "suspended", annotations: map[string]string{ogxiov1beta1.SuspendReconcileAnnotation: "true"}, want: true},
+		{name: "explicitly not suspended", annotations: map[string]string{ogxiov1beta1.SuspendReconcileAnnotation: "false"}, want: false},
+		{name: "unrecognized value", annotations: map[string]string{ogxiov1beta1.SuspendReconcileAnnotation: "yes"}, want: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := &ogxiov1beta1.OGXServer{ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations}}
+			assert.Equal(t, tc.want, isReconcileSuspended(instance))
+		})
+	}
+}
+
+func TestHandleReconcileSuspended(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, ogxiov1beta1.AddToScheme(scheme))
+
+	instance := &ogxiov1beta1.OGXServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test",
+			Namespace:   "default",
+			Annotations: map[string]string{ogxiov1beta1.SuspendReconcileAnnotation: "true"},
+		},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(instance).
+		WithStatusSubresource(&ogxiov1beta1.OGXServer{}).
+		Build()
+	r := &OGXServerReconciler{Client: c, Scheme: scheme}
+
+	r.handleReconcileSuspended(t.Context(), instance)
+
+	stored := &ogxiov1beta1.OGXServer{}
+	require.NoError(t, c.Get(t.Context(), types.NamespacedName{Name: "test", Namespace: "default"}, stored))
+	condition := GetCondition(&stored.Status, ConditionTypeReconcileSuspended)
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, ReasonReconcileSuspended, condition.Reason)
+
+	// A second pass leaves the recorded condition alone.
+	transition := condition.LastTransitionTime
+	r.handleReconcileSuspended(t.Context(), stored)
+	require.NoError(t, c.Get(t.Context(), types.NamespacedName{Name: "test", Namespace: "default"}, stored))
+	assert.True(t, GetCondition(&stored.Status, ConditionTypeReconcileSuspended).LastTransitionTime.Equal(&transition))
+}
@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -14,15 +16,23 @@ import (
 	"github.com/ogx-ai/ogx-k8s-operator/pkg/cluster"
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -212,6 +222,137 @@ func TestDefaultPVCLifecycle(t *testing.T) {
 	AssertDeploymentUsesPVCStorage(t, deployment, pvcName)
 }
 
+func TestHorizontalPodAutoscalerLifecycle(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	// --- arrange ---
+	namespace := createTestNamespace(t, "hpa-lifecycle")
+	hpaKey := types.NamespacedName{Name: "hpa-test-hpa", Namespace: namespace.Name}
+	crKey := types.NamespacedName{Name: "hpa-test", Namespace: namespace.Name}
+
+	// --- act: create OGXServer with autoscaling ---
+	instance := NewOGXServerBuilder().
+		WithName("hpa-test").
+		WithNamespace(namespace.Name).
+		WithAutoscaling(&ogxiov1beta1.AutoscalingSpec{
+			MinReplicas: ptr.To(int32(1)),
+			MaxReplicas: 3,
+		}).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+	t.Cleanup(func() {
+		if err := k8sClient.Delete(t.Context(), instance); err != nil && !apierrors.IsNotFound(err) {
+			t.Logf("Cleanup: %v", err)
+		}
+	})
+
+	ReconcileOGXServer(t, instance)
+
+	// --- assert: HPA created, owned by the CR and targeting the Deployment ---
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	waitForResourceWithKey(t, k8sClient, hpaKey, hpa)
+	require.Equal(t, ptr.To(int32(1)), hpa.Spec.MinReplicas)
+	require.Equal(t, int32(3), hpa.Spec.MaxReplicas)
+	require.Equal(t, "Deployment", hpa.Spec.ScaleTargetRef.Kind)
+	require.Equal(t, "hpa-test", hpa.Spec.ScaleTargetRef.Name)
+	require.True(t, metav1.IsControlledBy(hpa, instance),
+		"HPA should be controlled by the OGXServer")
+
+	// --- act: update autoscaling bounds ---
+	require.NoError(t, k8sClient.Get(t.Context(), crKey, instance))
+	instance.Spec.Workload.Autoscaling.MinReplicas = ptr.To(int32(2))
+	instance.Spec.Workload.Autoscaling.MaxReplicas = 5
+	require.NoError(t, k8sClient.Update(t.Context(), instance))
+
+	ReconcileOGXServer(t, instance)
+
+	// --- assert: HPA bounds follow the spec ---
+	waitForResourceWithKeyAndCondition(t, k8sClient, hpaKey, hpa, func() bool {
+		return hpa.Spec.MaxReplicas == 5 && hpa.Spec.MinReplicas != nil && *hpa.Spec.MinReplicas == 2
+	}, "HPA bounds should be updated to min 2, max 5")
+
+	// --- act: disable autoscaling ---
+	require.NoError(t, k8sClient.Get(t.Context(), crKey, instance))
+	instance.Spec.Workload.Autoscaling = nil
+	require.NoError(t, k8sClient.Update(t.Context(), instance))
+
+	ReconcileOGXServer(t, instance)
+
+	// --- assert: HPA removed ---
+	require.Eventually(t, func() bool {
+		return apierrors.IsNotFound(k8sClient.Get(t.Context(), hpaKey, &autoscalingv2.HorizontalPodAutoscaler{}))
+	}, testTimeout, testInterval, "HPA should be deleted once autoscaling is disabled")
+}
+
+func TestPodDisruptionBudgetLifecycle(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	// --- arrange ---
+	namespace := createTestNamespace(t, "pdb-lifecycle")
+	pdbKey := types.NamespacedName{Name: "pdb-test-pdb", Namespace: namespace.Name}
+	serviceKey := types.NamespacedName{Name: "pdb-test-service", Namespace: namespace.Name}
+	crKey := types.NamespacedName{Name: "pdb-test", Namespace: namespace.Name}
+
+	// --- act: create a single-replica OGXServer with an explicit PDB ---
+	instance := NewOGXServerBuilder().
+		WithName("pdb-test").
+		WithNamespace(namespace.Name).
+		WithReplicas(1).
+		WithPodDisruptionBudget(&ogxiov1beta1.PodDisruptionBudgetSpec{
+			MinAvailable: ptr.To(intstr.FromInt32(1)),
+		}).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+	t.Cleanup(func() {
+		if err := k8sClient.Delete(t.Context(), instance); err != nil && !apierrors.IsNotFound(err) {
+			t.Logf("Cleanup: %v", err)
+		}
+	})
+
+	ReconcileOGXServer(t, instance)
+
+	// --- assert: PDB created, owned by the CR and selecting the Service's pods ---
+	pdb := &policyv1.PodDisruptionBudget{}
+	waitForResourceWithKey(t, k8sClient, pdbKey, pdb)
+	require.Equal(t, ptr.To(intstr.FromInt32(1)), pdb.Spec.MinAvailable)
+	require.Nil(t, pdb.Spec.MaxUnavailable)
+	require.True(t, metav1.IsControlledBy(pdb, instance),
+		"PDB should be controlled by the OGXServer")
+
+	service := &corev1.Service{}
+	waitForResourceWithKey(t, k8sClient, serviceKey, service)
+	require.NotNil(t, pdb.Spec.Selector)
+	require.Equal(t, service.Spec.Selector, pdb.Spec.Selector.MatchLabels,
+		"PDB should select the same pods as the Service")
+
+	// --- act: switch to maxUnavailable ---
+	require.NoError(t, k8sClient.Get(t.Context(), crKey, instance))
+	instance.Spec.Workload.PodDisruptionBudget = &ogxiov1beta1.PodDisruptionBudgetSpec{
+		MaxUnavailable: ptr.To(intstr.FromString("50%")),
+	}
+	require.NoError(t, k8sClient.Update(t.Context(), instance))
+
+	ReconcileOGXServer(t, instance)
+
+	// --- assert: PDB follows the spec and drops minAvailable ---
+	waitForResourceWithKeyAndCondition(t, k8sClient, pdbKey, pdb, func() bool {
+		return pdb.Spec.MinAvailable == nil &&
+			pdb.Spec.MaxUnavailable != nil && pdb.Spec.MaxUnavailable.String() == "50%"
+	}, "PDB should switch to maxUnavailable 50%")
+
+	// --- act: remove the PDB from the spec ---
+	require.NoError(t, k8sClient.Get(t.Context(), crKey, instance))
+	instance.Spec.Workload.PodDisruptionBudget = nil
+	require.NoError(t, k8sClient.Update(t.Context(), instance))
+
+	ReconcileOGXServer(t, instance)
+
+	// --- assert: PDB removed for the single-replica server ---
+	require.Eventually(t, func() bool {
+		return apierrors.IsNotFound(k8sClient.Get(t.Context(), pdbKey, &policyv1.PodDisruptionBudget{}))
+	}, testTimeout, testInterval, "PDB should be deleted once it is removed from the spec")
+}
+
 func TestConfigMapWatchingFunctionality(t *testing.T) {
 	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
 
@@ -319,6 +460,447 @@ server:
 	// so we skip the isConfigMapReferenced checks which rely on field indexing
 }
 
+func TestSuspendedServerSkipsHealthChecks(t *testing.T) {
+	// arrange: fail on any call to the server API
+	var apiCalls atomic.Int32
+	mockClient := &http.Client{
+		Transport: &mockRoundTripper{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				apiCalls.Add(1)
+				return nil, fmt.Errorf("unexpected request to %s", req.URL.Path)
+			},
+		},
+	}
+
+	namespace := createTestNamespace(t, "test-suspended")
+	instance := NewOGXServerBuilder().
+		WithName("test-suspended").
+		WithNamespace(namespace.Name).
+		WithReplicas(0).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+
+	reconciler := controllers.NewTestReconciler(
+		k8sClient,
+		scheme.Scheme,
+		&cluster.ClusterInfo{DistributionImages: map[string]string{"starter": testImage}},
+		mockClient,
+	)
+
+	// act
+	_, err := reconciler.Reconcile(t.Context(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace},
+	})
+	require.NoError(t, err)
+
+	// assert: the deployment is created with zero replicas
+	deployment := &appsv1.Deployment{}
+	deploymentKey := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+	waitForResourceWithKey(t, k8sClient, deploymentKey, deployment)
+	require.NotNil(t, deployment.Spec.Replicas)
+	require.Equal(t, int32(0), *deployment.Spec.Replicas)
+
+	// assert: the server is reported as suspended without querying it
+	updated := &ogxiov1beta1.OGXServer{}
+	require.NoError(t, k8sClient.Get(t.Context(), deploymentKey, updated))
+	require.Equal(t, ogxiov1beta1.OGXServerPhaseSuspended, updated.Status.Phase)
+	condition := meta.FindStatusCondition(updated.Status.Conditions, controllers.ConditionTypeDeploymentReady)
+	require.NotNil(t, condition)
+	require.Equal(t, metav1.ConditionFalse, condition.Status)
+	require.Equal(t, controllers.ReasonDeploymentSuspended, condition.Reason)
+	health := meta.FindStatusCondition(updated.Status.Conditions, controllers.ConditionTypeHealthCheck)
+	require.NotNil(t, health)
+	require.Equal(t, metav1.ConditionFalse, health.Status)
+	require.Empty(t, updated.Status.DistributionConfig.Providers)
+	require.Zero(t, apiCalls.Load(), "a suspended server must not be queried")
+}
+
+func TestDeploymentRolloutProblemsInStatus(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	// --- arrange ---
+	namespace := createTestNamespace(t, "rollout-problems")
+	instance := NewOGXServerBuilder().
+		WithName("rollout-problems").
+		WithNamespace(namespace.Name).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+	ReconcileOGXServer(t, instance)
+
+	deploymentKey := types.NamespacedName{Name: instance.Name, Namespace: namespace.Name}
+	deployment := &appsv1.Deployment{}
+	waitForResourceWithKey(t, k8sClient, deploymentKey, deployment)
+
+	// --- act: stall the rollout and leave a pod waiting on its image ---
+	now := metav1.Now()
+	deployment.Status.Conditions = []appsv1.DeploymentCondition{{
+		Type:               appsv1.DeploymentProgressing,
+		Status:             corev1.ConditionFalse,
+		Reason:             "ProgressDeadlineExceeded",
+		Message:            "ReplicaSet has timed out progressing.",
+		LastUpdateTime:     now,
+		LastTransitionTime: now,
+	}}
+	require.NoError(t, k8sClient.Status().Update(t.Context(), deployment))
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rollout-problems-pod",
+			Namespace: namespace.Name,
+			Labels:    map[string]string{"app.kubernetes.io/instance": instance.Name},
+		},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "ogx", Image: "missing:latest"}}},
+	}
+	require.NoError(t, k8sClient.Create(t.Context(), pod))
+	pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+		Name:  "ogx",
+		Image: "missing:latest",
+		State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}},
+	}}
+	require.NoError(t, k8sClient.Status().Update(t.Context(), pod))
+
+	ReconcileOGXServer(t, instance)
+
+	// --- assert: the DeploymentReady condition names the cause ---
+	updated := &ogxiov1beta1.OGXServer{}
+	require.NoError(t, k8sClient.Get(t.Context(), deploymentKey, updated))
+	condition := meta.FindStatusCondition(updated.Status.Conditions, controllers.ConditionTypeDeploymentReady)
+	require.NotNil(t, condition)
+	require.Equal(t, metav1.ConditionFalse, condition.Status)
+	require.Contains(t, condition.Message, "rollout stalled: ProgressDeadlineExceeded")
+	require.Contains(t, condition.Message, "rollout-problems-pod/ogx: ImagePullBackOff")
+}
+
+func TestOverrideConfigValidation(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	namespace := createTestNamespace(t, "test-override-config-validation")
+	validConfig := `version: '2'
+image_name: ollama
+apis:
+- inference
+providers:
+  inference:
+  - provider_id: ollama
+    provider_type: "remote::ollama"
+    config:
+      url: "http://ollama-server:11434"`
+
+	tests := []struct {
+		name      string
+		data      map[string]string
+		wantValid bool
+		errSubstr string
+	}{
+		{
+			name:      "valid config",
+			data:      map[string]string{"config.yaml": validConfig},
+			wantValid: true,
+		},
+		{
+			name:      "missing key",
+			data:      map[string]string{"run.yaml": validConfig},
+			errSubstr: "failed to find override ConfigMap key 'config.yaml'",
+		},
+		{
+			name:      "invalid YAML",
+			data:      map[string]string{"config.yaml": "apis: [inference\nproviders: {}"},
+			errSubstr: "is not a valid config",
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      fmt.Sprintf("override-config-%d", i),
+					Namespace: namespace.Name,
+				},
+				Data: tt.data,
+			}
+			require.NoError(t, k8sClient.Create(t.Context(), configMap))
+
+			instance := NewOGXServerBuilder().
+				WithName(fmt.Sprintf("test-override-config-%d", i)).
+				WithNamespace(namespace.Name).
+				WithOverrideConfig(configMap.Name, "config.yaml").
+				Build()
+			require.NoError(t, k8sClient.Create(t.Context(), instance))
+
+			_, err := createTestReconciler().Reconcile(t.Context(), ctrl.Request{
+				NamespacedName: types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace},
+			})
+			if tt.wantValid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.errSubstr)
+			}
+
+			updated := &ogxiov1beta1.OGXServer{}
+			require.NoError(t, k8sClient.Get(t.Context(),
+				types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}, updated))
+			condition := meta.FindStatusCondition(updated.Status.Conditions, controllers.ConditionTypeOverrideConfigValid)
+			require.NotNil(t, condition, "OverrideConfigValid condition should be set")
+			if tt.wantValid {
+				require.Equal(t, metav1.ConditionTrue, condition.Status)
+				return
+			}
+			require.Equal(t, metav1.ConditionFalse, condition.Status)
+			require.Equal(t, controllers.ReasonOverrideConfigInvalid, condition.Reason)
+			require.Contains(t, condition.Message, tt.errSubstr)
+		})
+	}
+}
+
+func TestSecretWatchingFunctionality(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	// Create a test namespace
+	namespace := createTestNamespace(t, "test-secret-watch")
+	testCert := loadTestCertificate(t)
+
+	// Create a Secret holding a CA certificate
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-ca-secret",
+			Namespace: namespace.Name,
+		},
+		Data: map[string][]byte{
+			"ca.crt": []byte(testCert),
+		},
+	}
+	require.NoError(t, k8sClient.Create(t.Context(), secret))
+
+	// Create an OGXServer that references the Secret
+	instance := NewOGXServerBuilder().
+		WithName("test-secret-reference").
+		WithNamespace(namespace.Name).
+		WithCACertificateSecrets(ogxiov1beta1.SecretKeyRef{Name: secret.Name, Key: "ca.crt"}).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+
+	// Reconcile to create initial deployment
+	ReconcileOGXServer(t, instance)
+
+	// Get the initial deployment and check for Secret hash annotation
+	deployment := &appsv1.Deployment{}
+	deploymentKey := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+	waitForResourceWithKey(t, k8sClient, deploymentKey, deployment)
+
+	initialHash := deployment.Spec.Template.Annotations["secret.hash/ca-bundle"]
+	require.NotEmpty(t, initialHash, "Secret hash annotation should be present")
+
+	// Update the Secret data
+	require.NoError(t, k8sClient.Get(t.Context(),
+		types.NamespacedName{Name: secret.Name, Namespace: secret.Namespace}, secret))
+	secret.Data["ca.crt"] = []byte(testCert + "\n" + testCert)
+	require.NoError(t, k8sClient.Update(t.Context(), secret))
+
+	// Trigger reconciliation (in real scenarios this would be triggered by the watch)
+	ReconcileOGXServer(t, instance)
+
+	// Verify the deployment was updated with a new hash
+	waitForResourceWithKeyAndCondition(
+		t, k8sClient, deploymentKey, deployment, func() bool {
+			newHash := deployment.Spec.Template.Annotations["secret.hash/ca-bundle"]
+			return newHash != initialHash && newHash != ""
+		}, "Secret hash should be updated after Secret data change")
+}
+
+func TestEnvFromSecretRotation(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	namespace := createTestNamespace(t, "test-envfrom-rotation")
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "provider-credentials",
+			Namespace: namespace.Name,
+		},
+		Data: map[string][]byte{
+			"API_KEY": []byte("initial"),
+		},
+	}
+	require.NoError(t, k8sClient.Create(t.Context(), secret))
+
+	instance := NewOGXServerBuilder().
+		WithName("test-envfrom").
+		WithNamespace(namespace.Name).
+		WithEnvFrom(corev1.EnvFromSource{
+			SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: secret.Name}},
+		}).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+
+	ReconcileOGXServer(t, instance)
+
+	deployment := &appsv1.Deployment{}
+	deploymentKey := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+	waitForResourceWithKey(t, k8sClient, deploymentKey, deployment)
+
+	require.Len(t, deployment.Spec.Template.Spec.Containers, 1)
+	require.Equal(t, instance.Spec.Workload.Overrides.EnvFrom, deployment.Spec.Template.Spec.Containers[0].EnvFrom)
+	initialHash := deployment.Spec.Template.Annotations["env.hash/sources"]
+	require.NotEmpty(t, initialHash, "env source hash annotation should be present")
+
+	// Rotate the credentials
+	require.NoError(t, k8sClient.Get(t.Context(),
+		types.NamespacedName{Name: secret.Name, Namespace: secret.Namespace}, secret))
+	secret.Data["API_KEY"] = []byte("rotated")
+	require.NoError(t, k8sClient.Update(t.Context(), secret))
+
+	// Trigger reconciliation (in real scenarios this would be triggered by the watch)
+	ReconcileOGXServer(t, instance)
+
+	waitForResourceWithKeyAndCondition(
+		t, k8sClient, deploymentKey, deployment, func() bool {
+			newHash := deployment.Spec.Template.Annotations["env.hash/sources"]
+			return newHash != initialHash && newHash != ""
+		}, "env source hash should be updated after Secret data change")
+}
+
+func TestRestartedAtAnnotationRollsOutPods(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	namespace := createTestNamespace(t, "test-restarted-at")
+
+	instance := NewOGXServerBuilder().
+		WithName("test-restart").
+		WithNamespace(namespace.Name).
+		Build()
+	instance.Annotations = map[string]string{ogxiov1beta1.RestartedAtAnnotation: "2025-01-01T00:00:00Z"}
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+
+	ReconcileOGXServer(t, instance)
+
+	deployment := &appsv1.Deployment{}
+	deploymentKey := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+	waitForResourceWithKey(t, k8sClient, deploymentKey, deployment)
+	require.Equal(t, "2025-01-01T00:00:00Z", deployment.Spec.Template.Annotations[ogxiov1beta1.RestartedAtAnnotation])
+
+	// Request a restart
+	require.NoError(t, k8sClient.Get(t.Context(),
+		types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}, instance))
+	instance.Annotations[ogxiov1beta1.RestartedAtAnnotation] = "2025-01-02T00:00:00Z"
+	require.NoError(t, k8sClient.Update(t.Context(), instance))
+
+	ReconcileOGXServer(t, instance)
+
+	waitForResourceWithKeyAndCondition(
+		t, k8sClient, deploymentKey, deployment, func() bool {
+			return deployment.Spec.Template.Annotations[ogxiov1beta1.RestartedAtAnnotation] == "2025-01-02T00:00:00Z"
+		}, "pod template should pick up the new restart time")
+}
+
+func TestSuspendReconcileAnnotation(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	namespace := createTestNamespace(t, "test-suspend-reconcile")
+
+	instance := NewOGXServerBuilder().
+		WithName("test-suspend").
+		WithNamespace(namespace.Name).
+		WithReplicas(1).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+
+	ReconcileOGXServer(t, instance)
+
+	deployment := &appsv1.Deployment{}
+	deploymentKey := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+	waitForResourceWithKey(t, k8sClient, deploymentKey, deployment)
+	require.Equal(t, int32(1), *deployment.Spec.Replicas)
+	suspendedVersion := deployment.ResourceVersion
+
+	// Suspend and change the spec; the deployment must not change
+	require.NoError(t, k8sClient.Get(t.Context(), deploymentKey, instance))
+	instance.Annotations = map[string]string{ogxiov1beta1.SuspendReconcileAnnotation: "true"}
+	instance.Spec.Workload.Replicas = ptr.To(int32(2))
+	require.NoError(t, k8sClient.Update(t.Context(), instance))
+
+	ReconcileOGXServer(t, instance)
+
+	require.NoError(t, k8sClient.Get(t.Context(), deploymentKey, deployment))
+	require.Equal(t, suspendedVersion, deployment.ResourceVersion, "deployment should not change while suspended")
+	require.Equal(t, int32(1), *deployment.Spec.Replicas)
+	require.NoError(t, k8sClient.Get(t.Context(), deploymentKey, instance))
+	require.True(t, controllers.IsConditionTrue(&instance.Status, controllers.ConditionTypeReconcileSuspended))
+
+	// Resume by removing the annotation
+	delete(instance.Annotations, ogxiov1beta1.SuspendReconcileAnnotation)
+	require.NoError(t, k8sClient.Update(t.Context(), instance))
+
+	ReconcileOGXServer(t, instance)
+
+	waitForResourceWithKeyAndCondition(
+		t, k8sClient, deploymentKey, deployment, func() bool {
+			return deployment.Spec.Replicas != nil && *deployment.Spec.Replicas == 2
+		}, "deployment should pick up the spec change after resuming")
+	require.NoError(t, k8sClient.Get(t.Context(), deploymentKey, instance))
+	condition := controllers.GetCondition(&instance.Status, controllers.ConditionTypeReconcileSuspended)
+	require.NotNil(t, condition)
+	require.Equal(t, metav1.ConditionFalse, condition.Status)
+}
+
+func TestProviderSecretRotation(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	namespace := createTestNamespace(t, "test-provider-secret-rotation")
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "vllm-credentials",
+			Namespace: namespace.Name,
+		},
+		Data: map[string][]byte{
+			"token": []byte("initial"),
+		},
+	}
+	require.NoError(t, k8sClient.Create(t.Context(), secret))
+
+	instance := NewOGXServerBuilder().
+		WithName("test-provider-secrets").
+		WithNamespace(namespace.Name).
+		WithProviderSecrets(ogxiov1beta1.ProviderSecretRef{
+			EnvVar:       "VLLM_API_TOKEN",
+			SecretKeyRef: ogxiov1beta1.SecretKeyRef{Name: secret.Name, Key: "token"},
+		}).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+
+	ReconcileOGXServer(t, instance)
+
+	deployment := &appsv1.Deployment{}
+	deploymentKey := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+	waitForResourceWithKey(t, k8sClient, deploymentKey, deployment)
+
+	require.NotEmpty(t, deployment.Spec.Template.Spec.Containers)
+	require.Contains(t, deployment.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{
+		Name: "VLLM_API_TOKEN",
+		ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: secret.Name},
+			Key:                  "token",
+		}},
+	})
+	initialHash := deployment.Spec.Template.Annotations["env.hash/sources"]
+	require.NotEmpty(t, initialHash, "env source hash annotation should be present")
+
+	// Rotate the token
+	require.NoError(t, k8sClient.Get(t.Context(),
+		types.NamespacedName{Name: secret.Name, Namespace: secret.Namespace}, secret))
+	secret.Data["token"] = []byte("rotated")
+	require.NoError(t, k8sClient.Update(t.Context(), secret))
+
+	// Trigger reconciliation (in real scenarios this would be triggered by the watch)
+	ReconcileOGXServer(t, instance)
+
+	waitForResourceWithKeyAndCondition(
+		t, k8sClient, deploymentKey, deployment, func() bool {
+			newHash := deployment.Spec.Template.Annotations["env.hash/sources"]
+			return newHash != initialHash && newHash != ""
+		}, "env source hash should be updated after the provider Secret is rotated")
+}
+
 func TestReconcile(t *testing.T) {
 	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
 
@@ -385,6 +967,43 @@ func TestReconcile(t *testing.T) {
 	AssertResourceOwnedByInstance(t, serviceAccount, instance)
 }
 
+func TestReconcileRestoresServiceSelector(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	// --- arrange ---
+	namespace := createTestNamespace(t, "test-selector-drift")
+	instance := NewOGXServerBuilder().
+		WithName("selector-drift").
+		WithNamespace(namespace.Name).
+		WithDistribution("starter").
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+	expectedSelector := map[string]string{
+		ogxiov1beta1.DefaultLabelKey: ogxiov1beta1.DefaultLabelValue,
+		"app.kubernetes.io/instance": instance.Name,
+	}
+
+	ReconcileOGXServer(t, instance)
+	service := &corev1.Service{}
+	waitForResource(t, k8sClient, instance.Namespace, instance.Name+"-service", service)
+
+	// Edit the selector by hand: change a value, drop a key and add an unrelated one.
+	service.Spec.Selector = map[string]string{
+		ogxiov1beta1.DefaultLabelKey: "something-else",
+		"tier":                       "edited-by-hand",
+	}
+	require.NoError(t, k8sClient.Update(t.Context(), service))
+
+	// --- act ---
+	ReconcileOGXServer(t, instance)
+
+	// --- assert ---
+	serviceKey := types.NamespacedName{Name: service.Name, Namespace: service.Namespace}
+	waitForResourceWithKeyAndCondition(t, k8sClient, serviceKey, service, func() bool {
+		return maps.Equal(service.Spec.Selector, expectedSelector)
+	}, "the Service selector should be restored")
+}
+
 // Define a custom roundtripper type for testing.
 type mockRoundTripper struct {
 	RoundTripFunc func(req *http.Request) (*http.Response, error)
@@ -409,6 +1028,50 @@ func newMockAPIResponse(t *testing.T, data any) *http.Response {
 	}
 }
 
+func TestWorkloadOverridesVolumeConflict(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	// --- arrange ---
+	namespace := createTestNamespace(t, "test-overrides-conflict")
+	instance := NewOGXServerBuilder().
+		WithName("overrides-conflict").
+		WithNamespace(namespace.Name).
+		WithServiceAccountName("custom-sa").
+		Build()
+	instance.Spec.Workload.Overrides.Volumes = []corev1.Volume{{
+		Name:         testStorageVolumeName,
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	}}
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+	t.Cleanup(func() { _ = k8sClient.Delete(t.Context(), instance) })
+
+	// --- act ---
+	ReconcileOGXServer(t, instance)
+
+	// --- assert: condition names the conflict and no Deployment is created ---
+	updated := &ogxiov1beta1.OGXServer{}
+	require.NoError(t, k8sClient.Get(t.Context(), client.ObjectKeyFromObject(instance), updated))
+	condition := controllers.GetCondition(&updated.Status, controllers.ConditionTypeWorkloadOverridesValid)
+	require.NotNil(t, condition, "WorkloadOverridesValid condition should be set")
+	require.Equal(t, metav1.ConditionFalse, condition.Status)
+	require.Equal(t, controllers.ReasonVolumeConflict, condition.Reason)
+	require.Contains(t, condition.Message, `volume "ogx-storage"`)
+
+	err := k8sClient.Get(t.Context(), client.ObjectKeyFromObject(instance), &appsv1.Deployment{})
+	require.True(t, apierrors.IsNotFound(err), "Deployment should not be created while overrides conflict")
+
+	// --- act: rename the volume ---
+	updated.Spec.Workload.Overrides.Volumes[0].Name = "extra-data"
+	require.NoError(t, k8sClient.Update(t.Context(), updated))
+
+	ReconcileOGXServer(t, updated)
+
+	// --- assert: condition clears ---
+	require.NoError(t, k8sClient.Get(t.Context(), client.ObjectKeyFromObject(instance), updated))
+	require.True(t, controllers.IsConditionTrue(&updated.Status, controllers.ConditionTypeWorkloadOverridesValid),
+		"WorkloadOverridesValid should be True once the conflict is resolved")
+}
+
 func TestOGXServerProviderAndVersionInfo(t *testing.T) {
 	// arrange
 	expectedServerVersion := "v-test"
@@ -649,6 +1312,104 @@ func TestManagedCABundleConfigMap(t *testing.T) {
 		require.Equal(t, "ca-bundle", managedConfigMap.Labels["app.kubernetes.io/component"])
 		require.Equal(t, controllers.WatchLabelValue, managedConfigMap.Labels[controllers.WatchLabelKey],
 			"managed CA bundle ConfigMap should have the watch label")
+		require.Len(t, managedConfigMap.Data, 1, "source keys should not be preserved by default")
+	})
+
+	t.Run("merges certificates from separate ConfigMaps and the ODH bundle", func(t *testing.T) {
+		// --- arrange ---
+		namespace := createTestNamespace(t, "test-cabundle-merge")
+		corporateRoot := loadTestCertificate(t)
+		vendorIntermediate := loadTestCertificate(t)
+
+		sources := []*corev1.ConfigMap{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "corporate-root", Namespace: namespace.Name},
+				Data:       map[string]string{"root.crt": corporateRoot},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "vendor-intermediate", Namespace: namespace.Name},
+				Data:       map[string]string{"intermediate.crt": vendorIntermediate},
+			},
+			{
+				// The ODH bundle repeats the corporate root, which must appear only once.
+				ObjectMeta: metav1.ObjectMeta{Name: "odh-trusted-ca-bundle", Namespace: namespace.Name},
+				Data:       map[string]string{"ca-bundle.crt": corporateRoot},
+			},
+		}
+		for _, source := range sources {
+			require.NoError(t, k8sClient.Create(t.Context(), source))
+		}
+
+		instance := NewOGXServerBuilder().
+			WithName("test-merge").
+			WithNamespace(namespace.Name).
+			WithCACertificates(
+				ogxiov1beta1.ConfigMapKeyRef{Name: "corporate-root", Key: "root.crt"},
+				ogxiov1beta1.ConfigMapKeyRef{Name: "vendor-intermediate", Key: "intermediate.crt"},
+			).
+			Build()
+
+		require.NoError(t, k8sClient.Create(t.Context(), instance))
+		t.Cleanup(func() { _ = k8sClient.Delete(t.Context(), instance) })
+
+		// --- act ---
+		ReconcileOGXServer(t, instance)
+
+		// --- assert ---
+		managedConfigMap := &corev1.ConfigMap{}
+		waitForResource(t, k8sClient, namespace.Name, instance.Name+"-ca-bundle", managedConfigMap)
+		caBundleData := managedConfigMap.Data["ca-bundle.crt"]
+		require.Equal(t, 2, strings.Count(caBundleData, "BEGIN CERTIFICATE"),
+			"bundle should contain each distinct certificate once")
+		require.Contains(t, caBundleData, strings.TrimSpace(corporateRoot))
+		require.Contains(t, caBundleData, strings.TrimSpace(vendorIntermediate))
+	})
+
+	t.Run("preserves source keys when requested", func(t *testing.T) {
+		// --- arrange ---
+		namespace := createTestNamespace(t, "test-cabundle-preserve")
+		testCert := loadTestCertificate(t)
+
+		sourceConfigMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "source-ca-bundle",
+				Namespace: namespace.Name,
+			},
+			Data: map[string]string{
+				"root-ca.crt":      testCert,
+				"intermediate.crt": testCert,
+			},
+		}
+		require.NoError(t, k8sClient.Create(t.Context(), sourceConfigMap))
+
+		instance := NewOGXServerBuilder().
+			WithName("test-preserve").
+			WithNamespace(namespace.Name).
+			WithCACertificates(
+				ogxiov1beta1.ConfigMapKeyRef{Name: "source-ca-bundle", Key: "root-ca.crt"},
+				ogxiov1beta1.ConfigMapKeyRef{Name: "source-ca-bundle", Key: "intermediate.crt"},
+			).
+			Build()
+		instance.Spec.TLS.Trust.PreserveKeys = true
+
+		require.NoError(t, k8sClient.Create(t.Context(), instance))
+		t.Cleanup(func() { _ = k8sClient.Delete(t.Context(), instance) })
+
+		// --- act ---
+		ReconcileOGXServer(t, instance)
+
+		// --- assert ---
+		managedConfigMap := &corev1.ConfigMap{}
+		waitForResource(t, k8sClient, namespace.Name, instance.Name+"-ca-bundle", managedConfigMap)
+		require.Contains(t, managedConfigMap.Data, "ca-bundle.crt", "combined bundle should still be present")
+		require.Contains(t, managedConfigMap.Data["root-ca.crt"], "BEGIN CERTIFICATE")
+		require.Contains(t, managedConfigMap.Data["intermediate.crt"], "BEGIN CERTIFICATE")
+
+		deployment := &appsv1.Deployment{}
+		waitForResource(t, k8sClient, namespace.Name, instance.Name, deployment)
+		caVolume := findVolumeByName(t, deployment, controllers.CABundleVolumeName)
+		require.NotNil(t, caVolume.ConfigMap, "CA bundle volume should mount the managed ConfigMap")
+		require.Empty(t, caVolume.ConfigMap.Items, "all managed keys should be mounted")
 	})
 
 	t.Run("updates managed ConfigMap when source changes", func(t *testing.T) {
@@ -766,12 +1527,97 @@ InvalidCertificateDataThatIsNotValidX509
 -----END CERTIFICATE-----`,
 			},
 		}
-		require.NoError(t, k8sClient.Create(t.Context(), sourceConfigMap))
+		require.NoError(t, k8sClient.Create(t.Context(), sourceConfigMap))
+
+		instance := NewOGXServerBuilder().
+			WithName("test-reject-invalid").
+			WithNamespace(namespace.Name).
+			WithCACertificates(ogxiov1beta1.ConfigMapKeyRef{Name: "source-with-invalid-cert", Key: "ca-bundle.crt"}).
+			Build()
+
+		require.NoError(t, k8sClient.Create(t.Context(), instance))
+		t.Cleanup(func() { _ = k8sClient.Delete(t.Context(), instance) })
+
+		// --- act ---
+		reconciler := createTestReconciler()
+		_, err := reconciler.Reconcile(t.Context(), ctrl.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      instance.Name,
+				Namespace: instance.Namespace,
+			},
+		})
+
+		// --- assert ---
+		require.Error(t, err, "reconciliation should fail for invalid X.509 certificate")
+		require.Contains(t, err.Error(), "failed to parse X.509 certificate",
+			"error should indicate X.509 parsing failure")
+	})
+
+	t.Run("creates managed ConfigMap from Secret source", func(t *testing.T) {
+		// --- arrange ---
+		namespace := createTestNamespace(t, "test-cabundle-secret")
+		testCert := loadTestCertificate(t)
+
+		sourceSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "source-ca-secret",
+				Namespace: namespace.Name,
+			},
+			Data: map[string][]byte{
+				"ca.crt": []byte(testCert),
+			},
+		}
+		require.NoError(t, k8sClient.Create(t.Context(), sourceSecret))
+
+		instance := NewOGXServerBuilder().
+			WithName("test-secret-ca").
+			WithNamespace(namespace.Name).
+			WithCACertificateSecrets(ogxiov1beta1.SecretKeyRef{Name: "source-ca-secret", Key: "ca.crt"}).
+			Build()
+
+		require.NoError(t, k8sClient.Create(t.Context(), instance))
+		t.Cleanup(func() { _ = k8sClient.Delete(t.Context(), instance) })
+
+		// --- act ---
+		ReconcileOGXServer(t, instance)
+
+		// --- assert ---
+		managedConfigMap := &corev1.ConfigMap{}
+		waitForResource(t, k8sClient, namespace.Name, instance.Name+"-ca-bundle", managedConfigMap)
+		require.NotContains(t, managedConfigMap.Data["ca-bundle.crt"], "BEGIN CERTIFICATE",
+			"certificates from the Secret should not be copied into the ConfigMap")
+		AssertResourceOwnedByInstance(t, managedConfigMap, instance)
+
+		deployment := &appsv1.Deployment{}
+		waitForResource(t, k8sClient, namespace.Name, instance.Name, deployment)
+		caVolume := findVolumeByName(t, deployment, controllers.CABundleVolumeName)
+		require.NotNil(t, caVolume.EmptyDir, "CA bundle volume should hold the bundle assembled by the init container")
+		sourcesVolume := findVolumeByName(t, deployment, controllers.CABundleSourcesVolumeName)
+		require.NotNil(t, sourcesVolume.Projected, "the Secret should be mounted in the projected sources volume")
+		require.Equal(t, managedConfigMap.Name, sourcesVolume.Projected.Sources[0].ConfigMap.Name)
+		require.Equal(t, sourceSecret.Name, sourcesVolume.Projected.Sources[1].Secret.Name)
+		require.Equal(t, ogxiov1beta1.CABundleInitContainerName, deployment.Spec.Template.Spec.InitContainers[0].Name)
+	})
+
+	t.Run("fails when Secret key is missing", func(t *testing.T) {
+		// --- arrange ---
+		namespace := createTestNamespace(t, "test-cabundle-secret-missing")
+
+		sourceSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "source-ca-secret",
+				Namespace: namespace.Name,
+			},
+			Data: map[string][]byte{
+				"other.crt": []byte(loadTestCertificate(t)),
+			},
+		}
+		require.NoError(t, k8sClient.Create(t.Context(), sourceSecret))
 
 		instance := NewOGXServerBuilder().
-			WithName("test-reject-invalid").
+			WithName("test-secret-missing").
 			WithNamespace(namespace.Name).
-			WithCACertificates(ogxiov1beta1.ConfigMapKeyRef{Name: "source-with-invalid-cert", Key: "ca-bundle.crt"}).
+			WithCACertificateSecrets(ogxiov1beta1.SecretKeyRef{Name: "source-ca-secret", Key: "ca.crt"}).
 			Build()
 
 		require.NoError(t, k8sClient.Create(t.Context(), instance))
@@ -787,9 +1633,8 @@ InvalidCertificateDataThatIsNotValidX509
 		})
 
 		// --- assert ---
-		require.Error(t, err, "reconciliation should fail for invalid X.509 certificate")
-		require.Contains(t, err.Error(), "failed to parse X.509 certificate",
-			"error should indicate X.509 parsing failure")
+		require.Error(t, err, "reconciliation should fail when the Secret key is missing")
+		require.Contains(t, err.Error(), "failed to find CA certificate key 'ca.crt' in Secret")
 	})
 }
 
@@ -809,6 +1654,305 @@ func TestParseImageMappingOverrides_SingleOverride(t *testing.T) {
 	require.Equal(t, "quay.io/custom/ogx-server:starter", result["starter"], "Override should match expected value")
 }
 
+// TestSCCBindingIsNamespaceScoped guards against leaking cluster-scoped RBAC on
+// CR deletion: the SCC binding must be a RoleBinding garbage-collected through
+// its owner reference, never a ClusterRoleBinding.
+func TestSCCBindingIsNamespaceScoped(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	// --- arrange ---
+	// The SCC RoleBinding is only rendered when the anyuid SCC ClusterRole exists.
+	sccClusterRole := &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{Name: "system:openshift:scc:anyuid"},
+	}
+	require.NoError(t, k8sClient.Create(t.Context(), sccClusterRole))
+	t.Cleanup(func() { _ = k8sClient.Delete(t.Context(), sccClusterRole) })
+
+	namespace := createTestNamespace(t, "test-scc-binding")
+	instance := NewOGXServerBuilder().
+		WithName("scc-binding").
+		WithNamespace(namespace.Name).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+	t.Cleanup(func() { _ = k8sClient.Delete(t.Context(), instance) })
+
+	// --- act ---
+	ReconcileOGXServer(t, instance)
+
+	// --- assert ---
+	roleBindings := &rbacv1.RoleBindingList{}
+	require.NoError(t, k8sClient.List(t.Context(), roleBindings, client.InNamespace(namespace.Name)))
+	require.Len(t, roleBindings.Items, 1, "the SCC binding should be a single namespaced RoleBinding")
+	AssertResourceOwnedByInstance(t, &roleBindings.Items[0], instance)
+
+	clusterRoleBindings := &rbacv1.ClusterRoleBindingList{}
+	require.NoError(t, k8sClient.List(t.Context(), clusterRoleBindings,
+		client.MatchingLabels{"app.kubernetes.io/managed-by": "ogx-operator"}))
+	for _, crb := range clusterRoleBindings.Items {
+		for _, subject := range crb.Subjects {
+			require.NotEqual(t, namespace.Name, subject.Namespace,
+				"no ClusterRoleBinding should be created for the instance, found %s", crb.Name)
+		}
+	}
+}
+
+func TestReconcileExternalAccessIngress(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	// --- arrange ---
+	namespace := createTestNamespace(t, "test-external-ingress")
+	instance := NewOGXServerBuilder().
+		WithName("external-ingress").
+		WithNamespace(namespace.Name).
+		WithExternalAccess(&ogxiov1beta1.ExternalAccessConfig{
+			Enabled:     true,
+			Hostname:    "ogx.example.com",
+			TLS:         &ogxiov1beta1.ExternalAccessTLSConfig{SecretName: "ogx-tls"},
+			Annotations: map[string]string{"cert-manager.io/cluster-issuer": "letsencrypt"},
+		}).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+	t.Cleanup(func() { _ = k8sClient.Delete(t.Context(), instance) })
+
+	// --- act ---
+	ReconcileOGXServer(t, instance)
+
+	// --- assert ---
+	ingress := &networkingv1.Ingress{}
+	waitForResource(t, k8sClient, namespace.Name, instance.Name+controllers.IngressNameSuffix, ingress)
+	AssertResourceOwnedByInstance(t, ingress, instance)
+	require.Equal(t, "ogx.example.com", ingress.Spec.Rules[0].Host)
+	require.Equal(t, "letsencrypt", ingress.Annotations["cert-manager.io/cluster-issuer"])
+	require.Len(t, ingress.Spec.TLS, 1)
+	require.Equal(t, "ogx-tls", ingress.Spec.TLS[0].SecretName)
+
+	route := &unstructured.Unstructured{}
+	route.SetGroupVersionKind(cluster.RouteGVK)
+	err := k8sClient.Get(t.Context(), types.NamespacedName{
+		Name: instance.Name + controllers.RouteNameSuffix, Namespace: namespace.Name,
+	}, route)
+	require.True(t, apierrors.IsNotFound(err), "no Route should be created outside OpenShift")
+
+	updated := &ogxiov1beta1.OGXServer{}
+	require.NoError(t, k8sClient.Get(t.Context(), client.ObjectKeyFromObject(instance), updated))
+	require.NotNil(t, updated.Status.ExternalURL)
+	require.Equal(t, "https://ogx.example.com", *updated.Status.ExternalURL)
+}
+
+func TestReconcileExternalAccessRoute(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	// --- arrange ---
+	namespace := createTestNamespace(t, "test-external-route")
+	instance := NewOGXServerBuilder().
+		WithName("external-route").
+		WithNamespace(namespace.Name).
+		WithExternalAccess(&ogxiov1beta1.ExternalAccessConfig{
+			Enabled:  true,
+			Hostname: "ogx.apps.example.com",
+			TLS:      &ogxiov1beta1.ExternalAccessTLSConfig{Termination: ogxiov1beta1.ExternalTLSTerminationEdge},
+		}).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+	t.Cleanup(func() { _ = k8sClient.Delete(t.Context(), instance) })
+
+	reconciler := createTestReconciler()
+	reconciler.ClusterInfo.IsOpenShift = true
+	reconcileInstance := func() {
+		_, err := reconciler.Reconcile(t.Context(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(instance)})
+		require.NoError(t, err, "reconciliation should succeed")
+	}
+
+	// --- act ---
+	reconcileInstance()
+
+	// --- assert: a Route replaces the Ingress ---
+	route := &unstructured.Unstructured{}
+	route.SetGroupVersionKind(cluster.RouteGVK)
+	waitForResource(t, k8sClient, namespace.Name, instance.Name+controllers.RouteNameSuffix, route)
+	AssertResourceOwnedByInstance(t, route, instance)
+	host, _, _ := unstructured.NestedString(route.Object, "spec", "host")
+	require.Equal(t, "ogx.apps.example.com", host)
+	termination, _, _ := unstructured.NestedString(route.Object, "spec", "tls", "termination")
+	require.Equal(t, "edge", termination)
+
+	err := k8sClient.Get(t.Context(), types.NamespacedName{
+		Name: instance.Name + controllers.IngressNameSuffix, Namespace: namespace.Name,
+	}, &networkingv1.Ingress{})
+	require.True(t, apierrors.IsNotFound(err), "no Ingress should be created on OpenShift")
+
+	updated := &ogxiov1beta1.OGXServer{}
+	require.NoError(t, k8sClient.Get(t.Context(), client.ObjectKeyFromObject(instance), updated))
+	require.NotNil(t, updated.Status.ExternalURL)
+	require.Equal(t, "https://ogx.apps.example.com", *updated.Status.ExternalURL)
+
+	// --- act: disable external access ---
+	updated.Spec.Network.ExternalAccess.Enabled = false
+	require.NoError(t, k8sClient.Update(t.Context(), updated))
+	reconcileInstance()
+
+	// --- assert: the Route is removed ---
+	require.Eventually(t, func() bool {
+		err := k8sClient.Get(t.Context(), client.ObjectKeyFromObject(route), route)
+		return apierrors.IsNotFound(err)
+	}, testTimeout, testInterval, "Route should be deleted when external access is disabled")
+}
+
+func TestReconcileSkipsTerminatingNamespace(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	// --- arrange ---
+	namespace := createTestNamespace(t, "test-ns-terminating")
+	instance := NewOGXServerBuilder().
+		WithName("ns-terminating").
+		WithNamespace(namespace.Name).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+
+	// envtest runs no namespace controller, so the namespace stays Terminating.
+	require.NoError(t, k8sClient.Delete(t.Context(), namespace))
+	require.Eventually(t, func() bool {
+		ns := &corev1.Namespace{}
+		if err := k8sClient.Get(t.Context(), types.NamespacedName{Name: namespace.Name}, ns); err != nil {
+			return false
+		}
+		return ns.Status.Phase == corev1.NamespaceTerminating
+	}, testTimeout, testInterval, "namespace should be terminating")
+
+	// --- act ---
+	reconciler := createTestReconciler()
+	result, err := reconciler.Reconcile(t.Context(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace},
+	})
+
+	// --- assert ---
+	require.NoError(t, err, "reconciliation should not fail while the namespace terminates")
+	require.Zero(t, result.RequeueAfter, "reconciliation should not requeue")
+
+	deployment := &appsv1.Deployment{}
+	err = k8sClient.Get(t.Context(), types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}, deployment)
+	require.True(t, apierrors.IsNotFound(err), "no Deployment should be created in a terminating namespace")
+
+	updated := &ogxiov1beta1.OGXServer{}
+	require.NoError(t, k8sClient.Get(t.Context(), types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}, updated))
+	condition := controllers.GetCondition(&updated.Status, controllers.ConditionTypeNamespaceTerminating)
+	require.NotNil(t, condition, "NamespaceTerminating condition should be set")
+	require.Equal(t, metav1.ConditionTrue, condition.Status)
+	require.NotEqual(t, ogxiov1beta1.OGXServerPhaseFailed, updated.Status.Phase)
+}
+
+func TestParseDefaultResources(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	t.Run("parses requests and limits", func(t *testing.T) {
+		result := controllers.ParseDefaultResources(t.Context(), map[string]string{
+			"default-resources": "requests:\n  cpu: 500m\n  memory: 1Gi\nlimits:\n  memory: 2Gi\n",
+		})
+		require.NotNil(t, result)
+		require.Equal(t, "500m", ptr.To(result.Requests[corev1.ResourceCPU]).String())
+		require.Equal(t, "2Gi", ptr.To(result.Limits[corev1.ResourceMemory]).String())
+	})
+
+	t.Run("absent key", func(t *testing.T) {
+		require.Nil(t, controllers.ParseDefaultResources(t.Context(), map[string]string{}))
+	})
+
+	t.Run("invalid YAML", func(t *testing.T) {
+		require.Nil(t, controllers.ParseDefaultResources(t.Context(), map[string]string{
+			"default-resources": "requests: [cpu",
+		}))
+	})
+
+	t.Run("limits below requests", func(t *testing.T) {
+		require.Nil(t, controllers.ParseDefaultResources(t.Context(), map[string]string{
+			"default-resources": "requests:\n  memory: 4Gi\nlimits:\n  memory: 2Gi\n",
+		}))
+	})
+}
+
+func TestParseInitializingRequeueInterval(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	tests := []struct {
+		name string
+		data map[string]string
+		want time.Duration
+	}{
+		{"absent key", map[string]string{}, controllers.DefaultInitializingRequeueInterval},
+		{"custom interval", map[string]string{"initializing-requeue-seconds": "30"}, 30 * time.Second},
+		{"surrounding whitespace", map[string]string{"initializing-requeue-seconds": " 45\n"}, 45 * time.Second},
+		{"zero", map[string]string{"initializing-requeue-seconds": "0"}, controllers.DefaultInitializingRequeueInterval},
+		{"negative", map[string]string{"initializing-requeue-seconds": "-5"}, controllers.DefaultInitializingRequeueInterval},
+		{"not a number", map[string]string{"initializing-requeue-seconds": "10s"}, controllers.DefaultInitializingRequeueInterval},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, controllers.ParseInitializingRequeueInterval(t.Context(), tt.data))
+		})
+	}
+}
+
+func TestParseEnableClusterScopedResources(t *testing.T) {
+	tests := []struct {
+		name string
+		data map[string]string
+		want bool
+	}{
+		{"absent key", map[string]string{}, true},
+		{"enabled", map[string]string{"enable-cluster-scoped-resources": "true"}, true},
+		{"disabled", map[string]string{"enable-cluster-scoped-resources": "false"}, false},
+		{"surrounding whitespace", map[string]string{"enable-cluster-scoped-resources": " false\n"}, false},
+		{"not a boolean", map[string]string{"enable-cluster-scoped-resources": "no"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, controllers.ParseEnableClusterScopedResources(t.Context(), tt.data))
+		})
+	}
+}
+
+func TestParseDefaultProxy(t *testing.T) {
+	require.Nil(t, controllers.ParseDefaultProxy(t.Context(), map[string]string{}))
+	require.Nil(t, controllers.ParseDefaultProxy(t.Context(), map[string]string{"proxy": "httpProxy: [invalid"}))
+	require.Nil(t, controllers.ParseDefaultProxy(t.Context(), map[string]string{"proxy": "http_proxy: http://proxy:3128"}),
+		"unknown fields should be rejected")
+
+	proxy := controllers.ParseDefaultProxy(t.Context(), map[string]string{"proxy": `
+httpProxy: http://proxy.corp:3128
+httpsProxy: http://proxy.corp:3128
+noProxy: internal.corp
+`})
+	require.Equal(t, &ogxiov1beta1.ProxySpec{
+		HTTPProxy:  "http://proxy.corp:3128",
+		HTTPSProxy: "http://proxy.corp:3128",
+		NoProxy:    "internal.corp",
+	}, proxy)
+}
+
+func TestBuildManifestContextRejectsInvalidResources(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	namespace := createTestNamespace(t, "test-invalid-resources")
+	instance := NewOGXServerBuilder().
+		WithName("invalid-resources").
+		WithNamespace(namespace.Name).
+		WithResources(corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("4Gi")},
+			Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+		}).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+	t.Cleanup(func() { _ = k8sClient.Delete(t.Context(), instance) })
+
+	reconciler := createTestReconciler()
+	_, err := reconciler.Reconcile(t.Context(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace},
+	})
+
+	require.Error(t, err, "reconciliation should fail when a limit is below its request")
+	require.Contains(t, err.Error(), "memory limit 2Gi is less than request 4Gi")
+}
+
 func TestParseImageMappingOverrides_InvalidYAML(t *testing.T) {
 	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
 
@@ -1021,14 +2165,130 @@ func TestReconcileRequeuesAfterSuccess(t *testing.T) {
 	require.NoError(t, err)
 
 	// In test envs, deployment never becomes ready (no kubelet), so the instance
-	// stays in Initializing phase which requeues after 10s. Verify that a
+	// stays in Initializing phase which requeues after 10s plus jitter. Verify that a
 	// successful reconciliation always schedules a requeue (not zero).
 	// In test env the deployment stays in Initializing phase (10s requeue).
 	// The Ready path returns 5m. Either way, requeue must be scheduled.
 	require.Positive(t, result.RequeueAfter,
 		"Successful reconciliation should always schedule a requeue")
-	require.Equal(t, 10*time.Second, result.RequeueAfter,
+	require.GreaterOrEqual(t, result.RequeueAfter, 10*time.Second,
 		"Initializing phase should requeue after 10 seconds")
+	require.LessOrEqual(t, result.RequeueAfter, 12*time.Second,
+		"Initializing requeue jitter should stay within 20% of the interval")
+}
+
+func TestReconcileRecordsLastReconcile(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	namespace := createTestNamespace(t, "test-last-reconcile")
+	operatorNamespace := createTestNamespace(t, "ogx-k8s-operator-system")
+	t.Setenv("OPERATOR_NAMESPACE", operatorNamespace.Name)
+
+	instance := NewOGXServerBuilder().
+		WithName("test-last-reconcile").
+		WithNamespace(namespace.Name).
+		WithDistribution("starter").
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+
+	clusterInfo := &cluster.ClusterInfo{
+		OperatorNamespace:  operatorNamespace.Name,
+		DistributionImages: map[string]string{"starter": "default-starter-image"},
+	}
+	reconciler, err := controllers.NewOGXServerReconciler(t.Context(), k8sClient, scheme.Scheme, clusterInfo, k8sClient)
+	require.NoError(t, err)
+
+	key := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+	lastReconcile := func() ogxiov1beta1.OGXServerStatus {
+		t.Helper()
+		_, err := reconciler.Reconcile(t.Context(), ctrl.Request{NamespacedName: key})
+		require.NoError(t, err)
+		updated := &ogxiov1beta1.OGXServer{}
+		require.NoError(t, k8sClient.Get(t.Context(), key, updated))
+		require.NotNil(t, updated.Status.LastReconcileTime)
+		require.NotNil(t, updated.Status.LastReconcileDuration)
+		require.Positive(t, updated.Status.LastReconcileDuration.Duration)
+		return updated.Status
+	}
+
+	first := lastReconcile()
+	// Status timestamps are stored with second precision.
+	time.Sleep(time.Second)
+	second := lastReconcile()
+
+	require.True(t, second.LastReconcileTime.After(first.LastReconcileTime.Time),
+		"the last reconcile time should advance with every reconcile")
+}
+
+func TestReconcileRecordsManagedResources(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	namespace := createTestNamespace(t, "test-managed-resources")
+	instance := NewOGXServerBuilder().
+		WithName("test-managed").
+		WithNamespace(namespace.Name).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+
+	ReconcileOGXServer(t, instance)
+
+	updated := &ogxiov1beta1.OGXServer{}
+	require.NoError(t, k8sClient.Get(t.Context(),
+		types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}, updated))
+	require.Contains(t, updated.Status.ManagedResources,
+		ogxiov1beta1.ManagedResourceRef{Kind: "Deployment", Name: instance.Name, Namespace: namespace.Name})
+	require.Contains(t, updated.Status.ManagedResources,
+		ogxiov1beta1.ManagedResourceRef{Kind: "Service", Name: instance.Name + "-service", Namespace: namespace.Name})
+}
+
+func TestReconcileRequeuesInitializingAfterConfiguredInterval(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	namespace := createTestNamespace(t, "test-requeue-interval")
+	operatorNamespace := createTestNamespace(t, "ogx-k8s-operator-system")
+	t.Setenv("OPERATOR_NAMESPACE", operatorNamespace.Name)
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ogx-operator-config",
+			Namespace: operatorNamespace.Name,
+		},
+		Data: map[string]string{"initializing-requeue-seconds": "45"},
+	}
+	require.NoError(t, k8sClient.Create(t.Context(), configMap))
+
+	instance := NewOGXServerBuilder().
+		WithName("test-requeue-interval").
+		WithNamespace(namespace.Name).
+		WithDistribution("starter").
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+
+	clusterInfo := &cluster.ClusterInfo{
+		OperatorNamespace:  operatorNamespace.Name,
+		DistributionImages: map[string]string{"starter": "default-starter-image"},
+	}
+
+	reconciler, err := controllers.NewOGXServerReconciler(
+		t.Context(),
+		k8sClient,
+		scheme.Scheme,
+		clusterInfo,
+		k8sClient,
+	)
+	require.NoError(t, err)
+	require.Equal(t, 45*time.Second, reconciler.InitializingRequeueInterval)
+
+	result, err := reconciler.Reconcile(t.Context(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace},
+	})
+	require.NoError(t, err)
+
+	// The deployment never becomes ready in envtest, so the instance stays Initializing.
+	require.GreaterOrEqual(t, result.RequeueAfter, 45*time.Second,
+		"Initializing phase should requeue after the configured interval")
+	require.LessOrEqual(t, result.RequeueAfter, 54*time.Second,
+		"Initializing requeue jitter should stay within 20% of the interval")
 }
 
 func TestMapConfigMapToReconcileRequests(t *testing.T) {
@@ -1102,6 +2362,111 @@ func TestMapConfigMapToReconcileRequests_SkipsManagedConfigMaps(t *testing.T) {
 	require.Empty(t, requests, "managed ConfigMaps should be skipped")
 }
 
+func TestMapSecretToReconcileRequests(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	namespace := createTestNamespace(t, "test-secret-mapping")
+
+	caSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ca-secret",
+			Namespace: namespace.Name,
+			Labels: map[string]string{
+				controllers.WatchLabelKey: controllers.WatchLabelValue,
+			},
+		},
+	}
+
+	// Create an instance that sources CA certificates from this Secret.
+	instance := NewOGXServerBuilder().
+		WithName("test-secret-mapping").
+		WithNamespace(namespace.Name).
+		WithCACertificateSecrets(ogxiov1beta1.SecretKeyRef{Name: caSecret.Name, Key: "ca.crt"}).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+	t.Cleanup(func() { _ = k8sClient.Delete(t.Context(), instance) })
+
+	// An instance without CA Secrets should not be enqueued.
+	other := NewOGXServerBuilder().
+		WithName("test-secret-unrelated").
+		WithNamespace(namespace.Name).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), other))
+	t.Cleanup(func() { _ = k8sClient.Delete(t.Context(), other) })
+
+	reconciler := createTestReconciler()
+
+	// Act: call the handler with the CA Secret.
+	requests := reconciler.MapSecretToReconcileRequests(t.Context(), caSecret)
+
+	// Assert: should return a request for the referencing instance only.
+	require.Equal(t, []reconcile.Request{{
+		NamespacedName: types.NamespacedName{
+			Name:      instance.Name,
+			Namespace: instance.Namespace,
+		},
+	}}, requests)
+}
+
+func TestMapSecretToReconcileRequestsForEnvSources(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	namespace := createTestNamespace(t, "test-env-secret-mapping")
+
+	credentials := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "provider-credentials",
+			Namespace: namespace.Name,
+			Labels: map[string]string{
+				controllers.WatchLabelKey: controllers.WatchLabelValue,
+			},
+		},
+	}
+
+	// One instance reads the Secret through envFrom, another through valueFrom.
+	viaEnvFrom := NewOGXServerBuilder().
+		WithName("test-env-from").
+		WithNamespace(namespace.Name).
+		WithEnvFrom(corev1.EnvFromSource{
+			SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: credentials.Name}},
+		}).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), viaEnvFrom))
+	t.Cleanup(func() { _ = k8sClient.Delete(t.Context(), viaEnvFrom) })
+
+	viaValueFrom := NewOGXServerBuilder().
+		WithName("test-value-from").
+		WithNamespace(namespace.Name).
+		Build()
+	viaValueFrom.Spec.Workload = &ogxiov1beta1.WorkloadSpec{Overrides: &ogxiov1beta1.WorkloadOverrides{
+		Env: []corev1.EnvVar{{
+			Name: "API_KEY",
+			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: credentials.Name},
+				Key:                  "API_KEY",
+			}},
+		}},
+	}}
+	require.NoError(t, k8sClient.Create(t.Context(), viaValueFrom))
+	t.Cleanup(func() { _ = k8sClient.Delete(t.Context(), viaValueFrom) })
+
+	other := NewOGXServerBuilder().
+		WithName("test-env-unrelated").
+		WithNamespace(namespace.Name).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), other))
+	t.Cleanup(func() { _ = k8sClient.Delete(t.Context(), other) })
+
+	reconciler := createTestReconciler()
+
+	requests := reconciler.MapSecretToReconcileRequests(t.Context(), credentials)
+
+	require.ElementsMatch(t, []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Name: viaEnvFrom.Name, Namespace: namespace.Name}},
+		{NamespacedName: types.NamespacedName{Name: viaValueFrom.Name, Namespace: namespace.Name}},
+	}, requests)
+}
+
 func TestUserConfigMapPredicate(t *testing.T) {
 	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
 
@@ -0,0 +1,90 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestPersistStatus(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, ogxiov1beta1.AddToScheme(scheme))
+
+	newClient := func(statusUpdate func(ctx context.Context, c client.Client, subResource string, obj client.Object, opts ...client.SubResourceUpdateOption) error) client.Client {
+		existing := &ogxiov1beta1.OGXServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+		return fake.NewClientBuilder().WithScheme(scheme).
+			WithObjects(existing).
+			WithStatusSubresource(existing).
+			WithInterceptorFuncs(interceptor.Funcs{SubResourceUpdate: statusUpdate}).
+			Build()
+	}
+	getInstance := func(t *testing.T, c client.Client) *ogxiov1beta1.OGXServer {
+		t.Helper()
+		instance := &ogxiov1beta1.OGXServer{}
+		require.NoError(t, c.Get(t.Context(), client.ObjectKey{Name: "test", Namespace: "default"}, instance))
+		return instance
+	}
+
+	t.Run("retries a conflicting update on a fresh copy", func(t *testing.T) {
+		calls := 0
+		c := newClient(func(ctx context.Context, c client.Client, subResource string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+			calls++
+			if calls == 1 {
+				// Another writer, e.g. the previous leader, updates the object first.
+				concurrent := &ogxiov1beta1.OGXServer{}
+				require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(obj), concurrent))
+				concurrent.Labels = map[string]string{"touched": "true"}
+				require.NoError(t, c.Update(ctx, concurrent))
+			}
+			return c.SubResource(subResource).Update(ctx, obj, opts...)
+		})
+		r := &OGXServerReconciler{Client: c}
+		instance := getInstance(t, c)
+		instance.Status.Phase = ogxiov1beta1.OGXServerPhaseReady
+
+		require.NoError(t, r.persistStatus(t.Context(), instance))
+
+		assert.Equal(t, 2, calls, "the conflicting update should be retried once")
+		stored := getInstance(t, c)
+		assert.Equal(t, ogxiov1beta1.OGXServerPhaseReady, stored.Status.Phase)
+		assert.Equal(t, "true", stored.Labels["touched"], "the concurrent write must be kept")
+		assert.Equal(t, stored.ResourceVersion, instance.ResourceVersion)
+	})
+
+	t.Run("does not retry other errors", func(t *testing.T) {
+		calls := 0
+		c := newClient(func(context.Context, client.Client, string, client.Object, ...client.SubResourceUpdateOption) error {
+			calls++
+			return errors.New("connection refused")
+		})
+		r := &OGXServerReconciler{Client: c}
+
+		require.ErrorContains(t, r.persistStatus(t.Context(), getInstance(t, c)), "connection refused")
+		assert.Equal(t, 1, calls)
+	})
+}
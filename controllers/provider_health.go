@@ -0,0 +1,222 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// DefaultProviderErrorGracePeriod is how long providers may report errors after
+// the server becomes ready before the OGXServer is considered degraded.
+const DefaultProviderErrorGracePeriod = 2 * time.Minute
+
+// DefaultProviderQueryRetryInterval is how soon a Ready server whose provider
+// query failed is reconciled again.
+const DefaultProviderQueryRetryInterval = 30 * time.Second
+
+// readyResyncInterval is how often a Ready server is reconciled again.
+const readyResyncInterval = 5 * time.Minute
+
+// transientProviderStatuses are provider statuses reported while the server warms up.
+var transientProviderStatuses = []string{"initializing", "starting"}
+
+// durationTracker records since when each OGXServer has been in a given
+// state, such as providers reporting errors, so that short-lived failures are
+// not reported until they persist past a grace period.
+type durationTracker struct {
+	mu    sync.Mutex
+	since map[types.NamespacedName]time.Time
+}
+
+// observe records whether key is currently failing and returns how
+// long the failures have lasted as of now. It returns 0 when nothing fails.
+func (t *durationTracker) observe(key types.NamespacedName, failing bool, now time.Time) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !failing {
+		delete(t.since, key)
+		return 0
+	}
+
+	if t.since == nil {
+		t.since = make(map[types.NamespacedName]time.Time)
+	}
+
+	start, ok := t.since[key]
+	if !ok {
+		t.since[key] = now
+		return 0
+	}
+	return now.Sub(start)
+}
+
+// elapsed returns how long key has been in the recorded state as of now, or 0
+// when it is not recorded.
+func (t *durationTracker) elapsed(key types.NamespacedName, now time.Time) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	start, ok := t.since[key]
+	if !ok {
+		return 0
+	}
+	return now.Sub(start)
+}
+
+// forget drops any record for key, e.g. when the server is no longer ready.
+func (t *durationTracker) forget(key types.NamespacedName) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.since, key)
+}
+
+// failing reports whether key is currently recorded as failing.
+func (t *durationTracker) failing(key types.NamespacedName) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, ok := t.since[key]
+	return ok
+}
+
+// providerErrorGracePeriod returns the configured grace period for provider errors.
+func (r *OGXServerReconciler) providerErrorGracePeriod() time.Duration {
+	if r.ProviderErrorGracePeriod > 0 {
+		return r.ProviderErrorGracePeriod
+	}
+	return DefaultProviderErrorGracePeriod
+}
+
+// normalizeProviderHealth records transient warm-up statuses as Initializing so
+// they are not mistaken for errors.
+func normalizeProviderHealth(providers []ogxiov1beta1.ProviderInfo) {
+	for i := range providers {
+		status := strings.ToLower(strings.TrimSpace(providers[i].Health.Status))
+		for _, transient := range transientProviderStatuses {
+			if status == transient {
+				providers[i].Health.Status = ogxiov1beta1.ProviderHealthInitializing
+				break
+			}
+		}
+	}
+}
+
+// summarizeProviderHealth returns the IDs of initializing and failing providers.
+func summarizeProviderHealth(providers []ogxiov1beta1.ProviderInfo) ([]string, []string) {
+	var initializing, failing []string
+	for _, provider := range providers {
+		switch provider.Health.Status {
+		case ogxiov1beta1.ProviderHealthInitializing:
+			initializing = append(initializing, provider.ProviderID)
+		case ogxiov1beta1.ProviderHealthError:
+			failing = append(failing, provider.ProviderID)
+		}
+	}
+	return initializing, failing
+}
+
+// requireHealthyProviders reports whether the instance waits for healthy
+// providers before it is Ready.
+func requireHealthyProviders(instance *ogxiov1beta1.OGXServer) bool {
+	return instance.Spec.Workload != nil && instance.Spec.Workload.RequireHealthyProviders
+}
+
+// refreshProviderHealth fetches the providers from the server, records them in
+// the status and updates the provider health conditions and rollout gate. When
+// the server cannot be queried, the last-known provider list is kept and
+// marked stale, as is the server version. Servers that require healthy
+// providers stay Initializing until the providers can be queried.
+func (r *OGXServerReconciler) refreshProviderHealth(ctx context.Context, instance *ogxiov1beta1.OGXServer) {
+	providers, err := r.getProviderInfo(ctx, instance)
+	key := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+	r.providerQueryFailures.observe(key, err != nil, time.Now())
+	if err != nil {
+		log.FromContext(ctx).Error(err, "failed to get provider info, keeping the last-known provider list")
+		instance.Status.DistributionConfig.ProvidersStale = len(instance.Status.DistributionConfig.Providers) > 0
+		if requireHealthyProviders(instance) {
+			instance.Status.Phase = ogxiov1beta1.OGXServerPhaseInitializing
+		}
+		return
+	}
+
+	normalizeProviderHealth(providers)
+	instance.Status.DistributionConfig.Providers = providers
+	instance.Status.DistributionConfig.ProvidersStale = false
+	r.updateProviderHealth(ctx, instance, providers)
+	r.gateRolloutOnProviderHealth(ctx, instance)
+}
+
+// readyRequeueAfter returns when a Ready server is reconciled again. While its
+// provider query keeps failing, it is retried after ProviderQueryRetryInterval
+// instead of the regular resync, so the provider list recovers on its own.
+func (r *OGXServerReconciler) readyRequeueAfter(key types.NamespacedName) time.Duration {
+	if r.ProviderQueryRetryInterval > 0 && r.providerQueryFailures.failing(key) {
+		return withJitter(r.ProviderQueryRetryInterval)
+	}
+	return readyResyncInterval
+}
+
+// updateProviderHealth aggregates provider health into the ProvidersHealthy
+// and Degraded conditions. While providers are initializing, or report errors
+// within the grace period after the Deployment became ready, the phase is kept
+// at Initializing. Errors on a server that has been ready for longer keep the
+// phase Ready and are not reported as degraded until they outlast the grace
+// period. Errors that outlast it mark the server as degraded while the phase
+// stays Ready, unless the instance requires healthy providers.
+func (r *OGXServerReconciler) updateProviderHealth(ctx context.Context, instance *ogxiov1beta1.OGXServer, providers []ogxiov1beta1.ProviderInfo) {
+	key := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+	initializing, failing := summarizeProviderHealth(providers)
+	now := time.Now()
+	failingFor := r.providerErrors.observe(key, len(failing) > 0, now)
+	readyFor := r.serverReady.elapsed(key, now)
+	gracePeriod := r.providerErrorGracePeriod()
+
+	switch {
+	case len(initializing) > 0:
+		instance.Status.Phase = ogxiov1beta1.OGXServerPhaseInitializing
+		SetProvidersHealthyCondition(&instance.Status, false, ReasonProvidersInitializing,
+			fmt.Sprintf("Providers are initializing: %s", strings.Join(initializing, ", ")))
+	case len(failing) > 0 && failingFor < gracePeriod && readyFor < gracePeriod:
+		instance.Status.Phase = ogxiov1beta1.OGXServerPhaseInitializing
+		SetProvidersHealthyCondition(&instance.Status, false, ReasonProvidersInitializing,
+			fmt.Sprintf("Providers report errors, waiting up to %s for them to recover: %s",
+				gracePeriod, strings.Join(failing, ", ")))
+	case len(failing) > 0 && failingFor < gracePeriod:
+		SetProvidersHealthyCondition(&instance.Status, false, ReasonProvidersDegraded,
+			fmt.Sprintf("Providers report errors, waiting up to %s before marking the server degraded: %s",
+				gracePeriod, strings.Join(failing, ", ")))
+	case len(failing) > 0:
+		log.FromContext(ctx).Info("Providers keep reporting errors", "providers", failing, "duration", failingFor)
+		msg := fmt.Sprintf("Providers report errors: %s", strings.Join(failing, ", "))
+		SetProvidersHealthyCondition(&instance.Status, false, ReasonProvidersDegraded, msg)
+		SetDegradedCondition(&instance.Status, true, msg)
+		if requireHealthyProviders(instance) {
+			instance.Status.Phase = ogxiov1beta1.OGXServerPhaseInitializing
+		}
+		return
+	default:
+		SetProvidersHealthyCondition(&instance.Status, true, "", "")
+	}
+	SetDegradedCondition(&instance.Status, false, "")
+}
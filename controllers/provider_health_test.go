@@ -0,0 +1,369 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func newTestProvider(id, status string) ogxiov1beta1.ProviderInfo {
+	return ogxiov1beta1.ProviderInfo{
+		API:        "inference",
+		ProviderID: id,
+		Health:     ogxiov1beta1.ProviderHealthStatus{Status: status},
+	}
+}
+
+func TestNormalizeProviderHealth(t *testing.T) {
+	providers := []ogxiov1beta1.ProviderInfo{
+		newTestProvider("a", "initializing"),
+		newTestProvider("b", "Starting"),
+		newTestProvider("c", ogxiov1beta1.ProviderHealthOK),
+		newTestProvider("d", ogxiov1beta1.ProviderHealthError),
+	}
+
+	normalizeProviderHealth(providers)
+
+	assert.Equal(t, ogxiov1beta1.ProviderHealthInitializing, providers[0].Health.Status)
+	assert.Equal(t, ogxiov1beta1.ProviderHealthInitializing, providers[1].Health.Status)
+	assert.Equal(t, ogxiov1beta1.ProviderHealthOK, providers[2].Health.Status)
+	assert.Equal(t, ogxiov1beta1.ProviderHealthError, providers[3].Health.Status)
+}
+
+func TestUpdateProviderHealth(t *testing.T) {
+	key := types.NamespacedName{Name: "test", Namespace: "default"}
+
+	newInstance := func() *ogxiov1beta1.OGXServer {
+		return &ogxiov1beta1.OGXServer{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Status:     ogxiov1beta1.OGXServerStatus{Phase: ogxiov1beta1.OGXServerPhaseReady},
+		}
+	}
+
+	t.Run("keeps phase initializing while providers warm up", func(t *testing.T) {
+		r := &OGXServerReconciler{}
+		instance := newInstance()
+
+		r.updateProviderHealth(t.Context(), instance, []ogxiov1beta1.ProviderInfo{
+			newTestProvider("ollama", ogxiov1beta1.ProviderHealthInitializing),
+		})
+
+		assert.Equal(t, ogxiov1beta1.OGXServerPhaseInitializing, instance.Status.Phase)
+		condition := GetCondition(&instance.Status, ConditionTypeProvidersHealthy)
+		require.NotNil(t, condition)
+		assert.Equal(t, metav1.ConditionFalse, condition.Status)
+		assert.Equal(t, ReasonProvidersInitializing, condition.Reason)
+	})
+
+	t.Run("treats errors within the grace period as initializing", func(t *testing.T) {
+		r := &OGXServerReconciler{}
+		instance := newInstance()
+
+		r.updateProviderHealth(t.Context(), instance, []ogxiov1beta1.ProviderInfo{
+			newTestProvider("ollama", ogxiov1beta1.ProviderHealthError),
+		})
+
+		assert.Equal(t, ogxiov1beta1.OGXServerPhaseInitializing, instance.Status.Phase)
+		assert.Equal(t, ReasonProvidersInitializing, GetCondition(&instance.Status, ConditionTypeProvidersHealthy).Reason)
+	})
+
+	t.Run("keeps a server that has been ready Ready while new errors are within the grace period", func(t *testing.T) {
+		r := &OGXServerReconciler{ProviderErrorGracePeriod: time.Minute}
+		r.serverReady.observe(key, true, time.Now().Add(-time.Hour))
+		instance := newInstance()
+
+		r.updateProviderHealth(t.Context(), instance, []ogxiov1beta1.ProviderInfo{
+			newTestProvider("ollama", ogxiov1beta1.ProviderHealthError),
+		})
+
+		assert.Equal(t, ogxiov1beta1.OGXServerPhaseReady, instance.Status.Phase)
+		assert.False(t, IsConditionTrue(&instance.Status, ConditionTypeDegraded))
+		condition := GetCondition(&instance.Status, ConditionTypeProvidersHealthy)
+		require.NotNil(t, condition)
+		assert.Equal(t, metav1.ConditionFalse, condition.Status)
+		assert.Contains(t, condition.Message, "ollama")
+	})
+
+	t.Run("marks degraded when errors persist", func(t *testing.T) {
+		r := &OGXServerReconciler{ProviderErrorGracePeriod: time.Minute}
+		r.providerErrors.observe(key, true, time.Now().Add(-time.Hour))
+		instance := newInstance()
+
+		r.updateProviderHealth(t.Context(), instance, []ogxiov1beta1.ProviderInfo{
+			newTestProvider("ollama", ogxiov1beta1.ProviderHealthError),
+		})
+
+		assert.Equal(t, ogxiov1beta1.OGXServerPhaseReady, instance.Status.Phase)
+		condition := GetCondition(&instance.Status, ConditionTypeProvidersHealthy)
+		require.NotNil(t, condition)
+		assert.Equal(t, ReasonProvidersDegraded, condition.Reason)
+		assert.Contains(t, condition.Message, "ollama")
+	})
+
+	t.Run("recovery resets the error clock", func(t *testing.T) {
+		r := &OGXServerReconciler{}
+		r.providerErrors.observe(key, true, time.Now().Add(-time.Hour))
+		instance := newInstance()
+
+		r.updateProviderHealth(t.Context(), instance, []ogxiov1beta1.ProviderInfo{
+			newTestProvider("ollama", ogxiov1beta1.ProviderHealthOK),
+		})
+		assert.True(t, IsConditionTrue(&instance.Status, ConditionTypeProvidersHealthy))
+		assert.Equal(t, ogxiov1beta1.OGXServerPhaseReady, instance.Status.Phase)
+
+		r.updateProviderHealth(t.Context(), instance, []ogxiov1beta1.ProviderInfo{
+			newTestProvider("ollama", ogxiov1beta1.ProviderHealthError),
+		})
+		assert.Equal(t, ReasonProvidersInitializing, GetCondition(&instance.Status, ConditionTypeProvidersHealthy).Reason)
+	})
+}
+
+// roundTripperFunc adapts a function to an http.RoundTripper.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRefreshProviderHealth(t *testing.T) {
+	key := types.NamespacedName{Name: "test", Namespace: "default"}
+	const mixedProviders = `{"data": [
+		{"api": "inference", "provider_id": "vllm", "provider_type": "remote::vllm", "health": {"status": "OK"}},
+		{"api": "vector_io", "provider_id": "milvus", "provider_type": "inline::milvus", "health": {"status": "Error", "message": "connection refused"}}
+	]}`
+
+	newReconciler := func(body string) *OGXServerReconciler {
+		return &OGXServerReconciler{
+			ProviderErrorGracePeriod: time.Minute,
+			httpClient: &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				if req.URL.Path != "/v1/providers" {
+					return &http.Response{StatusCode: http.StatusNotFound, Body: http.NoBody}, nil
+				}
+				return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}, nil
+			})},
+		}
+	}
+	newInstance := func() *ogxiov1beta1.OGXServer {
+		return &ogxiov1beta1.OGXServer{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Status:     ogxiov1beta1.OGXServerStatus{Phase: ogxiov1beta1.OGXServerPhaseReady},
+		}
+	}
+
+	t.Run("marks a Ready server degraded when errors persist", func(t *testing.T) {
+		r := newReconciler(mixedProviders)
+		r.providerErrors.observe(key, true, time.Now().Add(-time.Hour))
+		instance := newInstance()
+
+		r.refreshProviderHealth(t.Context(), instance)
+
+		assert.Len(t, instance.Status.DistributionConfig.Providers, 2)
+		assert.Equal(t, ogxiov1beta1.OGXServerPhaseReady, instance.Status.Phase)
+		condition := GetCondition(&instance.Status, ConditionTypeDegraded)
+		require.NotNil(t, condition)
+		assert.Equal(t, metav1.ConditionTrue, condition.Status)
+		assert.Equal(t, ReasonProvidersDegraded, condition.Reason)
+		assert.Contains(t, condition.Message, "milvus")
+		assert.NotContains(t, condition.Message, "vllm")
+	})
+
+	t.Run("errors within the grace period do not degrade", func(t *testing.T) {
+		r := newReconciler(mixedProviders)
+		instance := newInstance()
+
+		r.refreshProviderHealth(t.Context(), instance)
+
+		assert.Equal(t, ogxiov1beta1.OGXServerPhaseInitializing, instance.Status.Phase)
+		condition := GetCondition(&instance.Status, ConditionTypeDegraded)
+		require.NotNil(t, condition)
+		assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	})
+
+	t.Run("healthy providers clear the condition", func(t *testing.T) {
+		r := newReconciler(`{"data": [{"api": "inference", "provider_id": "vllm", "health": {"status": "OK"}}]}`)
+		instance := newInstance()
+		SetDegradedCondition(&instance.Status, true, "Providers report errors: vllm")
+
+		r.refreshProviderHealth(t.Context(), instance)
+
+		condition := GetCondition(&instance.Status, ConditionTypeDegraded)
+		require.NotNil(t, condition)
+		assert.Equal(t, metav1.ConditionFalse, condition.Status)
+		assert.Equal(t, ReasonNotDegraded, condition.Reason)
+		assert.True(t, IsConditionTrue(&instance.Status, ConditionTypeProvidersHealthy))
+	})
+
+	t.Run("keeps providers when the server cannot be queried", func(t *testing.T) {
+		r := newReconciler("not json")
+		instance := newInstance()
+		lastKnown := []ogxiov1beta1.ProviderInfo{newTestProvider("vllm", ogxiov1beta1.ProviderHealthOK)}
+		instance.Status.DistributionConfig.Providers = lastKnown
+
+		r.refreshProviderHealth(t.Context(), instance)
+
+		assert.Equal(t, lastKnown, instance.Status.DistributionConfig.Providers)
+		assert.True(t, instance.Status.DistributionConfig.ProvidersStale)
+		assert.Equal(t, ogxiov1beta1.OGXServerPhaseReady, instance.Status.Phase)
+		assert.Nil(t, GetCondition(&instance.Status, ConditionTypeDegraded))
+	})
+
+	t.Run("a successful refresh clears the stale marker", func(t *testing.T) {
+		r := newReconciler(`{"data": [{"api": "inference", "provider_id": "vllm", "health": {"status": "OK"}}]}`)
+		instance := newInstance()
+		instance.Status.DistributionConfig.ProvidersStale = true
+
+		r.refreshProviderHealth(t.Context(), instance)
+
+		assert.Len(t, instance.Status.DistributionConfig.Providers, 1)
+		assert.False(t, instance.Status.DistributionConfig.ProvidersStale)
+	})
+
+	t.Run("nothing is stale without a previous list", func(t *testing.T) {
+		r := newReconciler("not json")
+		instance := newInstance()
+
+		r.refreshProviderHealth(t.Context(), instance)
+
+		assert.Empty(t, instance.Status.DistributionConfig.Providers)
+		assert.False(t, instance.Status.DistributionConfig.ProvidersStale)
+	})
+
+	t.Run("requiring healthy providers holds persistent errors at Initializing", func(t *testing.T) {
+		r := newReconciler(mixedProviders)
+		r.providerErrors.observe(key, true, time.Now().Add(-time.Hour))
+		instance := newInstance()
+		instance.Spec.Workload = &ogxiov1beta1.WorkloadSpec{RequireHealthyProviders: true}
+
+		r.refreshProviderHealth(t.Context(), instance)
+
+		assert.Equal(t, ogxiov1beta1.OGXServerPhaseInitializing, instance.Status.Phase)
+		assert.True(t, IsConditionTrue(&instance.Status, ConditionTypeDegraded))
+		assert.Equal(t, ReasonProvidersDegraded, GetCondition(&instance.Status, ConditionTypeProvidersHealthy).Reason)
+	})
+
+	t.Run("requiring healthy providers holds at Initializing when the server cannot be queried", func(t *testing.T) {
+		r := newReconciler("not json")
+		instance := newInstance()
+		instance.Spec.Workload = &ogxiov1beta1.WorkloadSpec{RequireHealthyProviders: true}
+
+		r.refreshProviderHealth(t.Context(), instance)
+
+		assert.Equal(t, ogxiov1beta1.OGXServerPhaseInitializing, instance.Status.Phase)
+	})
+
+	t.Run("requiring healthy providers is Ready once providers are OK", func(t *testing.T) {
+		r := newReconciler(`{"data": [{"api": "inference", "provider_id": "vllm", "health": {"status": "OK"}}]}`)
+		instance := newInstance()
+		instance.Spec.Workload = &ogxiov1beta1.WorkloadSpec{RequireHealthyProviders: true}
+
+		r.refreshProviderHealth(t.Context(), instance)
+
+		assert.Equal(t, ogxiov1beta1.OGXServerPhaseReady, instance.Status.Phase)
+		assert.True(t, IsConditionTrue(&instance.Status, ConditionTypeProvidersHealthy))
+	})
+}
+
+func TestServerAPIBasePath(t *testing.T) {
+	var requested []string
+	r := &OGXServerReconciler{
+		httpClient: &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			requested = append(requested, req.URL.Path)
+			switch req.URL.Path {
+			case "/api/v2/providers":
+				return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"data": []}`))}, nil
+			case "/api/v2/version":
+				return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"version": "1.2.3"}`))}, nil
+			}
+			return &http.Response{StatusCode: http.StatusNotFound, Body: http.NoBody}, nil
+		})},
+	}
+	instance := &ogxiov1beta1.OGXServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: ogxiov1beta1.OGXServerSpec{
+			Workload: &ogxiov1beta1.WorkloadSpec{APIBasePath: "/api/v2"},
+		},
+	}
+
+	_, err := r.getProviderInfo(t.Context(), instance)
+	require.NoError(t, err)
+	version, err := r.getVersionInfo(t.Context(), instance)
+	require.NoError(t, err)
+	assert.Equal(t, "1.2.3", version)
+	assert.Equal(t, []string{"/api/v2/providers", "/api/v2/version"}, requested)
+}
+
+func TestReadyRequeueAfter(t *testing.T) {
+	key := types.NamespacedName{Name: "test", Namespace: "default"}
+	const retryInterval = 30 * time.Second
+
+	newReconciler := func(interval time.Duration, queryErr error) *OGXServerReconciler {
+		return &OGXServerReconciler{
+			ProviderQueryRetryInterval: interval,
+			httpClient: &http.Client{Transport: roundTripperFunc(func(*http.Request) (*http.Response, error) {
+				if queryErr != nil {
+					return nil, queryErr
+				}
+				body := `{"data": [{"api": "inference", "provider_id": "vllm", "health": {"status": "OK"}}]}`
+				return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}, nil
+			})},
+		}
+	}
+	newInstance := func() *ogxiov1beta1.OGXServer {
+		return &ogxiov1beta1.OGXServer{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Status:     ogxiov1beta1.OGXServerStatus{Phase: ogxiov1beta1.OGXServerPhaseReady},
+		}
+	}
+
+	t.Run("a failed provider query schedules an early retry", func(t *testing.T) {
+		r := newReconciler(retryInterval, errors.New("connection refused"))
+
+		r.refreshProviderHealth(t.Context(), newInstance())
+
+		after := r.readyRequeueAfter(key)
+		assert.GreaterOrEqual(t, after, retryInterval)
+		assert.Less(t, after, readyResyncInterval)
+	})
+
+	t.Run("a successful query restores the regular resync", func(t *testing.T) {
+		r := newReconciler(retryInterval, nil)
+		r.providerQueryFailures.observe(key, true, time.Now())
+
+		r.refreshProviderHealth(t.Context(), newInstance())
+
+		assert.Equal(t, readyResyncInterval, r.readyRequeueAfter(key))
+	})
+
+	t.Run("a zero interval waits for the regular resync", func(t *testing.T) {
+		r := newReconciler(0, errors.New("connection refused"))
+
+		r.refreshProviderHealth(t.Context(), newInstance())
+
+		assert.Equal(t, readyResyncInterval, r.readyRequeueAfter(key))
+	})
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// DefaultReconcileFailureThreshold is the number of consecutive failures with
+	// the same error after which the reconciler stops active retries.
+	DefaultReconcileFailureThreshold = 5
+	// DefaultReconcileBackoffInterval is the periodic retry interval used once the
+	// failure threshold is reached.
+	DefaultReconcileBackoffInterval = 30 * time.Minute
+)
+
+// reconcileFailure records consecutive reconcile failures for one OGXServer.
+type reconcileFailure struct {
+	generation int64
+	reason     string
+	count      int
+}
+
+// failureTracker counts consecutive reconcile failures per OGXServer so that a
+// permanently broken CR stops consuming reconcile capacity. The count resets
+// when the error changes, the spec generation changes, or a reconcile succeeds.
+type failureTracker struct {
+	mu       sync.Mutex
+	failures map[types.NamespacedName]*reconcileFailure
+}
+
+// record updates the failure count for key and returns the new count.
+// A nil err clears the record and returns 0.
+func (t *failureTracker) record(key types.NamespacedName, generation int64, err error) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err == nil {
+		delete(t.failures, key)
+		return 0
+	}
+
+	if t.failures == nil {
+		t.failures = make(map[types.NamespacedName]*reconcileFailure)
+	}
+
+	reason := err.Error()
+	if rec, ok := t.failures[key]; ok && rec.generation == generation && rec.reason == reason {
+		rec.count++
+		return rec.count
+	}
+
+	t.failures[key] = &reconcileFailure{generation: generation, reason: reason, count: 1}
+	return 1
+}
+
+// forget drops any failure record for key, e.g. after the CR is deleted.
+func (t *failureTracker) forget(key types.NamespacedName) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.failures, key)
+}
+
+// isTransientError reports whether err is a conflict, timeout or overload
+// error from the API server. The same such error can repeat for a while, but
+// it says nothing about the CR, so it must not open the circuit breaker.
+func isTransientError(err error) bool {
+	return k8serrors.IsConflict(err) ||
+		k8serrors.IsTimeout(err) ||
+		k8serrors.IsServerTimeout(err) ||
+		k8serrors.IsTooManyRequests(err) ||
+		k8serrors.IsServiceUnavailable(err) ||
+		errors.Is(err, context.DeadlineExceeded)
+}
+
+// shouldBackOff reports whether count consecutive failures reach the configured
+// threshold. A threshold of zero or less disables the circuit breaker.
+func (r *OGXServerReconciler) shouldBackOff(count int) bool {
+	return r.ReconcileFailureThreshold > 0 && count >= r.ReconcileFailureThreshold
+}
+
+// backoffInterval returns the periodic retry interval used while backing off.
+func (r *OGXServerReconciler) backoffInterval() time.Duration {
+	if r.ReconcileBackoffInterval > 0 {
+		return r.ReconcileBackoffInterval
+	}
+	return DefaultReconcileBackoffInterval
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+func TestFailureTrackerRecord(t *testing.T) {
+	key := types.NamespacedName{Name: "test", Namespace: "default"}
+	errImage := errors.New("failed to validate distribution: unknown")
+
+	t.Run("counts consecutive identical failures", func(t *testing.T) {
+		var tracker failureTracker
+		assert.Equal(t, 1, tracker.record(key, 1, errImage))
+		assert.Equal(t, 2, tracker.record(key, 1, errImage))
+		assert.Equal(t, 3, tracker.record(key, 1, errImage))
+	})
+
+	t.Run("resets on a different error", func(t *testing.T) {
+		var tracker failureTracker
+		tracker.record(key, 1, errImage)
+		tracker.record(key, 1, errImage)
+		assert.Equal(t, 1, tracker.record(key, 1, errors.New("another failure")))
+	})
+
+	t.Run("resets on spec change", func(t *testing.T) {
+		var tracker failureTracker
+		tracker.record(key, 1, errImage)
+		tracker.record(key, 1, errImage)
+		assert.Equal(t, 1, tracker.record(key, 2, errImage))
+	})
+
+	t.Run("resets on success", func(t *testing.T) {
+		var tracker failureTracker
+		tracker.record(key, 1, errImage)
+		assert.Equal(t, 0, tracker.record(key, 1, nil))
+		assert.Equal(t, 1, tracker.record(key, 1, errImage))
+	})
+
+	t.Run("forget drops the record", func(t *testing.T) {
+		var tracker failureTracker
+		tracker.record(key, 1, errImage)
+		tracker.forget(key)
+		assert.Equal(t, 1, tracker.record(key, 1, errImage))
+	})
+}
+
+func TestUpdateReconcileBackoff(t *testing.T) {
+	key := types.NamespacedName{Name: "test", Namespace: "default"}
+	reconcileErr := errors.New("failed to validate distribution: unknown")
+
+	newInstance := func() *ogxiov1beta1.OGXServer {
+		return &ogxiov1beta1.OGXServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", Generation: 1}}
+	}
+
+	t.Run("backs off once the threshold is reached", func(t *testing.T) {
+		r := &OGXServerReconciler{ReconcileFailureThreshold: 3, ReconcileBackoffInterval: time.Hour}
+		instance := newInstance()
+
+		assert.False(t, r.updateReconcileBackoff(t.Context(), key, instance, reconcileErr))
+		assert.False(t, r.updateReconcileBackoff(t.Context(), key, instance, reconcileErr))
+		assert.Nil(t, GetCondition(&instance.Status, ConditionTypeReconcileBackingOff))
+
+		assert.True(t, r.updateReconcileBackoff(t.Context(), key, instance, reconcileErr))
+		condition := GetCondition(&instance.Status, ConditionTypeReconcileBackingOff)
+		require.NotNil(t, condition)
+		assert.Equal(t, metav1.ConditionTrue, condition.Status)
+		assert.Equal(t, ReasonRepeatedFailures, condition.Reason)
+		assert.Contains(t, condition.Message, reconcileErr.Error())
+	})
+
+	t.Run("ignores transient API errors", func(t *testing.T) {
+		r := &OGXServerReconciler{ReconcileFailureThreshold: 1}
+		instance := newInstance()
+		conflict := fmt.Errorf("failed to update deployment: %w",
+			k8serrors.NewConflict(schema.GroupResource{Group: "apps", Resource: "deployments"}, "test", errors.New("modified")))
+
+		for _, err := range []error{
+			conflict,
+			k8serrors.NewTimeoutError("request timed out", 1),
+			k8serrors.NewServerTimeout(schema.GroupResource{Resource: "services"}, "get", 1),
+			fmt.Errorf("failed to get service: %w", context.DeadlineExceeded),
+		} {
+			assert.False(t, r.updateReconcileBackoff(t.Context(), key, instance, err), err.Error())
+		}
+		assert.Nil(t, GetCondition(&instance.Status, ConditionTypeReconcileBackingOff))
+	})
+
+	t.Run("clears the condition after a spec change", func(t *testing.T) {
+		r := &OGXServerReconciler{ReconcileFailureThreshold: 1}
+		instance := newInstance()
+		require.True(t, r.updateReconcileBackoff(t.Context(), key, instance, reconcileErr))
+
+		instance.Generation = 2
+		assert.False(t, r.updateReconcileBackoff(t.Context(), key, instance, nil))
+		assert.True(t, IsConditionFalse(&instance.Status, ConditionTypeReconcileBackingOff))
+	})
+
+	t.Run("disabled with zero threshold", func(t *testing.T) {
+		r := &OGXServerReconciler{}
+		instance := newInstance()
+		for range 10 {
+			assert.False(t, r.updateReconcileBackoff(t.Context(), key, instance, reconcileErr))
+		}
+		assert.Equal(t, DefaultReconcileBackoffInterval, r.backoffInterval())
+	})
+}
+
+func TestOGXServerUpdatePredicateIgnoresStatusWrites(t *testing.T) {
+	r := &OGXServerReconciler{ReconcileFailureThreshold: 1}
+	update := r.ogxServerUpdatePredicate(logr.Discard())
+	key := types.NamespacedName{Name: "test", Namespace: "default"}
+	healthy := &ogxiov1beta1.OGXServer{ObjectMeta: metav1.ObjectMeta{
+		Name: key.Name, Namespace: key.Namespace, Generation: 1, ResourceVersion: "1",
+	}}
+	old := healthy.DeepCopy()
+	require.True(t, r.updateReconcileBackoff(t.Context(), key, old, errors.New("failed to validate distribution")))
+
+	t.Run("a status write is reconciled", func(t *testing.T) {
+		updated := healthy.DeepCopy()
+		SetDeploymentReadyCondition(&updated.Status, true, MessageDeploymentReady)
+		updated.ResourceVersion = "2"
+
+		assert.True(t, update(event.UpdateEvent{ObjectOld: healthy, ObjectNew: updated}))
+	})
+
+	t.Run("a backed-off instance is not requeued by its own status write", func(t *testing.T) {
+		updated := old.DeepCopy()
+		require.True(t, r.updateReconcileBackoff(t.Context(), key, updated, errors.New("failed to validate distribution")))
+		updated.ResourceVersion = "2"
+
+		assert.False(t, update(event.UpdateEvent{ObjectOld: old, ObjectNew: updated}))
+	})
+
+	t.Run("a spec change is reconciled", func(t *testing.T) {
+		updated := old.DeepCopy()
+		updated.Spec.Distribution.Name = "starter"
+		updated.Generation = 2
+
+		assert.True(t, update(event.UpdateEvent{ObjectOld: old, ObjectNew: updated}))
+	})
+
+	t.Run("an annotation change is reconciled", func(t *testing.T) {
+		updated := old.DeepCopy()
+		updated.Annotations = map[string]string{"ogx.io/restartedAt": "now"}
+
+		assert.True(t, update(event.UpdateEvent{ObjectOld: old, ObjectNew: updated}))
+	})
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"time"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// reconcilePhase identifies a timed phase of a reconcile.
+type reconcilePhase int
+
+const (
+	reconcilePhaseRender reconcilePhase = iota
+	reconcilePhaseApply
+	reconcilePhaseHealthProbe
+	reconcilePhaseTotal
+)
+
+// startReconcileTimings resets the reported timings of instance for a new
+// reconcile, or clears them when timings are not reported.
+func (r *OGXServerReconciler) startReconcileTimings(instance *ogxiov1beta1.OGXServer, now time.Time) {
+	if !r.ReportReconcileTimings {
+		instance.Status.ReconcileTimings = nil
+		return
+	}
+	instance.Status.ReconcileTimings = &ogxiov1beta1.ReconcileTimings{StartedAt: metav1.NewTime(now.UTC())}
+}
+
+// recordPhaseDuration stores the time elapsed since start for phase when
+// timings are reported. The total is measured from the start of the reconcile.
+func recordPhaseDuration(instance *ogxiov1beta1.OGXServer, phase reconcilePhase, start time.Time) {
+	timings := instance.Status.ReconcileTimings
+	if timings == nil {
+		return
+	}
+	if phase == reconcilePhaseTotal {
+		start = timings.StartedAt.Time
+	}
+
+	duration := &metav1.Duration{Duration: time.Since(start).Round(time.Millisecond)}
+	switch phase {
+	case reconcilePhaseRender:
+		timings.Render = duration
+	case reconcilePhaseApply:
+		timings.Apply = duration
+	case reconcilePhaseHealthProbe:
+		timings.HealthProbe = duration
+	case reconcilePhaseTotal:
+		timings.Total = duration
+	}
+}
+
+// recordLastReconcile stores when the reconcile that started at start finished
+// and how long it took. Unlike the timings, it is always reported.
+func recordLastReconcile(instance *ogxiov1beta1.OGXServer, start, now time.Time) {
+	finished := metav1.NewTime(now.UTC())
+	instance.Status.LastReconcileTime = &finished
+	instance.Status.LastReconcileDuration = &metav1.Duration{Duration: now.Sub(start)}
+}
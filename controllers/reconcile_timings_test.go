@@ -0,0 +1,89 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestReconcileTimings(t *testing.T) {
+	t.Run("records each phase when reported", func(t *testing.T) {
+		r := &OGXServerReconciler{ReportReconcileTimings: true}
+		instance := &ogxiov1beta1.OGXServer{}
+		started := time.Now().Add(-3 * time.Second)
+
+		r.startReconcileTimings(instance, started)
+		recordPhaseDuration(instance, reconcilePhaseRender, time.Now().Add(-time.Second))
+		recordPhaseDuration(instance, reconcilePhaseApply, time.Now().Add(-2*time.Second))
+		recordPhaseDuration(instance, reconcilePhaseTotal, time.Time{})
+
+		timings := instance.Status.ReconcileTimings
+		require.NotNil(t, timings)
+		assert.True(t, timings.StartedAt.Equal(&metav1.Time{Time: started.UTC()}))
+		require.NotNil(t, timings.Render)
+		assert.GreaterOrEqual(t, timings.Render.Duration, time.Second)
+		require.NotNil(t, timings.Apply)
+		assert.GreaterOrEqual(t, timings.Apply.Duration, 2*time.Second)
+		assert.Nil(t, timings.HealthProbe, "phases that did not run are omitted")
+		require.NotNil(t, timings.Total)
+		assert.GreaterOrEqual(t, timings.Total.Duration, 3*time.Second, "total is measured from the reconcile start")
+	})
+
+	t.Run("a new reconcile drops timings of the previous one", func(t *testing.T) {
+		r := &OGXServerReconciler{ReportReconcileTimings: true}
+		instance := &ogxiov1beta1.OGXServer{}
+		r.startReconcileTimings(instance, time.Now())
+		recordPhaseDuration(instance, reconcilePhaseHealthProbe, time.Now())
+
+		r.startReconcileTimings(instance, time.Now())
+
+		assert.Nil(t, instance.Status.ReconcileTimings.HealthProbe)
+	})
+
+	t.Run("clears timings when not reported", func(t *testing.T) {
+		r := &OGXServerReconciler{}
+		instance := &ogxiov1beta1.OGXServer{Status: ogxiov1beta1.OGXServerStatus{
+			ReconcileTimings: &ogxiov1beta1.ReconcileTimings{},
+		}}
+
+		r.startReconcileTimings(instance, time.Now())
+		recordPhaseDuration(instance, reconcilePhaseRender, time.Now())
+
+		assert.Nil(t, instance.Status.ReconcileTimings)
+	})
+}
+
+func TestRecordLastReconcile(t *testing.T) {
+	instance := &ogxiov1beta1.OGXServer{}
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	recordLastReconcile(instance, start, start.Add(1500*time.Millisecond))
+	first := instance.Status.LastReconcileTime
+	require.NotNil(t, first)
+	require.NotNil(t, instance.Status.LastReconcileDuration)
+	assert.Equal(t, 1500*time.Millisecond, instance.Status.LastReconcileDuration.Duration)
+
+	recordLastReconcile(instance, start.Add(time.Minute), start.Add(time.Minute+time.Second))
+	assert.True(t, instance.Status.LastReconcileTime.After(first.Time))
+	assert.Equal(t, time.Second, instance.Status.LastReconcileDuration.Duration)
+}
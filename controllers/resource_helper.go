@@ -17,12 +17,21 @@ limitations under the License.
 package controllers
 
 import (
+	"cmp"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"maps"
+	"path"
+	"slices"
 	"strconv"
+	"strings"
 
+	"github.com/google/go-containerregistry/pkg/name"
 	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/ogx-ai/ogx-k8s-operator/pkg/cluster"
 	"github.com/ogx-ai/ogx-k8s-operator/pkg/deploy"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
@@ -30,7 +39,9 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
 	ctrlLog "sigs.k8s.io/controller-runtime/pkg/log"
+	sigsyaml "sigs.k8s.io/yaml"
 )
 
 // Constants for validation limits.
@@ -40,6 +51,26 @@ const (
 	FSGroup = int64(1001)
 	// instanceLabelKey is the label we apply to all resources for per-instance targeting.
 	instanceLabelKey = "app.kubernetes.io/instance"
+	// maxManagedNameLength keeps derived resource names within an RFC 1123
+	// subdomain, the limit for ConfigMap names. Names within it are left as
+	// they are, so that upgrading does not rename existing resources.
+	maxManagedNameLength = validation.DNS1123SubdomainMaxLength
+	// managedNameHashLength is the length of the hash suffix of shortened names.
+	managedNameHashLength = 8
+	// storageVolumeName is the name of the operator-managed storage volume.
+	storageVolumeName = "ogx-storage"
+	// userConfigVolumeName is the name of the operator-managed override config volume.
+	userConfigVolumeName = "user-config"
+	// userConfigMountPath is where the override config volume is mounted.
+	userConfigMountPath = "/etc/ogx/"
+	// tmpVolumeName is the name of the writable scratch volume mounted when the
+	// root filesystem is read-only.
+	tmpVolumeName = "tmp"
+	// tmpMountPath is where the scratch volume is mounted.
+	tmpMountPath = "/tmp"
+	// defaultTerminationGracePeriodSeconds gives the server time to drain
+	// in-flight inference requests, which can take longer than the Kubernetes default.
+	defaultTerminationGracePeriodSeconds = int64(60)
 )
 
 var (
@@ -55,9 +86,49 @@ const (
 	startupProbeSuccessThreshold    = 1  // Pod is marked Ready after 1 successful probe
 )
 
-// getManagedCABundleConfigMapName returns the name of the managed CA bundle ConfigMap.
+// getManagedCABundleConfigMapName returns the name of the managed CA bundle
+// ConfigMap: the configured name, or the instance name with the bundle suffix.
+// Default names longer than maxManagedNameLength are shortened deterministically.
 func getManagedCABundleConfigMapName(instance *ogxiov1beta1.OGXServer) string {
-	return instance.Name + ManagedCABundleConfigMapSuffix
+	if instance.Spec.TLS != nil && instance.Spec.TLS.Trust != nil && instance.Spec.TLS.Trust.ManagedConfigMapName != "" {
+		return instance.Spec.TLS.Trust.ManagedConfigMapName
+	}
+	return shortenName(instance.Name, ManagedCABundleConfigMapSuffix)
+}
+
+// shortenName returns base followed by suffix. When that exceeds
+// maxManagedNameLength, base is truncated and followed by a hash of the full
+// base, so that distinct instances keep distinct names.
+func shortenName(base, suffix string) string {
+	if len(base)+len(suffix) <= maxManagedNameLength {
+		return base + suffix
+	}
+	sum := sha256.Sum256([]byte(base))
+	hash := hex.EncodeToString(sum[:])[:managedNameHashLength]
+	truncated := strings.TrimRight(base[:maxManagedNameLength-len(suffix)-managedNameHashLength-1], "-.")
+	return truncated + "-" + hash + suffix
+}
+
+// preservesCABundleKeys reports whether the managed CA bundle keeps source keys.
+func preservesCABundleKeys(instance *ogxiov1beta1.OGXServer) bool {
+	return instance.Spec.TLS != nil && instance.Spec.TLS.Trust != nil && instance.Spec.TLS.Trust.PreserveKeys
+}
+
+// projectsCABundleIntoConfig reports whether the managed CA bundle is mounted
+// in the projected override config volume instead of its own volume. It never
+// is with Secret sources, whose bundle the ca-bundle init container assembles.
+func projectsCABundleIntoConfig(ctx context.Context, r *OGXServerReconciler, instance *ogxiov1beta1.OGXServer) bool {
+	return instance.Spec.Workload != nil && instance.Spec.Workload.ProjectedConfig &&
+		instance.Spec.OverrideConfig != nil && instance.Spec.OverrideConfig.Name != "" && instance.Spec.OverrideConfig.Key != "" &&
+		hasAnyCABundle(ctx, r, instance) && !r.hasCACertificateSecrets(instance)
+}
+
+// getCABundleFilePath returns the path of the combined CA bundle file in the server container.
+func getCABundleFilePath(ctx context.Context, r *OGXServerReconciler, instance *ogxiov1beta1.OGXServer) string {
+	if projectsCABundleIntoConfig(ctx, r, instance) {
+		return path.Join(userConfigMountPath, ManagedCABundleKey)
+	}
+	return ManagedCABundleFilePath
 }
 
 // startupScript is the script that will be used to start the server.
@@ -95,63 +166,188 @@ except Exception as e:
 
 PORT=${OGX_PORT:-8321}
 WORKERS=${OGX_WORKERS:-1}
+OGX_CONFIG=${OGX_CONFIG:-/etc/ogx/config.yaml}
 
 # Execute the appropriate CLI based on version
 case $VERSION_CODE in
-    0) python3 -m ogx.distribution.server.server --config /etc/ogx/config.yaml ;;
-    1) python3 -m ogx.core.server.server /etc/ogx/config.yaml ;;
+    0) python3 -m ogx.distribution.server.server --config "$OGX_CONFIG" ;;
+    1) python3 -m ogx.core.server.server "$OGX_CONFIG" ;;
     2) exec uvicorn ogx.core.server.server:create_app --host 0.0.0.0 --port "$PORT" --workers "$WORKERS" --factory ;;
     *) echo "Invalid version code: $VERSION_CODE, using uvicorn CLI command"; \
        exec uvicorn ogx.core.server.server:create_app --host 0.0.0.0 --port "$PORT" --workers "$WORKERS" --factory ;;
 esac`
 
-const ogxConfigPath = "/etc/ogx/config.yaml"
+// getConfigFileName returns the config filename the server of the instance's
+// distribution expects, as declared by its distribution catalog entry.
+func getConfigFileName(r *OGXServerReconciler, instance *ogxiov1beta1.OGXServer) string {
+	if r != nil && r.ClusterInfo != nil && instance.Spec.Distribution.Name != "" {
+		if fileName, ok := r.ClusterInfo.DistributionConfigFiles[instance.Spec.Distribution.Name]; ok {
+			return fileName
+		}
+	}
+	return cluster.DefaultConfigFileName
+}
+
+// getConfigPath returns the path of the config file the server is started with.
+func getConfigPath(r *OGXServerReconciler, instance *ogxiov1beta1.OGXServer) string {
+	return path.Join(userConfigMountPath, getConfigFileName(r, instance))
+}
 
-// getHealthProbe returns the health probe handler for the container.
+// getHealthCheckPath returns the path of the server health endpoint.
+func getHealthCheckPath(instance *ogxiov1beta1.OGXServer) string {
+	if instance.Spec.Workload != nil && instance.Spec.Workload.HealthCheckPath != "" {
+		return instance.Spec.Workload.HealthCheckPath
+	}
+	return ogxiov1beta1.DefaultHealthCheckPath
+}
+
+// getAPIPath returns the path of the given server API endpoint.
+func getAPIPath(instance *ogxiov1beta1.OGXServer, endpoint string) string {
+	basePath := ogxiov1beta1.DefaultAPIBasePath
+	if instance.Spec.Workload != nil && instance.Spec.Workload.APIBasePath != "" {
+		basePath = instance.Spec.Workload.APIBasePath
+	}
+	return path.Join(basePath, endpoint)
+}
+
+// getHealthProbe returns the health probe handler for the container. It runs
+// the workload.startupProbe command for the Exec type and queries the health
+// endpoint otherwise.
 func getHealthProbe(instance *ogxiov1beta1.OGXServer) corev1.ProbeHandler {
-	return corev1.ProbeHandler{
+	if instance.Spec.Workload != nil && instance.Spec.Workload.StartupProbe != nil &&
+		instance.Spec.Workload.StartupProbe.Type == ogxiov1beta1.StartupProbeTypeExec {
+		return corev1.ProbeHandler{
+			Exec: &corev1.ExecAction{Command: slices.Clone(instance.Spec.Workload.StartupProbe.Command)},
+		}
+	}
+	handler := corev1.ProbeHandler{
 		HTTPGet: &corev1.HTTPGetAction{
-			Path: "/v1/health",
+			Path: getHealthCheckPath(instance),
 			Port: intstr.FromInt(int(getContainerPort(instance))),
 		},
 	}
+	if isServingTLSEnabled(instance) {
+		handler.HTTPGet.Scheme = corev1.URISchemeHTTPS
+	}
+	return handler
 }
 
-// getStartupProbe returns the startup probe for the container.
+// getStartupProbe returns the startup probe for the container, with any
+// timing overrides from workload.startupProbe applied.
 func getStartupProbe(instance *ogxiov1beta1.OGXServer) *corev1.Probe {
-	return &corev1.Probe{
+	probe := &corev1.Probe{
 		ProbeHandler:        getHealthProbe(instance),
 		InitialDelaySeconds: startupProbeInitialDelaySeconds,
 		TimeoutSeconds:      startupProbeTimeoutSeconds,
 		FailureThreshold:    startupProbeFailureThreshold,
 		SuccessThreshold:    startupProbeSuccessThreshold,
 	}
+	if instance.Spec.Workload == nil || instance.Spec.Workload.StartupProbe == nil {
+		return probe
+	}
+	overrides := instance.Spec.Workload.StartupProbe
+	if overrides.InitialDelaySeconds != nil {
+		probe.InitialDelaySeconds = *overrides.InitialDelaySeconds
+	}
+	if overrides.PeriodSeconds != nil {
+		probe.PeriodSeconds = *overrides.PeriodSeconds
+	}
+	if overrides.TimeoutSeconds != nil {
+		probe.TimeoutSeconds = *overrides.TimeoutSeconds
+	}
+	if overrides.FailureThreshold != nil {
+		probe.FailureThreshold = *overrides.FailureThreshold
+	}
+	return probe
 }
 
 // buildContainerSpec creates the container specification.
 func buildContainerSpec(ctx context.Context, r *OGXServerReconciler, instance *ogxiov1beta1.OGXServer, image string) corev1.Container {
 	workers, workersSet := getEffectiveWorkers(instance)
 	container := corev1.Container{
-		Name:         ogxiov1beta1.DefaultContainerName,
-		Image:        image,
-		Resources:    resolveContainerResources(instance, workers, workersSet),
-		Ports:        []corev1.ContainerPort{{ContainerPort: getContainerPort(instance)}},
-		StartupProbe: getStartupProbe(instance),
+		Name:            ogxiov1beta1.DefaultContainerName,
+		Image:           image,
+		ImagePullPolicy: getImagePullPolicy(instance, image),
+		Resources:       resolveContainerResources(instance, defaultResources(ctx, r, instance), workers, workersSet),
+		Ports:           getContainerPorts(instance),
+		StartupProbe:    getStartupProbe(instance),
 	}
 	configureContainerEnvironment(ctx, r, instance, &container)
 	configureContainerMounts(ctx, r, instance, &container)
-	configureContainerCommands(instance, &container)
+	configureContainerCommands(ctx, r, instance, &container)
+	configureContainerSecurityContext(instance, &container)
+	configureContainerLifecycle(instance, &container)
 	return container
 }
 
+// configureContainerSecurityContext applies the user-specified security context
+// to the server container.
+func configureContainerSecurityContext(instance *ogxiov1beta1.OGXServer, container *corev1.Container) {
+	if instance.Spec.Workload != nil && instance.Spec.Workload.Overrides != nil &&
+		instance.Spec.Workload.Overrides.SecurityContext != nil {
+		container.SecurityContext = instance.Spec.Workload.Overrides.SecurityContext.DeepCopy()
+	}
+}
+
+// configureContainerLifecycle applies the user-specified lifecycle hooks and
+// termination message policy to the server container.
+func configureContainerLifecycle(instance *ogxiov1beta1.OGXServer, container *corev1.Container) {
+	if instance.Spec.Workload != nil && instance.Spec.Workload.Overrides != nil &&
+		instance.Spec.Workload.Overrides.Lifecycle != nil {
+		container.Lifecycle = instance.Spec.Workload.Overrides.Lifecycle.DeepCopy()
+	}
+	if instance.Spec.Workload != nil && instance.Spec.Workload.Overrides != nil {
+		container.TerminationMessagePolicy = instance.Spec.Workload.Overrides.TerminationMessagePolicy
+	}
+}
+
+// getImagePullPolicy returns the user-specified pull policy of the server
+// container. Otherwise, like the Kubernetes default, images tagged latest or
+// without a tag are always pulled and other images only when not present.
+func getImagePullPolicy(instance *ogxiov1beta1.OGXServer, image string) corev1.PullPolicy {
+	if instance.Spec.Workload != nil && instance.Spec.Workload.Overrides != nil &&
+		instance.Spec.Workload.Overrides.ImagePullPolicy != "" {
+		return instance.Spec.Workload.Overrides.ImagePullPolicy
+	}
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return corev1.PullIfNotPresent
+	}
+	if tag, ok := ref.(name.Tag); ok && tag.TagStr() == name.DefaultTag {
+		return corev1.PullAlways
+	}
+	return corev1.PullIfNotPresent
+}
+
+// defaultResources returns the operator-level default resources, if any,
+// overlaid with the defaults of the instance's distribution.
+func defaultResources(ctx context.Context, r *OGXServerReconciler, instance *ogxiov1beta1.OGXServer) *corev1.ResourceRequirements {
+	if r == nil {
+		return nil
+	}
+	var distributionResources *corev1.ResourceRequirements
+	if defaults := getDistributionDefaults(ctx, r, instance); defaults != nil {
+		distributionResources = defaults.Resources
+	}
+	return mergeDefaultResources(r.operatorSettings(ctx).defaultResources, distributionResources)
+}
+
 // resolveContainerResources ensures the container always has CPU and memory
 // requests defined so that HPAs using utilization metrics can function.
-func resolveContainerResources(instance *ogxiov1beta1.OGXServer, workers int32, workersSet bool) corev1.ResourceRequirements {
+// Operator-level defaults fill in requests and limits the user did not set.
+func resolveContainerResources(instance *ogxiov1beta1.OGXServer, defaults *corev1.ResourceRequirements,
+	workers int32, workersSet bool) corev1.ResourceRequirements {
 	var resources corev1.ResourceRequirements
 	if instance.Spec.Workload != nil && instance.Spec.Workload.Resources != nil {
-		resources = *instance.Spec.Workload.Resources
+		resources = *instance.Spec.Workload.Resources.DeepCopy()
+	}
+	if defaults != nil {
+		applyDefaultRequests(&resources, defaults.Requests)
 	}
 	ensureRequests(&resources, workers)
+	if defaults != nil {
+		applyDefaultLimits(&resources, defaults.Limits)
+	}
 	if workersSet {
 		ensureLimitsMatchRequests(&resources)
 	}
@@ -181,12 +377,66 @@ func ensureRequests(resources *corev1.ResourceRequirements, workers int32) {
 
 	if cpuQty, ok := resources.Requests[corev1.ResourceCPU]; !ok || cpuQty.IsZero() {
 		// Default to 1 full core per worker unless user overrides.
-		resources.Requests[corev1.ResourceCPU] = resource.MustParse(strconv.Itoa(int(workers)))
+		resources.Requests[corev1.ResourceCPU] = capAtLimit(resources, corev1.ResourceCPU, resource.MustParse(strconv.Itoa(int(workers))))
 	}
 
 	if memQty, ok := resources.Requests[corev1.ResourceMemory]; !ok || memQty.IsZero() {
-		resources.Requests[corev1.ResourceMemory] = ogxiov1beta1.DefaultServerMemoryRequest
+		resources.Requests[corev1.ResourceMemory] = capAtLimit(resources, corev1.ResourceMemory, ogxiov1beta1.DefaultServerMemoryRequest)
+	}
+}
+
+// applyDefaultRequests sets each default request the user has not specified.
+func applyDefaultRequests(resources *corev1.ResourceRequirements, defaults corev1.ResourceList) {
+	for name, qty := range defaults {
+		if existing, ok := resources.Requests[name]; ok && !existing.IsZero() {
+			continue
+		}
+		if resources.Requests == nil {
+			resources.Requests = corev1.ResourceList{}
+		}
+		resources.Requests[name] = capAtLimit(resources, name, qty)
+	}
+}
+
+// capAtLimit returns the default request qty, lowered to the user's limit for
+// name if that is smaller, mirroring how Kubernetes defaults requests to limits.
+func capAtLimit(resources *corev1.ResourceRequirements, name corev1.ResourceName, qty resource.Quantity) resource.Quantity {
+	if limit, ok := resources.Limits[name]; ok && !limit.IsZero() && limit.Cmp(qty) < 0 {
+		return limit.DeepCopy()
 	}
+	return qty.DeepCopy()
+}
+
+// applyDefaultLimits sets each default limit the user has not specified. A
+// default limit below the effective request is skipped, so that operator
+// defaults never make a user's requests invalid.
+func applyDefaultLimits(resources *corev1.ResourceRequirements, defaults corev1.ResourceList) {
+	for name, qty := range defaults {
+		if existing, ok := resources.Limits[name]; ok && !existing.IsZero() {
+			continue
+		}
+		if request, ok := resources.Requests[name]; ok && qty.Cmp(request) < 0 {
+			continue
+		}
+		if resources.Limits == nil {
+			resources.Limits = corev1.ResourceList{}
+		}
+		resources.Limits[name] = qty.DeepCopy()
+	}
+}
+
+// validateResources checks that no limit is lower than the matching request.
+func validateResources(resources corev1.ResourceRequirements) error {
+	names := slices.Sorted(maps.Keys(resources.Limits))
+	for _, name := range names {
+		limit := resources.Limits[name]
+		request, ok := resources.Requests[name]
+		if ok && limit.Cmp(request) < 0 {
+			return fmt.Errorf("failed to validate resources: %s limit %s is less than request %s",
+				name, limit.String(), request.String())
+		}
+	}
+	return nil
 }
 
 func ensureLimitsMatchRequests(resources *corev1.ResourceRequirements) {
@@ -203,12 +453,21 @@ func ensureLimitsMatchRequests(resources *corev1.ResourceRequirements) {
 	}
 }
 
-// getContainerPort returns the container port, using custom port if specified.
+// getContainerPort returns the container port. It matches the Service and
+// NetworkPolicy port, so an unset port defaults the same everywhere.
 func getContainerPort(instance *ogxiov1beta1.OGXServer) int32 {
-	if instance.Spec.Network != nil && instance.Spec.Network.Port != 0 {
-		return instance.Spec.Network.Port
+	return deploy.GetServicePort(instance)
+}
+
+// getContainerPorts returns the server port followed by the additional ports.
+func getContainerPorts(instance *ogxiov1beta1.OGXServer) []corev1.ContainerPort {
+	ports := []corev1.ContainerPort{{ContainerPort: getContainerPort(instance)}}
+	if instance.Spec.Network != nil {
+		for i := range instance.Spec.Network.AdditionalPorts {
+			ports = append(ports, *instance.Spec.Network.AdditionalPorts[i].DeepCopy())
+		}
 	}
-	return ogxiov1beta1.DefaultServerPort
+	return ports
 }
 
 // getEffectiveWorkers returns a positive worker count, defaulting to 1.
@@ -221,17 +480,12 @@ func getEffectiveWorkers(instance *ogxiov1beta1.OGXServer) (int32, bool) {
 
 // configureContainerEnvironment sets up environment variables for the container.
 func configureContainerEnvironment(ctx context.Context, r *OGXServerReconciler, instance *ogxiov1beta1.OGXServer, container *corev1.Container) {
-	mountPath := getMountPath(instance)
 	workers, _ := getEffectiveWorkers(instance)
 
-	// Add HF_HOME variable to our mount path so that downloaded models and datasets are stored
-	// on the same volume as the storage. This is not critical but useful if the server is
-	// restarted so the models and datasets are not lost and need to be downloaded again.
+	// Point HF_HOME at the storage volume, or the configured model cache, so that
+	// downloaded models and datasets are not lost when the server restarts.
 	// For more information, see https://huggingface.co/docs/datasets/en/cache
-	container.Env = append(container.Env, corev1.EnvVar{
-		Name:  "HF_HOME",
-		Value: mountPath,
-	})
+	container.Env = append(container.Env, getModelCacheEnv(instance)...)
 
 	// Add CA bundle environment variable if any CA bundles are configured
 	// (explicit or auto-detected ODH bundles)
@@ -239,7 +493,7 @@ func configureContainerEnvironment(ctx context.Context, r *OGXServerReconciler,
 		// Set SSL_CERT_FILE to point to the managed CA bundle file
 		container.Env = append(container.Env, corev1.EnvVar{
 			Name:  "SSL_CERT_FILE",
-			Value: ManagedCABundleFilePath,
+			Value: getCABundleFilePath(ctx, r, instance),
 		})
 	}
 
@@ -255,16 +509,152 @@ func configureContainerEnvironment(ctx context.Context, r *OGXServerReconciler,
 		},
 		corev1.EnvVar{
 			Name:  "OGX_CONFIG",
-			Value: ogxConfigPath,
+			Value: getConfigPath(r, instance),
 		},
 	)
 
+	if isServingTLSEnabled(instance) {
+		container.Env = append(container.Env, getServingTLSEnv()...)
+	}
+
+	if logging := getLoggingConfig(instance); logging != "" {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  "OGX_LOGGING",
+			Value: logging,
+		})
+	}
+
+	container.Env = append(container.Env, getProxyEnv(ctx, r, instance)...)
+
+	// Distribution defaults never override the variables above
+	container.Env = appendDistributionEnv(container.Env, getDistributionDefaults(ctx, r, instance))
+
+	// Provider secrets come before the user provided env vars, which take precedence
+	if instance.Spec.Workload != nil {
+		for _, ref := range instance.Spec.Workload.ProviderSecrets {
+			container.Env = append(container.Env, corev1.EnvVar{
+				Name: ref.EnvVar,
+				ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: ref.SecretKeyRef.Name},
+					Key:                  ref.SecretKeyRef.Key,
+				}},
+			})
+		}
+	}
+
 	// Finally, add the user provided env vars
 	if instance.Spec.Workload != nil && instance.Spec.Workload.Overrides != nil {
 		container.Env = append(container.Env, instance.Spec.Workload.Overrides.Env...)
+		container.EnvFrom = append(container.EnvFrom, instance.Spec.Workload.Overrides.EnvFrom...)
 	}
 }
 
+// getEnvSourceNames returns the names of the Secrets and ConfigMaps that the
+// provider secrets and user-provided env and envFrom entries read from, without
+// duplicates.
+func getEnvSourceNames(instance *ogxiov1beta1.OGXServer) ([]string, []string) {
+	if instance.Spec.Workload == nil {
+		return nil, nil
+	}
+
+	var secrets, configMaps []string
+	addName := func(names []string, name string) []string {
+		if name == "" || slices.Contains(names, name) {
+			return names
+		}
+		return append(names, name)
+	}
+	for _, ref := range instance.Spec.Workload.ProviderSecrets {
+		secrets = addName(secrets, ref.SecretKeyRef.Name)
+	}
+
+	overrides := instance.Spec.Workload.Overrides
+	if overrides == nil {
+		return secrets, configMaps
+	}
+	for _, env := range overrides.Env {
+		if env.ValueFrom == nil {
+			continue
+		}
+		if ref := env.ValueFrom.SecretKeyRef; ref != nil {
+			secrets = addName(secrets, ref.Name)
+		}
+		if ref := env.ValueFrom.ConfigMapKeyRef; ref != nil {
+			configMaps = addName(configMaps, ref.Name)
+		}
+	}
+	for _, source := range overrides.EnvFrom {
+		if source.SecretRef != nil {
+			secrets = addName(secrets, source.SecretRef.Name)
+		}
+		if source.ConfigMapRef != nil {
+			configMaps = addName(configMaps, source.ConfigMapRef.Name)
+		}
+	}
+	return secrets, configMaps
+}
+
+// getLoggingConfig translates the workload log level into the server's
+// OGX_LOGGING format, which uses Python logging level names.
+func getLoggingConfig(instance *ogxiov1beta1.OGXServer) string {
+	if instance.Spec.Workload == nil || instance.Spec.Workload.LogLevel == "" {
+		return ""
+	}
+	level := instance.Spec.Workload.LogLevel
+	if level == "warn" {
+		level = "warning"
+	}
+	return "all=" + level
+}
+
+// inClusterNoProxy returns the hosts and domains that are always reached
+// without the proxy: the loopback addresses, the services of namespace and the
+// in-cluster service domains. NO_PROXY entries match host name suffixes, so
+// short service names such as my-service or my-service.other-ns still go
+// through the proxy and must be listed in noProxy or replaced by the full name.
+func inClusterNoProxy(namespace string) []string {
+	return []string{"localhost", "127.0.0.1", namespace + ".svc", ".svc", ".cluster.local"}
+}
+
+// getProxyEnv returns the proxy env vars of the server container. Fields set
+// in spec.network.proxy take precedence over the operator's proxy defaults.
+func getProxyEnv(ctx context.Context, r *OGXServerReconciler, instance *ogxiov1beta1.OGXServer) []corev1.EnvVar {
+	var proxy ogxiov1beta1.ProxySpec
+	if defaultProxy := r.operatorSettings(ctx).defaultProxy; defaultProxy != nil {
+		proxy = *defaultProxy
+	}
+	if instance.Spec.Network != nil && instance.Spec.Network.Proxy != nil {
+		spec := instance.Spec.Network.Proxy
+		proxy.HTTPProxy = cmp.Or(spec.HTTPProxy, proxy.HTTPProxy)
+		proxy.HTTPSProxy = cmp.Or(spec.HTTPSProxy, proxy.HTTPSProxy)
+		proxy.NoProxy = cmp.Or(spec.NoProxy, proxy.NoProxy)
+	}
+	if proxy.HTTPProxy == "" && proxy.HTTPSProxy == "" {
+		return nil
+	}
+
+	var env []corev1.EnvVar
+	if proxy.HTTPProxy != "" {
+		env = append(env, corev1.EnvVar{Name: "HTTP_PROXY", Value: proxy.HTTPProxy})
+	}
+	if proxy.HTTPSProxy != "" {
+		env = append(env, corev1.EnvVar{Name: "HTTPS_PROXY", Value: proxy.HTTPSProxy})
+	}
+
+	var noProxy []string
+	for _, host := range strings.Split(proxy.NoProxy, ",") {
+		if host = strings.TrimSpace(host); host != "" && !slices.Contains(noProxy, host) {
+			noProxy = append(noProxy, host)
+		}
+	}
+	for _, domain := range inClusterNoProxy(instance.Namespace) {
+		if !slices.Contains(noProxy, domain) {
+			noProxy = append(noProxy, domain)
+		}
+	}
+	return append(env, corev1.EnvVar{Name: "NO_PROXY", Value: strings.Join(noProxy, ",")})
+}
+
 // configureContainerMounts sets up volume mounts for the container.
 func configureContainerMounts(ctx context.Context, r *OGXServerReconciler, instance *ogxiov1beta1.OGXServer, container *corev1.Container) {
 	// Add volume mount for storage
@@ -275,12 +665,87 @@ func configureContainerMounts(ctx context.Context, r *OGXServerReconciler, insta
 
 	// Add CA bundle volume mount if TLS config is specified or auto-detected
 	addCABundleVolumeMount(ctx, r, instance, container)
+
+	// Add the serving certificate mount if the server terminates TLS
+	if isServingTLSEnabled(instance) {
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      servingTLSVolumeName,
+			MountPath: servingTLSMountPath,
+			ReadOnly:  true,
+		})
+	}
+
+	// Add the additional config mounts
+	addConfigMountVolumeMounts(instance, container)
+
+	// Add the model cache mount if a claim is configured
+	addModelCacheVolumeMount(instance, container)
+
+	// Add the custom startup script mount if configured
+	if getStartupScriptRef(instance) != nil {
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      startupScriptVolumeName,
+			MountPath: startupScriptMountPath,
+			ReadOnly:  true,
+		})
+	}
+
+	// Keep /tmp writable when the root filesystem is read-only
+	if needsTmpVolume(instance) {
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      tmpVolumeName,
+			MountPath: tmpMountPath,
+		})
+	}
+}
+
+// needsTmpVolume reports whether the server container runs with a read-only
+// root filesystem and overrides do not already mount a volume at /tmp.
+func needsTmpVolume(instance *ogxiov1beta1.OGXServer) bool {
+	if instance.Spec.Workload == nil || instance.Spec.Workload.Overrides == nil {
+		return false
+	}
+	overrides := instance.Spec.Workload.Overrides
+	if overrides.SecurityContext == nil || overrides.SecurityContext.ReadOnlyRootFilesystem == nil ||
+		!*overrides.SecurityContext.ReadOnlyRootFilesystem {
+		return false
+	}
+	for _, mount := range overrides.VolumeMounts {
+		if path.Clean(mount.MountPath) == tmpMountPath {
+			return false
+		}
+	}
+	return true
+}
+
+// requiredConfigKeys are the top-level keys every server config must define.
+var requiredConfigKeys = []string{"apis", "providers"}
+
+// validateOverrideConfig checks that data is a YAML mapping defining the
+// required top-level config keys, so that malformed configs are reported
+// instead of crash-looping the server.
+func validateOverrideConfig(data string) error {
+	var config map[string]any
+	if err := sigsyaml.Unmarshal([]byte(data), &config); err != nil {
+		return fmt.Errorf("failed to parse YAML: %w", err)
+	}
+	var missing []string
+	for _, key := range requiredConfigKeys {
+		if _, ok := config[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("missing required top-level keys: %s", strings.Join(missing, ", "))
+	}
+	return nil
 }
 
 // hasAnyCABundle checks if any CA bundle will be mounted (explicit or auto-detected).
 func hasAnyCABundle(ctx context.Context, r *OGXServerReconciler, instance *ogxiov1beta1.OGXServer) bool {
 	// Check for explicit CA certificate configuration
-	if instance.Spec.TLS != nil && instance.Spec.TLS.Trust != nil && len(instance.Spec.TLS.Trust.CACertificates) > 0 {
+	if instance.Spec.TLS != nil && instance.Spec.TLS.Trust != nil &&
+		(len(instance.Spec.TLS.Trust.CACertificates) > 0 || len(instance.Spec.TLS.Trust.CACertificateSecrets) > 0) {
 		return true
 	}
 
@@ -295,10 +760,29 @@ func hasAnyCABundle(ctx context.Context, r *OGXServerReconciler, instance *ogxio
 }
 
 // configureContainerCommands sets up container commands and args.
-func configureContainerCommands(instance *ogxiov1beta1.OGXServer, container *corev1.Container) {
-	// Override the container entrypoint to use the custom config file if user config is specified
-	if instance.Spec.OverrideConfig != nil && instance.Spec.OverrideConfig.Name != "" {
-		container.Command = []string{"/bin/sh", "-c", startupScript}
+func configureContainerCommands(ctx context.Context, r *OGXServerReconciler, instance *ogxiov1beta1.OGXServer, container *corev1.Container) {
+	// A custom startup script replaces the image entrypoint and the default script.
+	// Serving TLS needs the uvicorn CLI, the only launcher that serves HTTPS, so
+	// it is started directly with the certificate. Unknown versions are assumed
+	// to have it, and validateServingTLS rejects declared older versions.
+	// Otherwise, override the container entrypoint to use the custom config file if
+	// user config is specified. A declared server version or a command from the
+	// distribution defaults selects the command directly; otherwise the script
+	// detects it.
+	if getStartupScriptRef(instance) != nil {
+		container.Command = getStartupScriptCommand(instance)
+		container.Args = []string{}
+	} else if isServingTLSEnabled(instance) {
+		container.Command = append(uvicornServerCommand(), getServingTLSArgs()...)
+		container.Args = []string{}
+	} else if instance.Spec.OverrideConfig != nil && instance.Spec.OverrideConfig.Name != "" {
+		container.Command = serverCommand(instance.Spec.Distribution.Version)
+		if defaults := getDistributionDefaults(ctx, r, instance); container.Command == nil && defaults != nil && len(defaults.Command) > 0 {
+			container.Command = slices.Clone(defaults.Command)
+		}
+		if container.Command == nil {
+			container.Command = getStartupScriptCommand(instance)
+		}
 		container.Args = []string{}
 	}
 
@@ -325,7 +809,7 @@ func getMountPath(instance *ogxiov1beta1.OGXServer) string {
 func addStorageVolumeMount(instance *ogxiov1beta1.OGXServer, container *corev1.Container) {
 	mountPath := getMountPath(instance)
 	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
-		Name:      "ogx-storage",
+		Name:      storageVolumeName,
 		MountPath: mountPath,
 	})
 }
@@ -334,8 +818,8 @@ func addStorageVolumeMount(instance *ogxiov1beta1.OGXServer, container *corev1.C
 func addUserConfigVolumeMount(instance *ogxiov1beta1.OGXServer, container *corev1.Container) {
 	if instance.Spec.OverrideConfig != nil && instance.Spec.OverrideConfig.Name != "" {
 		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
-			Name:      "user-config",
-			MountPath: "/etc/ogx/",
+			Name:      userConfigVolumeName,
+			MountPath: userConfigMountPath,
 			ReadOnly:  true,
 		})
 	}
@@ -344,8 +828,9 @@ func addUserConfigVolumeMount(instance *ogxiov1beta1.OGXServer, container *corev
 // addCABundleVolumeMount adds the managed CA bundle volume mount to the container.
 // Mounts the operator-managed ConfigMap containing all concatenated certificates.
 func addCABundleVolumeMount(ctx context.Context, r *OGXServerReconciler, instance *ogxiov1beta1.OGXServer, container *corev1.Container) {
-	// Mount managed CA bundle if any CA bundles are configured
-	if hasAnyCABundle(ctx, r, instance) {
+	// Mount managed CA bundle if any CA bundles are configured, unless it is
+	// projected into the override config volume
+	if hasAnyCABundle(ctx, r, instance) && !projectsCABundleIntoConfig(ctx, r, instance) {
 		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
 			Name:      CABundleVolumeName,
 			MountPath: ManagedCABundleMountPath,
@@ -355,33 +840,40 @@ func addCABundleVolumeMount(ctx context.Context, r *OGXServerReconciler, instanc
 }
 
 // createCABundleVolume creates the volume configuration for the managed CA bundle ConfigMap.
-func createCABundleVolume(managedConfigMapName string) corev1.Volume {
-	return corev1.Volume{
+// When preserveKeys is set, every key is projected so that preserved source keys are
+// mounted as separate files next to the combined bundle.
+func createCABundleVolume(managedConfigMapName string, preserveKeys bool) corev1.Volume {
+	volume := corev1.Volume{
 		Name: CABundleVolumeName,
 		VolumeSource: corev1.VolumeSource{
 			ConfigMap: &corev1.ConfigMapVolumeSource{
 				LocalObjectReference: corev1.LocalObjectReference{
 					Name: managedConfigMapName,
 				},
-				Items: []corev1.KeyToPath{
-					{
-						Key:  ManagedCABundleKey,
-						Path: ManagedCABundleKey,
-					},
-				},
 			},
 		},
 	}
+	if !preserveKeys {
+		volume.ConfigMap.Items = []corev1.KeyToPath{
+			{
+				Key:  ManagedCABundleKey,
+				Path: ManagedCABundleKey,
+			},
+		}
+	}
+	return volume
 }
 
 // configurePodStorage configures the pod storage and returns the complete pod spec.
 func configurePodStorage(ctx context.Context, r *OGXServerReconciler, instance *ogxiov1beta1.OGXServer, container corev1.Container, effectivePVCName string) corev1.PodSpec {
 	fsGroup := FSGroup
+	terminationGracePeriodSeconds := defaultTerminationGracePeriodSeconds
 	podSpec := corev1.PodSpec{
 		Containers: []corev1.Container{container},
 		SecurityContext: &corev1.PodSecurityContext{
 			FSGroup: &fsGroup,
 		},
+		TerminationGracePeriodSeconds: &terminationGracePeriodSeconds,
 	}
 
 	// Configure storage volumes
@@ -390,12 +882,39 @@ func configurePodStorage(ctx context.Context, r *OGXServerReconciler, instance *
 	// Configure TLS CA bundle (with auto-detection support)
 	configureTLSCABundle(ctx, r, instance, &podSpec)
 
+	// Configure the serving certificate
+	configureServingTLS(instance, &podSpec)
+
 	// Configure user config
-	configureUserConfig(instance, &podSpec)
+	configureUserConfig(ctx, r, instance, &podSpec)
+
+	// Configure the custom startup script
+	configureStartupScript(instance, &podSpec)
+
+	// Configure the additional config mounts
+	configureConfigMounts(instance, &podSpec)
 
-	// Apply pod overrides including ServiceAccount, volumes, and volume mounts
+	// Configure the model cache volume
+	configureModelCache(instance, &podSpec)
+
+	if needsTmpVolume(instance) {
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name:         tmpVolumeName,
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		})
+	}
+
+	// Apply pod overrides including ServiceAccount, volumes, volume mounts, and scheduling constraints
 	configurePodOverrides(instance, &podSpec)
 
+	configureImagePullSecrets(instance, &podSpec)
+
+	// Sidecars are appended last so that the server container stays first
+	configureSidecars(instance, &podSpec)
+
+	// Init containers share the storage volume so that downloads persist
+	configureInitContainers(instance, &podSpec)
+
 	configurePodScheduling(instance, &podSpec)
 
 	return podSpec
@@ -406,14 +925,14 @@ func configureStorage(instance *ogxiov1beta1.OGXServer, podSpec *corev1.PodSpec,
 	if instance.Spec.Workload != nil && instance.Spec.Workload.Storage != nil {
 		configurePersistentStorage(podSpec, effectivePVCName)
 	} else {
-		configureEmptyDirStorage(podSpec)
+		configureEmptyDirStorage(instance, podSpec)
 	}
 }
 
 // configurePersistentStorage sets up PVC-based storage.
 func configurePersistentStorage(podSpec *corev1.PodSpec, pvcName string) {
 	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
-		Name: "ogx-storage",
+		Name: storageVolumeName,
 		VolumeSource: corev1.VolumeSource{
 			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
 				ClaimName: pvcName,
@@ -423,50 +942,169 @@ func configurePersistentStorage(podSpec *corev1.PodSpec, pvcName string) {
 }
 
 // configureEmptyDirStorage sets up temporary storage using emptyDir.
-func configureEmptyDirStorage(podSpec *corev1.PodSpec) {
+func configureEmptyDirStorage(instance *ogxiov1beta1.OGXServer, podSpec *corev1.PodSpec) {
 	// Use emptyDir for non-persistent storage
+	emptyDir := &corev1.EmptyDirVolumeSource{}
+	if instance.Spec.Workload != nil && instance.Spec.Workload.EphemeralStorage != nil {
+		ephemeral := instance.Spec.Workload.EphemeralStorage
+		emptyDir.Medium = ephemeral.Medium
+		if ephemeral.SizeLimit != nil {
+			sizeLimit := ephemeral.SizeLimit.DeepCopy()
+			emptyDir.SizeLimit = &sizeLimit
+		}
+	}
 	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
-		Name: "ogx-storage",
+		Name: storageVolumeName,
 		VolumeSource: corev1.VolumeSource{
-			EmptyDir: &corev1.EmptyDirVolumeSource{},
+			EmptyDir: emptyDir,
 		},
 	})
 }
 
 // configureTLSCABundle handles TLS CA bundle configuration.
 // Mounts the operator-managed CA bundle ConfigMap that contains all certificates.
+// Certificates sourced from Secrets are not in the ConfigMap, so with Secret
+// sources the bundle is assembled into an emptyDir by an init container instead.
 func configureTLSCABundle(ctx context.Context, r *OGXServerReconciler, instance *ogxiov1beta1.OGXServer, podSpec *corev1.PodSpec) {
-	// Check if any CA bundles are configured (explicit or auto-detected ODH)
-	if !hasAnyCABundle(ctx, r, instance) {
+	// Check if any CA bundles are configured (explicit or auto-detected ODH),
+	// and whether the user config volume projects them instead
+	if !hasAnyCABundle(ctx, r, instance) || projectsCABundleIntoConfig(ctx, r, instance) {
 		return
 	}
 
 	// Add the managed CA bundle ConfigMap volume
 	managedConfigMapName := getManagedCABundleConfigMapName(instance)
-	volume := createCABundleVolume(managedConfigMapName)
-	podSpec.Volumes = append(podSpec.Volumes, volume)
+	if !r.hasCACertificateSecrets(instance) {
+		podSpec.Volumes = append(podSpec.Volumes, createCABundleVolume(managedConfigMapName, preservesCABundleKeys(instance)))
+		return
+	}
+
+	sources, script := createCABundleSourcesVolume(instance, managedConfigMapName)
+	podSpec.Volumes = append(podSpec.Volumes,
+		corev1.Volume{
+			Name: CABundleVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{Medium: corev1.StorageMediumMemory},
+			},
+		},
+		sources,
+	)
+
+	server := podSpec.Containers[0]
+	podSpec.InitContainers = append(podSpec.InitContainers, corev1.Container{
+		Name:            ogxiov1beta1.CABundleInitContainerName,
+		Image:           server.Image,
+		ImagePullPolicy: server.ImagePullPolicy,
+		Command:         []string{"/bin/sh", "-c", script},
+		Resources:       *server.Resources.DeepCopy(),
+		SecurityContext: server.SecurityContext.DeepCopy(),
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: CABundleSourcesVolumeName, MountPath: CABundleSourcesMountPath, ReadOnly: true},
+			{Name: CABundleVolumeName, MountPath: ManagedCABundleMountPath},
+		},
+	})
 }
 
-// configureUserConfig handles user configuration setup.
-func configureUserConfig(instance *ogxiov1beta1.OGXServer, podSpec *corev1.PodSpec) {
+// createCABundleSourcesVolume returns the projected volume holding the managed
+// CA bundle ConfigMap and the referenced Secret keys, and the script that
+// combines them into the CA bundle volume. Secret keys are projected under
+// their index so that the script appends them in the order listed.
+func createCABundleSourcesVolume(instance *ogxiov1beta1.OGXServer, managedConfigMapName string) (corev1.Volume, string) {
+	preserveKeys := preservesCABundleKeys(instance)
+
+	configMapKeys := []string{ManagedCABundleKey}
+	if preserveKeys {
+		for _, ref := range instance.Spec.TLS.Trust.CACertificates {
+			if !slices.Contains(configMapKeys, ref.Key) {
+				configMapKeys = append(configMapKeys, ref.Key)
+			}
+		}
+	}
+	configMapItems := make([]corev1.KeyToPath, 0, len(configMapKeys))
+	for _, key := range configMapKeys {
+		configMapItems = append(configMapItems, corev1.KeyToPath{Key: key, Path: path.Join("configmap", key)})
+	}
+	sources := []corev1.VolumeProjection{{ConfigMap: &corev1.ConfigMapProjection{
+		LocalObjectReference: corev1.LocalObjectReference{Name: managedConfigMapName},
+		Items:                configMapItems,
+	}}}
+
+	files := []string{path.Join("configmap", ManagedCABundleKey)}
+	for i, ref := range instance.Spec.TLS.Trust.CACertificateSecrets {
+		file := fmt.Sprintf("secrets/%d.crt", i)
+		items := []corev1.KeyToPath{{Key: ref.Key, Path: file}}
+		if preserveKeys {
+			items = append(items, corev1.KeyToPath{Key: ref.Key, Path: path.Join("preserved", ref.Key)})
+		}
+		sources = append(sources, corev1.VolumeProjection{Secret: &corev1.SecretProjection{
+			LocalObjectReference: corev1.LocalObjectReference{Name: ref.Name},
+			Items:                items,
+		}})
+		files = append(files, file)
+	}
+
+	var script strings.Builder
+	fmt.Fprintf(&script, "set -e\ncd %s\n", CABundleSourcesMountPath)
+	if preserveKeys {
+		fmt.Fprintf(&script, "cp configmap/* preserved/* %s/\n", ManagedCABundleMountPath)
+	}
+	fmt.Fprintf(&script, "for f in %s; do cat \"$f\"; echo; done > %s\n", strings.Join(files, " "), ManagedCABundleFilePath)
+
+	volume := corev1.Volume{
+		Name: CABundleSourcesVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{Sources: sources},
+		},
+	}
+	return volume, script.String()
+}
+
+// configureUserConfig handles user configuration setup. The ConfigMap key is
+// mounted under the config filename the distribution expects. With
+// ProjectedConfig, the managed CA bundle is projected into the same volume.
+func configureUserConfig(ctx context.Context, r *OGXServerReconciler, instance *ogxiov1beta1.OGXServer, podSpec *corev1.PodSpec) {
 	overrideConfig := instance.Spec.OverrideConfig
 	if overrideConfig == nil || overrideConfig.Name == "" || overrideConfig.Key == "" {
 		return
 	}
 
+	configItems := []corev1.KeyToPath{
+		{
+			Key:  overrideConfig.Key,
+			Path: getConfigFileName(r, instance),
+		},
+	}
+
+	if projectsCABundleIntoConfig(ctx, r, instance) {
+		caBundle := createCABundleVolume(getManagedCABundleConfigMapName(instance), preservesCABundleKeys(instance))
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name: userConfigVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				Projected: &corev1.ProjectedVolumeSource{
+					Sources: []corev1.VolumeProjection{
+						{ConfigMap: &corev1.ConfigMapProjection{
+							LocalObjectReference: corev1.LocalObjectReference{Name: overrideConfig.Name},
+							Items:                configItems,
+						}},
+						{ConfigMap: &corev1.ConfigMapProjection{
+							LocalObjectReference: caBundle.ConfigMap.LocalObjectReference,
+							Items:                caBundle.ConfigMap.Items,
+						}},
+					},
+				},
+			},
+		})
+		return
+	}
+
 	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
-		Name: "user-config",
+		Name: userConfigVolumeName,
 		VolumeSource: corev1.VolumeSource{
 			ConfigMap: &corev1.ConfigMapVolumeSource{
 				LocalObjectReference: corev1.LocalObjectReference{
 					Name: overrideConfig.Name,
 				},
-				Items: []corev1.KeyToPath{
-					{
-						Key:  overrideConfig.Key,
-						Path: "config.yaml",
-					},
-				},
+				Items: configItems,
 			},
 		},
 	})
@@ -490,7 +1128,217 @@ func configurePodOverrides(instance *ogxiov1beta1.OGXServer, podSpec *corev1.Pod
 				podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, overrides.VolumeMounts...)
 			}
 		}
+		if len(overrides.NodeSelector) > 0 {
+			podSpec.NodeSelector = maps.Clone(overrides.NodeSelector)
+		}
+		if len(overrides.Tolerations) > 0 {
+			podSpec.Tolerations = make([]corev1.Toleration, len(overrides.Tolerations))
+			for i := range overrides.Tolerations {
+				overrides.Tolerations[i].DeepCopyInto(&podSpec.Tolerations[i])
+			}
+		}
+		if overrides.Affinity != nil {
+			podSpec.Affinity = overrides.Affinity.DeepCopy()
+		}
+		if len(overrides.HostAliases) > 0 {
+			podSpec.HostAliases = make([]corev1.HostAlias, len(overrides.HostAliases))
+			for i := range overrides.HostAliases {
+				overrides.HostAliases[i].DeepCopyInto(&podSpec.HostAliases[i])
+			}
+		}
+		if overrides.DNSConfig != nil {
+			podSpec.DNSConfig = overrides.DNSConfig.DeepCopy()
+		}
+		if overrides.PodSecurityContext != nil {
+			securityContext := overrides.PodSecurityContext.DeepCopy()
+			if securityContext.FSGroup == nil && podSpec.SecurityContext != nil {
+				securityContext.FSGroup = podSpec.SecurityContext.FSGroup
+			}
+			podSpec.SecurityContext = securityContext
+		}
+		if overrides.TerminationGracePeriodSeconds != nil {
+			terminationGracePeriodSeconds := *overrides.TerminationGracePeriodSeconds
+			podSpec.TerminationGracePeriodSeconds = &terminationGracePeriodSeconds
+		}
+	}
+}
+
+// configureImagePullSecrets sets the Secrets used to pull the pod images.
+func configureImagePullSecrets(instance *ogxiov1beta1.OGXServer, podSpec *corev1.PodSpec) {
+	if instance.Spec.Workload == nil || len(instance.Spec.Workload.ImagePullSecrets) == 0 {
+		return
+	}
+	podSpec.ImagePullSecrets = slices.Clone(instance.Spec.Workload.ImagePullSecrets)
+}
+
+// configureSidecars appends the sidecar containers after the server container.
+func configureSidecars(instance *ogxiov1beta1.OGXServer, podSpec *corev1.PodSpec) {
+	if instance.Spec.Workload == nil {
+		return
+	}
+	for i := range instance.Spec.Workload.Sidecars {
+		podSpec.Containers = append(podSpec.Containers, *instance.Spec.Workload.Sidecars[i].DeepCopy())
+	}
+}
+
+// configureInitContainers adds the init containers, mounting the storage volume
+// at the storage mount path so that downloaded artifacts are kept for the server.
+func configureInitContainers(instance *ogxiov1beta1.OGXServer, podSpec *corev1.PodSpec) {
+	if instance.Spec.Workload == nil {
+		return
+	}
+	for i := range instance.Spec.Workload.InitContainers {
+		initContainer := instance.Spec.Workload.InitContainers[i].DeepCopy()
+		mountsStorage := slices.ContainsFunc(initContainer.VolumeMounts, func(mount corev1.VolumeMount) bool {
+			return mount.Name == storageVolumeName
+		})
+		if !mountsStorage {
+			addStorageVolumeMount(instance, initContainer)
+		}
+		podSpec.InitContainers = append(podSpec.InitContainers, *initContainer)
+	}
+}
+
+// managedVolume is an operator-managed volume and where the server container mounts it.
+type managedVolume struct {
+	name      string
+	mountPath string
+}
+
+// getManagedVolumes returns the operator-managed volumes rendered for instance,
+// except for the additional config mounts. Only these are reserved, so
+// upgrading cannot break a spec that uses the name or path of a disabled
+// feature's volume. Enabling that feature later is rejected instead.
+func getManagedVolumes(ctx context.Context, r *OGXServerReconciler, instance *ogxiov1beta1.OGXServer) []managedVolume {
+	volumes := []managedVolume{{name: storageVolumeName, mountPath: getMountPath(instance)}}
+	if r.hasOverrideConfig(instance) {
+		volumes = append(volumes, managedVolume{name: userConfigVolumeName, mountPath: userConfigMountPath})
+	}
+	if hasAnyCABundle(ctx, r, instance) && !projectsCABundleIntoConfig(ctx, r, instance) {
+		volumes = append(volumes, managedVolume{name: CABundleVolumeName, mountPath: ManagedCABundleMountPath})
+	}
+	if r.hasCACertificateSecrets(instance) {
+		volumes = append(volumes, managedVolume{name: CABundleSourcesVolumeName, mountPath: CABundleSourcesMountPath})
+	}
+	if isServingTLSEnabled(instance) {
+		volumes = append(volumes, managedVolume{name: servingTLSVolumeName, mountPath: servingTLSMountPath})
+	}
+	if getStartupScriptRef(instance) != nil {
+		volumes = append(volumes, managedVolume{name: startupScriptVolumeName, mountPath: startupScriptMountPath})
+	}
+	if modelCache := getModelCache(instance); modelCache != nil && modelCache.ClaimName != "" {
+		volumes = append(volumes, managedVolume{name: modelCacheVolumeName, mountPath: modelCache.Path})
+	}
+	if needsTmpVolume(instance) {
+		volumes = append(volumes, managedVolume{name: tmpVolumeName, mountPath: tmpMountPath})
+	}
+	return volumes
+}
+
+// findWorkloadOverrideConflicts returns a description of every override volume whose
+// name, and every override volume mount whose path, collides with an operator-managed
+// volume. Duplicates would otherwise only surface as a pod creation rejection.
+func findWorkloadOverrideConflicts(ctx context.Context, r *OGXServerReconciler, instance *ogxiov1beta1.OGXServer) []string {
+	if instance.Spec.Workload == nil || instance.Spec.Workload.Overrides == nil {
+		return nil
+	}
+	overrides := instance.Spec.Workload.Overrides
+
+	var managedVolumeNames []string
+	managedMountPaths := map[string]string{}
+	for _, managed := range getManagedVolumes(ctx, r, instance) {
+		managedVolumeNames = append(managedVolumeNames, managed.name)
+		managedMountPaths[path.Clean(managed.mountPath)] = managed.name
+	}
+	for i, mount := range getConfigMounts(instance) {
+		managedVolumeNames = append(managedVolumeNames, configMountVolumeName(i))
+		managedMountPaths[path.Clean(mount.MountPath)] = configMountVolumeName(i)
+	}
+
+	var conflicts []string
+	for _, volume := range overrides.Volumes {
+		if slices.Contains(managedVolumeNames, volume.Name) {
+			conflicts = append(conflicts, fmt.Sprintf("volume %q conflicts with an operator-managed volume", volume.Name))
+		}
+	}
+	for _, mount := range overrides.VolumeMounts {
+		if managed, ok := managedMountPaths[path.Clean(mount.MountPath)]; ok {
+			conflicts = append(conflicts, fmt.Sprintf("volumeMount %q uses the mountPath %q of operator-managed volume %q",
+				mount.Name, mount.MountPath, managed))
+		}
+	}
+
+	return conflicts
+}
+
+// validatePodVolumes checks the assembled pod spec for volumes sharing a name,
+// container and init container mounts sharing a path, and mounts of undeclared
+// volumes. Storage, user config, and the CA bundle (which merges explicit
+// ConfigMaps, Secrets and the ODH bundle into one volume) must compose without
+// colliding.
+func validatePodVolumes(podSpec corev1.PodSpec) error {
+	var conflicts []string
+
+	volumes := make(map[string]struct{}, len(podSpec.Volumes))
+	for _, volume := range podSpec.Volumes {
+		if _, exists := volumes[volume.Name]; exists {
+			conflicts = append(conflicts, fmt.Sprintf("volume %q is declared more than once", volume.Name))
+		}
+		volumes[volume.Name] = struct{}{}
+	}
+
+	for _, container := range slices.Concat(podSpec.InitContainers, podSpec.Containers) {
+		mountPaths := make(map[string]string, len(container.VolumeMounts))
+		for _, mount := range container.VolumeMounts {
+			if _, exists := volumes[mount.Name]; !exists {
+				conflicts = append(conflicts, fmt.Sprintf("container %q mounts undeclared volume %q", container.Name, mount.Name))
+			}
+			mountPath := path.Clean(mount.MountPath)
+			if other, exists := mountPaths[mountPath]; exists {
+				conflicts = append(conflicts, fmt.Sprintf("container %q mounts volumes %q and %q at the same path %q",
+					container.Name, other, mount.Name, mountPath))
+			}
+			mountPaths[mountPath] = mount.Name
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return fmt.Errorf("failed to validate pod volumes: %s", strings.Join(conflicts, "; "))
 	}
+	return nil
+}
+
+// validateServerPort checks that the Service targets the port the server
+// container exposes and listens on. The server listens on OGX_PORT, which
+// overrides.env can replace, so a mismatch would leave the Service without
+// a backend.
+func validateServerPort(instance *ogxiov1beta1.OGXServer, container corev1.Container) error {
+	var conflicts []string
+	targetPort := deploy.GetServicePort(instance)
+
+	// The server port comes first; additional ports follow it.
+	if len(container.Ports) > 0 && container.Ports[0].ContainerPort != targetPort {
+		conflicts = append(conflicts, fmt.Sprintf("container port %d does not match the Service target port %d",
+			container.Ports[0].ContainerPort, targetPort))
+	}
+
+	// Kubernetes uses the last definition of a duplicated env var.
+	for i := len(container.Env) - 1; i >= 0; i-- {
+		env := container.Env[i]
+		if env.Name != "OGX_PORT" {
+			continue
+		}
+		if env.ValueFrom == nil && env.Value != strconv.Itoa(int(targetPort)) {
+			conflicts = append(conflicts, fmt.Sprintf("OGX_PORT %q does not match the Service target port %d; "+
+				"set network.port instead of overriding OGX_PORT", env.Value, targetPort))
+		}
+		break
+	}
+
+	if len(conflicts) > 0 {
+		return fmt.Errorf("failed to validate server port: %s", strings.Join(conflicts, "; "))
+	}
+	return nil
 }
 
 func configurePodScheduling(instance *ogxiov1beta1.OGXServer, podSpec *corev1.PodSpec) {
@@ -569,44 +1417,115 @@ func defaultInstanceLabelSelector(instance *ogxiov1beta1.OGXServer) *metav1.Labe
 
 // validateDistribution validates the distribution configuration.
 func (r *OGXServerReconciler) validateDistribution(instance *ogxiov1beta1.OGXServer) error {
+	if !hasDistributionSource(instance.Spec.Distribution) {
+		return fmt.Errorf("failed to validate distribution: %w", errDistributionUnset)
+	}
+
+	if err := validateDistributionImage(instance.Spec.Distribution); err != nil {
+		return fmt.Errorf("failed to validate distribution: %w", err)
+	}
+
 	// If using distribution name, validate it exists in clusterInfo
 	if instance.Spec.Distribution.Name != "" {
 		if r.ClusterInfo == nil {
 			return errors.New("failed to initialize cluster info")
 		}
-		if _, exists := r.ClusterInfo.DistributionImages[instance.Spec.Distribution.Name]; !exists {
-			return fmt.Errorf("failed to validate distribution: %s. Distribution name not supported", instance.Spec.Distribution.Name)
+		if !hasDistribution(r.ClusterInfo, instance.Spec.Distribution.Name) {
+			return fmt.Errorf("failed to validate distribution: %w", unknownDistributionError(r.ClusterInfo, instance.Spec.Distribution.Name))
 		}
 	}
 
 	return nil
 }
 
+// errDistributionUnset is returned for servers without a distribution name or
+// image. Admission rejects these, but objects stored before the rule was added
+// or written with validation disabled can still reach the reconciler.
+var errDistributionUnset = errors.New("either distribution.name or distribution.image must be set")
+
+// validateDistributionImage rejects a malformed spec.distribution.image,
+// matching the checks applied to image overrides, rather than letting the
+// Deployment fail to pull.
+func validateDistributionImage(distribution ogxiov1beta1.DistributionSpec) error {
+	if distribution.Image == "" {
+		return nil
+	}
+	if _, err := name.ParseReference(distribution.Image); err != nil {
+		return fmt.Errorf("invalid distribution.image %q: %w", distribution.Image, err)
+	}
+	return nil
+}
+
+// hasDistributionSource reports whether distribution names an image to deploy.
+func hasDistributionSource(distribution ogxiov1beta1.DistributionSpec) bool {
+	return distribution.Name != "" || distribution.Image != ""
+}
+
+// hasDistribution reports whether the distribution catalog contains name.
+func hasDistribution(clusterInfo *cluster.ClusterInfo, name string) bool {
+	if clusterInfo == nil {
+		return false
+	}
+	_, exists := clusterInfo.DistributionImages[name]
+	return exists
+}
+
+// unknownDistributionError explains why name cannot be resolved, telling an
+// operator without any distributions apart from a name missing from the catalog.
+func unknownDistributionError(clusterInfo *cluster.ClusterInfo, name string) error {
+	if distributionCatalogSize(clusterInfo) == 0 {
+		return fmt.Errorf("distribution %q cannot be resolved: the operator has no distribution catalog; "+
+			"use distribution.image or restore the catalog and restart the operator", name)
+	}
+	return fmt.Errorf("distribution %q not found; available distributions: %s",
+		name, strings.Join(slices.Sorted(maps.Keys(clusterInfo.DistributionImages)), ", "))
+}
+
+const (
+	// imageSourceCatalog marks images taken from the distribution catalog.
+	imageSourceCatalog = "catalog"
+	// imageSourceOverride marks images taken from the operator image overrides.
+	imageSourceOverride = "override"
+	// imageSourceCustom marks images set directly in spec.distribution.image.
+	imageSourceCustom = "custom"
+)
+
+// getImageSource reports where resolveImage takes the image of distribution from.
+func (r *OGXServerReconciler) getImageSource(ctx context.Context, distribution ogxiov1beta1.DistributionSpec) string {
+	if distribution.Name == "" {
+		return imageSourceCustom
+	}
+	if _, exists := r.operatorSettings(ctx).imageMappingOverrides[distribution.Name]; exists {
+		return imageSourceOverride
+	}
+	return imageSourceCatalog
+}
+
 // resolveImage determines the container image to use based on the distribution configuration.
 // It returns the resolved image and any error encountered.
-func (r *OGXServerReconciler) resolveImage(distribution ogxiov1beta1.DistributionSpec) (string, error) {
+func (r *OGXServerReconciler) resolveImage(ctx context.Context, distribution ogxiov1beta1.DistributionSpec) (string, error) {
 	distributionMap := r.ClusterInfo.DistributionImages
 	switch {
 	case distribution.Name != "":
-		if _, exists := distributionMap[distribution.Name]; !exists {
-			return "", fmt.Errorf("failed to validate distribution name: %s", distribution.Name)
+		if !hasDistribution(r.ClusterInfo, distribution.Name) {
+			return "", fmt.Errorf("failed to validate distribution name: %w", unknownDistributionError(r.ClusterInfo, distribution.Name))
 		}
 		// Check for image override in the operator config ConfigMap
 		// The override is keyed by distribution name only (e.g., "starter")
 		// This allows the same override to apply across all distributions
-		if override, exists := r.ImageMappingOverrides[distribution.Name]; exists {
+		if override, exists := r.operatorSettings(ctx).imageMappingOverrides[distribution.Name]; exists {
 			return override, nil
 		}
 		return distributionMap[distribution.Name], nil
 	case distribution.Image != "":
 		return distribution.Image, nil
 	default:
-		return "", errors.New("failed to validate distribution: either distribution.name or distribution.image must be set")
+		return "", fmt.Errorf("failed to validate distribution: %w", errDistributionUnset)
 	}
 }
 
 func buildPodDisruptionBudgetSpec(instance *ogxiov1beta1.OGXServer) *policyv1.PodDisruptionBudgetSpec {
-	if !needsPodDisruptionBudget(instance) {
+	if !deploy.NeedsPodDisruptionBudget(instance) {
 		return nil
 	}
 
@@ -699,11 +1618,14 @@ func buildHPAMetrics(auto *ogxiov1beta1.AutoscalingSpec) []autoscalingv2.MetricS
 	return metrics
 }
 
-func needsPodDisruptionBudget(instance *ogxiov1beta1.OGXServer) bool {
-	if instance.Spec.Workload != nil && instance.Spec.Workload.PodDisruptionBudget != nil {
-		return true
+// isSuspended reports whether the server is scaled to zero. Autoscaled
+// servers are never suspended, as their replica count is owned by the HPA.
+func isSuspended(instance *ogxiov1beta1.OGXServer) bool {
+	if instance.Spec.Workload != nil && instance.Spec.Workload.Autoscaling != nil &&
+		instance.Spec.Workload.Autoscaling.MaxReplicas > 0 {
+		return false
 	}
-	return deploy.GetEffectiveReplicas(instance) > 1
+	return deploy.GetEffectiveReplicas(instance) == 0
 }
 
 func copyIntOrString(value *intstr.IntOrString) *intstr.IntOrString {
@@ -1,16 +1,32 @@
 package controllers
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 
 	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
 	"github.com/ogx-ai/ogx-k8s-operator/pkg/cluster"
+	"github.com/ogx-ai/ogx-k8s-operator/pkg/deploy"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
 func int32Ptr(v int32) *int32 { return &v }
@@ -61,6 +77,7 @@ func TestBuildContainerSpec(t *testing.T) {
 		assert.Equal(t, "test-image:latest", c.Image)
 		assert.Equal(t, ogxiov1beta1.DefaultServerPort, c.Ports[0].ContainerPort)
 		assert.Equal(t, newDefaultStartupProbe(ogxiov1beta1.DefaultServerPort), c.StartupProbe)
+		assert.Contains(t, c.Env, corev1.EnvVar{Name: "OGX_PORT", Value: strconv.Itoa(int(ogxiov1beta1.DefaultServerPort))})
 		var foundOgxVol bool
 		for _, m := range c.VolumeMounts {
 			if m.Name == "ogx-storage" {
@@ -98,6 +115,537 @@ func TestBuildContainerSpec(t *testing.T) {
 			envNames = append(envNames, e.Name)
 		}
 		assert.Contains(t, envNames, "TEST_ENV")
+		assert.Nil(t, c.SecurityContext, "the container security context is unset by default")
+	})
+
+	t.Run("custom health check path", func(t *testing.T) {
+		instance := &ogxiov1beta1.OGXServer{
+			Spec: ogxiov1beta1.OGXServerSpec{
+				Distribution: ogxiov1beta1.DistributionSpec{Image: "x:latest"},
+				Workload:     &ogxiov1beta1.WorkloadSpec{HealthCheckPath: "/healthz"},
+			},
+		}
+		c := buildContainerSpec(t.Context(), nil, instance, "test-image:latest")
+		require.NotNil(t, c.StartupProbe)
+		require.NotNil(t, c.StartupProbe.HTTPGet)
+		assert.Equal(t, "/healthz", c.StartupProbe.HTTPGet.Path)
+	})
+
+	t.Run("startup probe overrides", func(t *testing.T) {
+		instance := &ogxiov1beta1.OGXServer{
+			Spec: ogxiov1beta1.OGXServerSpec{
+				Distribution: ogxiov1beta1.DistributionSpec{Image: "x:latest"},
+				Workload: &ogxiov1beta1.WorkloadSpec{StartupProbe: &ogxiov1beta1.StartupProbeSpec{
+					InitialDelaySeconds: ptr.To(int32(60)),
+					PeriodSeconds:       ptr.To(int32(20)),
+					TimeoutSeconds:      ptr.To(int32(5)),
+					FailureThreshold:    ptr.To(int32(30)),
+				}},
+			},
+		}
+		c := buildContainerSpec(t.Context(), nil, instance, "test-image:latest")
+		expected := newDefaultStartupProbe(ogxiov1beta1.DefaultServerPort)
+		expected.InitialDelaySeconds = 60
+		expected.PeriodSeconds = 20
+		expected.TimeoutSeconds = 5
+		expected.FailureThreshold = 30
+		assert.Equal(t, expected, c.StartupProbe)
+
+		// Unset fields keep their defaults.
+		instance.Spec.Workload.StartupProbe = &ogxiov1beta1.StartupProbeSpec{FailureThreshold: ptr.To(int32(60))}
+		c = buildContainerSpec(t.Context(), nil, instance, "test-image:latest")
+		expected = newDefaultStartupProbe(ogxiov1beta1.DefaultServerPort)
+		expected.FailureThreshold = 60
+		assert.Equal(t, expected, c.StartupProbe)
+	})
+
+	t.Run("exec startup probe", func(t *testing.T) {
+		instance := &ogxiov1beta1.OGXServer{
+			Spec: ogxiov1beta1.OGXServerSpec{
+				Distribution: ogxiov1beta1.DistributionSpec{Image: "x:latest"},
+				Workload: &ogxiov1beta1.WorkloadSpec{StartupProbe: &ogxiov1beta1.StartupProbeSpec{
+					Type:             ogxiov1beta1.StartupProbeTypeExec,
+					Command:          []string{"ogx", "health"},
+					FailureThreshold: ptr.To(int32(10)),
+				}},
+			},
+		}
+		c := buildContainerSpec(t.Context(), nil, instance, "test-image:latest")
+		require.NotNil(t, c.StartupProbe)
+		assert.Nil(t, c.StartupProbe.HTTPGet)
+		require.NotNil(t, c.StartupProbe.Exec)
+		assert.Equal(t, []string{"ogx", "health"}, c.StartupProbe.Exec.Command)
+		assert.Equal(t, int32(10), c.StartupProbe.FailureThreshold, "timing overrides apply to exec probes")
+
+		// The HTTPGet type keeps the health endpoint probe.
+		instance.Spec.Workload.StartupProbe = &ogxiov1beta1.StartupProbeSpec{Type: ogxiov1beta1.StartupProbeTypeHTTPGet}
+		c = buildContainerSpec(t.Context(), nil, instance, "test-image:latest")
+		assert.Equal(t, newDefaultStartupProbe(ogxiov1beta1.DefaultServerPort), c.StartupProbe)
+	})
+
+	t.Run("container security context override", func(t *testing.T) {
+		securityContext := &corev1.SecurityContext{
+			AllowPrivilegeEscalation: ptr.To(false),
+			Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+		}
+		instance := &ogxiov1beta1.OGXServer{
+			Spec: ogxiov1beta1.OGXServerSpec{
+				Distribution: ogxiov1beta1.DistributionSpec{Image: "x:latest"},
+				Workload: &ogxiov1beta1.WorkloadSpec{
+					Overrides: &ogxiov1beta1.WorkloadOverrides{SecurityContext: securityContext},
+				},
+			},
+		}
+		c := buildContainerSpec(t.Context(), nil, instance, "test-image:latest")
+		assert.Equal(t, securityContext, c.SecurityContext)
+		assert.NotSame(t, securityContext, c.SecurityContext)
+	})
+
+	t.Run("additional ports", func(t *testing.T) {
+		instance := &ogxiov1beta1.OGXServer{
+			Spec: ogxiov1beta1.OGXServerSpec{
+				Distribution: ogxiov1beta1.DistributionSpec{Image: "x:latest"},
+				Network: &ogxiov1beta1.NetworkSpec{
+					Port:            9000,
+					AdditionalPorts: []corev1.ContainerPort{{Name: "metrics", ContainerPort: 9090}},
+				},
+			},
+		}
+		c := buildContainerSpec(t.Context(), nil, instance, "test-image:latest")
+		assert.Equal(t, []corev1.ContainerPort{
+			{ContainerPort: 9000},
+			{Name: "metrics", ContainerPort: 9090},
+		}, c.Ports)
+		require.NoError(t, validateServerPort(instance, c), "additional ports do not conflict with the server port")
+	})
+
+	t.Run("preStop hook", func(t *testing.T) {
+		lifecycle := &corev1.Lifecycle{
+			PreStop: &corev1.LifecycleHandler{Sleep: &corev1.SleepAction{Seconds: 10}},
+		}
+		instance := &ogxiov1beta1.OGXServer{
+			Spec: ogxiov1beta1.OGXServerSpec{
+				Distribution: ogxiov1beta1.DistributionSpec{Image: "x:latest"},
+			},
+		}
+		assert.Nil(t, buildContainerSpec(t.Context(), nil, instance, "test-image:latest").Lifecycle)
+
+		instance.Spec.Workload = &ogxiov1beta1.WorkloadSpec{
+			Overrides: &ogxiov1beta1.WorkloadOverrides{Lifecycle: lifecycle},
+		}
+		c := buildContainerSpec(t.Context(), nil, instance, "test-image:latest")
+		assert.Equal(t, lifecycle, c.Lifecycle)
+		assert.NotSame(t, lifecycle, c.Lifecycle)
+	})
+
+	t.Run("termination message policy", func(t *testing.T) {
+		instance := &ogxiov1beta1.OGXServer{
+			Spec: ogxiov1beta1.OGXServerSpec{
+				Distribution: ogxiov1beta1.DistributionSpec{Image: "x:latest"},
+			},
+		}
+		assert.Empty(t, buildContainerSpec(t.Context(), nil, instance, "test-image:latest").TerminationMessagePolicy)
+
+		instance.Spec.Workload = &ogxiov1beta1.WorkloadSpec{
+			Overrides: &ogxiov1beta1.WorkloadOverrides{
+				TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+			},
+		}
+		c := buildContainerSpec(t.Context(), nil, instance, "test-image:latest")
+		assert.Equal(t, corev1.TerminationMessageFallbackToLogsOnError, c.TerminationMessagePolicy)
+	})
+
+	t.Run("image pull policy", func(t *testing.T) {
+		instance := &ogxiov1beta1.OGXServer{
+			Spec: ogxiov1beta1.OGXServerSpec{Distribution: ogxiov1beta1.DistributionSpec{Image: "x:latest"}},
+		}
+		for image, want := range map[string]corev1.PullPolicy{
+			"quay.io/ogx/distribution-starter:latest":                            corev1.PullAlways,
+			"quay.io/ogx/distribution-starter":                                   corev1.PullAlways,
+			"quay.io/ogx/distribution-starter:0.3.0":                             corev1.PullIfNotPresent,
+			"localhost:5000/starter":                                             corev1.PullAlways,
+			"quay.io/ogx/distribution-starter@sha256:" + strings.Repeat("a", 64): corev1.PullIfNotPresent,
+		} {
+			assert.Equal(t, want, buildContainerSpec(t.Context(), nil, instance, image).ImagePullPolicy, image)
+		}
+
+		instance.Spec.Workload = &ogxiov1beta1.WorkloadSpec{
+			Overrides: &ogxiov1beta1.WorkloadOverrides{ImagePullPolicy: corev1.PullNever},
+		}
+		c := buildContainerSpec(t.Context(), nil, instance, "quay.io/ogx/distribution-starter:latest")
+		assert.Equal(t, corev1.PullNever, c.ImagePullPolicy)
+	})
+}
+
+func TestConfigureContainerEnvironmentLogLevel(t *testing.T) {
+	newInstance := func(logLevel string) *ogxiov1beta1.OGXServer {
+		return &ogxiov1beta1.OGXServer{
+			Spec: ogxiov1beta1.OGXServerSpec{
+				Distribution: ogxiov1beta1.DistributionSpec{Image: "x:latest"},
+				Workload:     &ogxiov1beta1.WorkloadSpec{LogLevel: logLevel},
+			},
+		}
+	}
+	findEnv := func(c corev1.Container, name string) (string, bool) {
+		for _, e := range c.Env {
+			if e.Name == name {
+				return e.Value, true
+			}
+		}
+		return "", false
+	}
+
+	tests := []struct {
+		logLevel string
+		want     string
+	}{
+		{logLevel: "debug", want: "all=debug"},
+		{logLevel: "info", want: "all=info"},
+		{logLevel: "warn", want: "all=warning"},
+		{logLevel: "error", want: "all=error"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.logLevel, func(t *testing.T) {
+			c := buildContainerSpec(t.Context(), nil, newInstance(tt.logLevel), "x:latest")
+			value, ok := findEnv(c, "OGX_LOGGING")
+			require.True(t, ok, "expected OGX_LOGGING env var")
+			assert.Equal(t, tt.want, value)
+		})
+	}
+
+	t.Run("unset leaves the distribution default", func(t *testing.T) {
+		c := buildContainerSpec(t.Context(), nil, newInstance(""), "x:latest")
+		_, ok := findEnv(c, "OGX_LOGGING")
+		assert.False(t, ok)
+	})
+
+	t.Run("user env overrides the log level", func(t *testing.T) {
+		instance := newInstance("info")
+		instance.Spec.Workload.Overrides = &ogxiov1beta1.WorkloadOverrides{
+			Env: []corev1.EnvVar{{Name: "OGX_LOGGING", Value: "core=debug"}},
+		}
+		c := buildContainerSpec(t.Context(), nil, instance, "x:latest")
+		var values []string
+		for _, e := range c.Env {
+			if e.Name == "OGX_LOGGING" {
+				values = append(values, e.Value)
+			}
+		}
+		// The last definition of an environment variable wins.
+		assert.Equal(t, []string{"all=info", "core=debug"}, values)
+	})
+}
+
+func TestConfigureContainerEnvironmentEnvSources(t *testing.T) {
+	apiKey := corev1.EnvVar{
+		Name: "API_KEY",
+		ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "credentials"},
+			Key:                  "api-key",
+		}},
+	}
+	providerURL := corev1.EnvVar{
+		Name: "PROVIDER_URL",
+		ValueFrom: &corev1.EnvVarSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "endpoints"},
+			Key:                  "url",
+		}},
+	}
+	envFrom := []corev1.EnvFromSource{
+		{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "credentials"}}},
+		{Prefix: "VLLM_", ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "vllm"}}},
+	}
+	instance := &ogxiov1beta1.OGXServer{
+		Spec: ogxiov1beta1.OGXServerSpec{
+			Distribution: ogxiov1beta1.DistributionSpec{Image: "x:latest"},
+			Workload: &ogxiov1beta1.WorkloadSpec{Overrides: &ogxiov1beta1.WorkloadOverrides{
+				Env:     []corev1.EnvVar{apiKey, providerURL},
+				EnvFrom: envFrom,
+			}},
+		},
+	}
+
+	c := buildContainerSpec(t.Context(), nil, instance, "x:latest")
+
+	assert.Contains(t, c.Env, apiKey)
+	assert.Contains(t, c.Env, providerURL)
+	assert.Equal(t, envFrom, c.EnvFrom)
+
+	secrets, configMaps := getEnvSourceNames(instance)
+	assert.Equal(t, []string{"credentials"}, secrets, "Secrets should be listed once")
+	assert.Equal(t, []string{"endpoints", "vllm"}, configMaps)
+}
+
+func TestConfigureContainerEnvironmentProviderSecrets(t *testing.T) {
+	override := corev1.EnvVar{Name: "VLLM_API_TOKEN", Value: "from-overrides"}
+	instance := &ogxiov1beta1.OGXServer{
+		Spec: ogxiov1beta1.OGXServerSpec{
+			Distribution: ogxiov1beta1.DistributionSpec{Image: "x:latest"},
+			Workload: &ogxiov1beta1.WorkloadSpec{
+				ProviderSecrets: []ogxiov1beta1.ProviderSecretRef{
+					{EnvVar: "VLLM_API_TOKEN", SecretKeyRef: ogxiov1beta1.SecretKeyRef{Name: "vllm", Key: "token"}},
+					{EnvVar: "OPENAI_API_KEY", SecretKeyRef: ogxiov1beta1.SecretKeyRef{Name: "openai", Key: "api-key"}},
+				},
+			},
+		},
+	}
+
+	c := buildContainerSpec(t.Context(), nil, instance, "x:latest")
+
+	assert.Contains(t, c.Env, corev1.EnvVar{
+		Name: "VLLM_API_TOKEN",
+		ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "vllm"},
+			Key:                  "token",
+		}},
+	})
+	secrets, configMaps := getEnvSourceNames(instance)
+	assert.Equal(t, []string{"vllm", "openai"}, secrets, "provider Secrets are watched and hashed")
+	assert.Empty(t, configMaps)
+
+	instance.Spec.Workload.Overrides = &ogxiov1beta1.WorkloadOverrides{Env: []corev1.EnvVar{override}}
+	c = buildContainerSpec(t.Context(), nil, instance, "x:latest")
+	var last corev1.EnvVar
+	for _, env := range c.Env {
+		if env.Name == override.Name {
+			last = env
+		}
+	}
+	assert.Equal(t, override, last, "overrides.env must come last so that it takes precedence")
+}
+
+func TestGetManagedCABundleConfigMapName(t *testing.T) {
+	newInstance := func(name string, trust *ogxiov1beta1.TrustConfig) *ogxiov1beta1.OGXServer {
+		instance := createTestOGX("", "x:latest")
+		instance.Name = name
+		if trust != nil {
+			instance.Spec.TLS = &ogxiov1beta1.TLSClientConfig{Trust: trust}
+		}
+		return instance
+	}
+
+	t.Run("defaults to the instance name", func(t *testing.T) {
+		assert.Equal(t, "ogx-ca-bundle", getManagedCABundleConfigMapName(newInstance("ogx", nil)))
+	})
+
+	t.Run("uses the configured name", func(t *testing.T) {
+		instance := newInstance("ogx", &ogxiov1beta1.TrustConfig{
+			ManagedConfigMapName: "team-a-trusted-cas",
+			CACertificates:       []ogxiov1beta1.ConfigMapKeyRef{{Name: "corporate", Key: "root.crt"}},
+		})
+		assert.Equal(t, "team-a-trusted-cas", getManagedCABundleConfigMapName(instance))
+
+		var podSpec corev1.PodSpec
+		configureTLSCABundle(t.Context(), nil, instance, &podSpec)
+		require.Len(t, podSpec.Volumes, 1)
+		assert.Equal(t, "team-a-trusted-cas", podSpec.Volumes[0].ConfigMap.Name, "the volume must mount the resolved name")
+	})
+
+	t.Run("keeps names that are valid ConfigMap names", func(t *testing.T) {
+		longName := strings.Repeat("a", 60)
+		assert.Equal(t, longName+"-ca-bundle", getManagedCABundleConfigMapName(newInstance(longName, nil)),
+			"existing long-named instances must keep their ConfigMap")
+	})
+
+	t.Run("shortens names that exceed the ConfigMap name limit deterministically", func(t *testing.T) {
+		longName := strings.Repeat("a", 240) + "-" + strings.Repeat("b", 10)
+		name := getManagedCABundleConfigMapName(newInstance(longName, nil))
+
+		assert.LessOrEqual(t, len(name), 253)
+		assert.True(t, strings.HasSuffix(name, ManagedCABundleConfigMapSuffix))
+		assert.Equal(t, name, getManagedCABundleConfigMapName(newInstance(longName, nil)))
+		assert.NotEqual(t, name, getManagedCABundleConfigMapName(newInstance(longName+"c", nil)),
+			"names sharing a prefix must not collide")
+		assert.Empty(t, validation.IsDNS1123Subdomain(name))
+	})
+}
+
+func TestDistributionConfigFileName(t *testing.T) {
+	clusterInfo := setupTestClusterInfo(map[string]string{
+		"starter": "starter-image:latest",
+		"legacy":  "legacy-image:0.2.10",
+	})
+	clusterInfo.DistributionConfigFiles = map[string]string{"legacy": "run.yaml"}
+
+	newInstance := func(name, key string) *ogxiov1beta1.OGXServer {
+		instance := createTestOGX(name, "")
+		instance.Spec.OverrideConfig = &ogxiov1beta1.ConfigMapKeyRef{Name: "cfg", Key: key}
+		return instance
+	}
+	tests := []struct {
+		name         string
+		instance     *ogxiov1beta1.OGXServer
+		wantFileName string
+		wantWarning  bool
+	}{
+		{"catalog default", newInstance("starter", "config.yaml"), "config.yaml", false},
+		{"catalog config file", newInstance("legacy", "run.yaml"), "run.yaml", false},
+		{"key differs from catalog config file", newInstance("legacy", "config.yaml"), "run.yaml", true},
+		{"image without catalog entry", newInstance("", "custom.yaml"), "config.yaml", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.instance.Spec.Distribution.Name == "" {
+				tt.instance.Spec.Distribution.Image = "custom-image:latest"
+			}
+			recorder := record.NewFakeRecorder(1)
+			r := &OGXServerReconciler{ClusterInfo: clusterInfo, Recorder: recorder}
+
+			assert.Equal(t, "/etc/ogx/"+tt.wantFileName, getConfigPath(r, tt.instance),
+				"OGX_CONFIG must point at the file the config is mounted as")
+
+			var podSpec corev1.PodSpec
+			configureUserConfig(t.Context(), r, tt.instance, &podSpec)
+			require.Len(t, podSpec.Volumes, 1)
+			items := podSpec.Volumes[0].ConfigMap.Items
+			require.Len(t, items, 1)
+			assert.Equal(t, tt.instance.Spec.OverrideConfig.Key, items[0].Key)
+			assert.Equal(t, tt.wantFileName, items[0].Path)
+
+			r.warnOnConfigKeyMismatch(t.Context(), tt.instance)
+			if tt.wantWarning {
+				require.Len(t, recorder.Events, 1)
+				assert.Contains(t, <-recorder.Events, "Warning "+ReasonConfigKeyMismatch)
+			} else {
+				assert.Empty(t, recorder.Events)
+			}
+		})
+	}
+}
+
+func TestValidateOverrideConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		errSubstr string
+	}{
+		{
+			name: "valid config",
+			data: "version: '2'\napis:\n- inference\nproviders:\n  inference: []\n",
+		},
+		{
+			name:      "invalid YAML",
+			data:      "apis: [inference\nproviders: {}",
+			errSubstr: "failed to parse YAML",
+		},
+		{
+			name:      "not a mapping",
+			data:      "- inference",
+			errSubstr: "failed to parse YAML",
+		},
+		{
+			name:      "missing required keys",
+			data:      "version: '2'\nimage_name: ollama",
+			errSubstr: "missing required top-level keys: apis, providers",
+		},
+		{
+			name:      "empty config",
+			data:      "",
+			errSubstr: "missing required top-level keys",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateOverrideConfig(tt.data)
+			if tt.errSubstr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errSubstr)
+		})
+	}
+}
+
+func TestResolveContainerResourcesDefaults(t *testing.T) {
+	defaults := &corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("500m"),
+			corev1.ResourceMemory: resource.MustParse("2Gi"),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse("4Gi"),
+		},
+	}
+
+	newInstance := func(resources *corev1.ResourceRequirements) *ogxiov1beta1.OGXServer {
+		return &ogxiov1beta1.OGXServer{
+			Spec: ogxiov1beta1.OGXServerSpec{
+				Distribution: ogxiov1beta1.DistributionSpec{Image: "x:latest"},
+				Workload:     &ogxiov1beta1.WorkloadSpec{Resources: resources},
+			},
+		}
+	}
+
+	t.Run("applies operator defaults when unspecified", func(t *testing.T) {
+		resources := resolveContainerResources(newInstance(nil), defaults, 1, false)
+		assert.Equal(t, resource.MustParse("500m"), resources.Requests[corev1.ResourceCPU])
+		assert.Equal(t, resource.MustParse("2Gi"), resources.Requests[corev1.ResourceMemory])
+		assert.Equal(t, resource.MustParse("4Gi"), resources.Limits[corev1.ResourceMemory])
+		assert.NotContains(t, resources.Limits, corev1.ResourceCPU)
+	})
+
+	t.Run("user values take precedence", func(t *testing.T) {
+		instance := newInstance(&corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+			Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("8Gi")},
+		})
+		resources := resolveContainerResources(instance, defaults, 1, false)
+		assert.Equal(t, resource.MustParse("2"), resources.Requests[corev1.ResourceCPU])
+		assert.Equal(t, resource.MustParse("2Gi"), resources.Requests[corev1.ResourceMemory])
+		assert.Equal(t, resource.MustParse("8Gi"), resources.Limits[corev1.ResourceMemory])
+		assert.NotContains(t, instance.Spec.Workload.Resources.Requests, corev1.ResourceMemory,
+			"the instance spec must not be mutated")
+	})
+
+	t.Run("skips default limits below the user request", func(t *testing.T) {
+		instance := newInstance(&corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("16Gi")},
+		})
+		resources := resolveContainerResources(instance, defaults, 1, false)
+		assert.NotContains(t, resources.Limits, corev1.ResourceMemory)
+		require.NoError(t, validateResources(resources))
+	})
+
+	t.Run("default requests never exceed user limits", func(t *testing.T) {
+		instance := newInstance(&corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")},
+		})
+		resources := resolveContainerResources(instance, defaults, 1, false)
+		assert.Equal(t, resource.MustParse("512Mi"), resources.Requests[corev1.ResourceMemory])
+		require.NoError(t, validateResources(resources))
+	})
+
+	t.Run("built-in defaults without operator defaults", func(t *testing.T) {
+		resources := resolveContainerResources(newInstance(nil), nil, 2, false)
+		assert.Equal(t, resource.MustParse("2"), resources.Requests[corev1.ResourceCPU])
+		assert.Equal(t, ogxiov1beta1.DefaultServerMemoryRequest, resources.Requests[corev1.ResourceMemory])
+		assert.Empty(t, resources.Limits)
+	})
+}
+
+func TestValidateResources(t *testing.T) {
+	t.Run("limits at or above requests", func(t *testing.T) {
+		require.NoError(t, validateResources(corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("1"),
+				corev1.ResourceMemory: resource.MustParse("1Gi"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("1000m"),
+				corev1.ResourceMemory: resource.MustParse("2Gi"),
+			},
+		}))
+	})
+
+	t.Run("limit below request", func(t *testing.T) {
+		err := validateResources(corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("4Gi")},
+			Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "memory limit 2Gi is less than request 4Gi")
 	})
 }
 
@@ -109,67 +657,1133 @@ func TestResolveImage(t *testing.T) {
 		want      string
 		expectErr bool
 	}{
-		{"by name", createTestOGX("ollama", ""), "ollama-image:latest", false},
-		{"by image", createTestOGX("", "test-image:latest"), "test-image:latest", false},
-		{"invalid name", createTestOGX("nope", ""), "", true},
+		{"by name", createTestOGX("ollama", ""), "ollama-image:latest", false},
+		{"by image", createTestOGX("", "test-image:latest"), "test-image:latest", false},
+		{"by image with registry and digest",
+			createTestOGX("", "quay.io/ogx/server@sha256:"+strings.Repeat("a", 64)),
+			"quay.io/ogx/server@sha256:" + strings.Repeat("a", 64), false},
+		{"invalid name", createTestOGX("nope", ""), "", true},
+		{"neither name nor image", createTestOGX("", ""), "", true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &OGXServerReconciler{ClusterInfo: clusterInfo}
+			img, err := r.resolveImage(t.Context(), tc.instance.Spec.Distribution)
+			if tc.expectErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "failed to validate distribution")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, img)
+		})
+	}
+}
+
+func TestResolvedImageStatus(t *testing.T) {
+	cases := []struct {
+		name       string
+		instance   *ogxiov1beta1.OGXServer
+		overrides  map[string]string
+		wantImage  string
+		wantSource string
+	}{
+		{name: "by name", instance: createTestOGX("ollama", ""),
+			wantImage: "ollama-image:latest", wantSource: imageSourceCatalog},
+		{name: "by name with override", instance: createTestOGX("ollama", ""),
+			overrides: map[string]string{"ollama": "quay.io/example/ollama:patched"},
+			wantImage: "quay.io/example/ollama:patched", wantSource: imageSourceOverride},
+		{name: "by image", instance: createTestOGX("", "test-image:latest"),
+			wantImage: "test-image:latest", wantSource: imageSourceCustom},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &OGXServerReconciler{
+				Client:                fake.NewClientBuilder().Build(),
+				ClusterInfo:           setupTestClusterInfo(nil),
+				ImageMappingOverrides: tc.overrides,
+			}
+			tc.instance.Name = "test"
+			tc.instance.Namespace = "default"
+
+			manifestCtx, err := r.buildManifestContext(t.Context(), tc.instance, "test-pvc")
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantImage, manifestCtx.ResolvedImage)
+			resolved := tc.instance.Status.ResolvedDistribution
+			require.NotNil(t, resolved)
+			assert.Equal(t, tc.wantImage, resolved.Image)
+			assert.Equal(t, tc.wantSource, resolved.ImageSource)
+		})
+	}
+}
+
+func TestDistributionValidation(t *testing.T) {
+	clusterInfo := setupTestClusterInfo(map[string]string{"ollama": "lls/lls-ollama:1.0"})
+	cases := []struct {
+		name      string
+		instance  *ogxiov1beta1.OGXServer
+		wantError bool
+	}{
+		{"valid name", createTestOGX("ollama", ""), false},
+		{"valid image", createTestOGX("", "test:latest"), false},
+		{"invalid name", createTestOGX("invalid", ""), true},
+		{"neither name nor image", createTestOGX("", ""), true},
+		{"image with registry and digest", createTestOGX("", "quay.io/ogx/server@sha256:"+strings.Repeat("a", 64)), false},
+		{"invalid image with uppercase repository", createTestOGX("", "Quay.io/OGX/Server:latest"), true},
+		{"invalid image with bad tag", createTestOGX("", "test-image:bad tag"), true},
+		{"invalid image with truncated digest", createTestOGX("", "test-image@sha256:abc"), true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &OGXServerReconciler{ClusterInfo: clusterInfo}
+			err := r.validateDistribution(tc.instance)
+			if tc.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDistributionValidationEmptyCatalog(t *testing.T) {
+	r := &OGXServerReconciler{ClusterInfo: setupTestClusterInfo(map[string]string{})}
+
+	err := r.validateDistribution(createTestOGX("starter", ""))
+	require.ErrorContains(t, err, "the operator has no distribution catalog")
+
+	_, err = r.resolveImage(t.Context(), ogxiov1beta1.DistributionSpec{Name: "starter"})
+	require.ErrorContains(t, err, "the operator has no distribution catalog")
+
+	image, err := r.resolveImage(t.Context(), ogxiov1beta1.DistributionSpec{Image: "test:latest"})
+	require.NoError(t, err, "image-based servers do not need the catalog")
+	assert.Equal(t, "test:latest", image)
+}
+
+func TestDistributionWithoutClusterInfo(t *testing.T) {
+	r := &OGXServerReconciler{ClusterInfo: nil}
+	err := r.validateDistribution(createTestOGX("ollama", ""))
+	require.Error(t, err)
+}
+
+func TestValidateDistributionCatalog(t *testing.T) {
+	t.Run("empty catalog rejects name-based servers with CatalogUnavailable", func(t *testing.T) {
+		r := &OGXServerReconciler{ClusterInfo: setupTestClusterInfo(map[string]string{})}
+		instance := createTestOGX("starter", "")
+
+		err := r.validateDistributionCatalog(t.Context(), instance)
+
+		var termErr *terminalError
+		require.ErrorAs(t, err, &termErr)
+		condition := GetCondition(&instance.Status, ConditionTypeCatalogAvailable)
+		require.NotNil(t, condition)
+		assert.Equal(t, metav1.ConditionFalse, condition.Status)
+		assert.Equal(t, ReasonCatalogUnavailable, condition.Reason)
+		assert.Contains(t, condition.Message, "no distribution catalog")
+	})
+
+	t.Run("empty catalog still allows image-based servers", func(t *testing.T) {
+		r := &OGXServerReconciler{ClusterInfo: setupTestClusterInfo(map[string]string{})}
+		instance := createTestOGX("", "test:latest")
+
+		require.NoError(t, r.validateDistributionCatalog(t.Context(), instance))
+		assert.Nil(t, GetCondition(&instance.Status, ConditionTypeCatalogAvailable))
+	})
+
+	t.Run("unknown name reports DistributionNotFound with the available names", func(t *testing.T) {
+		r := &OGXServerReconciler{ClusterInfo: setupTestClusterInfo(map[string]string{
+			"starter": "ogx/starter:1.0",
+			"ollama":  "ogx/ollama:1.0",
+		})}
+		instance := createTestOGX("strater", "")
+
+		err := r.validateDistributionCatalog(t.Context(), instance)
+
+		var termErr *terminalError
+		require.ErrorAs(t, err, &termErr)
+		condition := GetCondition(&instance.Status, ConditionTypeDistributionResolved)
+		require.NotNil(t, condition)
+		assert.Equal(t, metav1.ConditionFalse, condition.Status)
+		assert.Equal(t, ReasonDistributionNotFound, condition.Reason)
+		assert.Equal(t, `distribution "strater" not found; available distributions: ollama, starter`, condition.Message)
+		assert.Nil(t, GetCondition(&instance.Status, ConditionTypeCatalogAvailable),
+			"an unknown name says nothing about the catalog")
+	})
+
+	t.Run("fixing the name clears DistributionResolved", func(t *testing.T) {
+		r := &OGXServerReconciler{ClusterInfo: setupTestClusterInfo(nil)}
+		instance := createTestOGX("ollama", "")
+		SetDistributionResolvedCondition(&instance.Status, false, ReasonDistributionNotFound, "not found")
+
+		require.NoError(t, r.validateDistributionCatalog(t.Context(), instance))
+		assert.True(t, IsConditionTrue(&instance.Status, ConditionTypeDistributionResolved))
+	})
+
+	t.Run("neither name nor image reports DistributionUnset", func(t *testing.T) {
+		r := &OGXServerReconciler{ClusterInfo: setupTestClusterInfo(nil)}
+		instance := createTestOGX("", "")
+
+		err := r.validateDistributionCatalog(t.Context(), instance)
+
+		var termErr *terminalError
+		require.ErrorAs(t, err, &termErr)
+		condition := GetCondition(&instance.Status, ConditionTypeDistributionResolved)
+		require.NotNil(t, condition)
+		assert.Equal(t, metav1.ConditionFalse, condition.Status)
+		assert.Equal(t, ReasonDistributionUnset, condition.Reason)
+		assert.Nil(t, GetCondition(&instance.Status, ConditionTypeCatalogAvailable))
+		assert.Contains(t, condition.Message, "either distribution.name or distribution.image must be set")
+	})
+
+	t.Run("malformed image reports DistributionInvalidImage", func(t *testing.T) {
+		r := &OGXServerReconciler{ClusterInfo: setupTestClusterInfo(nil)}
+		instance := createTestOGX("", "test-image:bad tag")
+
+		err := r.validateDistributionCatalog(t.Context(), instance)
+
+		var termErr *terminalError
+		require.ErrorAs(t, err, &termErr)
+		condition := GetCondition(&instance.Status, ConditionTypeDistributionResolved)
+		require.NotNil(t, condition)
+		assert.Equal(t, metav1.ConditionFalse, condition.Status)
+		assert.Equal(t, ReasonDistributionInvalidImage, condition.Reason)
+		assert.Contains(t, condition.Message, "test-image:bad tag")
+	})
+
+	t.Run("restored catalog clears the condition", func(t *testing.T) {
+		r := &OGXServerReconciler{ClusterInfo: setupTestClusterInfo(nil)}
+		instance := createTestOGX("ollama", "")
+		SetCatalogAvailableCondition(&instance.Status, false, "no catalog")
+
+		require.NoError(t, r.validateDistributionCatalog(t.Context(), instance))
+		assert.True(t, IsConditionTrue(&instance.Status, ConditionTypeCatalogAvailable))
+	})
+}
+
+func TestPodOverridesWithServiceAccount(t *testing.T) {
+	instance := &ogxiov1beta1.OGXServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "ns"},
+		Spec: ogxiov1beta1.OGXServerSpec{
+			Distribution: ogxiov1beta1.DistributionSpec{Image: "x:latest"},
+			Workload: &ogxiov1beta1.WorkloadSpec{
+				Overrides: &ogxiov1beta1.WorkloadOverrides{ServiceAccountName: "custom-sa"},
+			},
+		},
+	}
+	spec := &corev1.PodSpec{Containers: []corev1.Container{{Name: "c"}}}
+	configurePodOverrides(instance, spec)
+	assert.Equal(t, "custom-sa", spec.ServiceAccountName)
+}
+
+func TestProjectedConfig(t *testing.T) {
+	newInstance := func(projected bool) *ogxiov1beta1.OGXServer {
+		return &ogxiov1beta1.OGXServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "ns"},
+			Spec: ogxiov1beta1.OGXServerSpec{
+				Distribution:   ogxiov1beta1.DistributionSpec{Image: "x:latest"},
+				OverrideConfig: &ogxiov1beta1.ConfigMapKeyRef{Name: "cfg", Key: "config.yaml"},
+				TLS: &ogxiov1beta1.TLSClientConfig{Trust: &ogxiov1beta1.TrustConfig{
+					CACertificates: []ogxiov1beta1.ConfigMapKeyRef{{Name: "corporate", Key: "root.crt"}},
+				}},
+				Workload: &ogxiov1beta1.WorkloadSpec{ProjectedConfig: projected},
+			},
+		}
+	}
+	volumeNames := func(volumes []corev1.Volume) []string {
+		var names []string
+		for _, v := range volumes {
+			names = append(names, v.Name)
+		}
+		return names
+	}
+	mountPaths := func(c corev1.Container) map[string]string {
+		paths := map[string]string{}
+		for _, m := range c.VolumeMounts {
+			paths[m.Name] = m.MountPath
+		}
+		return paths
+	}
+	envValue := func(c corev1.Container, name string) string {
+		for _, e := range c.Env {
+			if e.Name == name {
+				return e.Value
+			}
+		}
+		return ""
+	}
+
+	t.Run("separate volumes by default", func(t *testing.T) {
+		instance := newInstance(false)
+		container := buildContainerSpec(t.Context(), nil, instance, "x:latest")
+		podSpec := configurePodStorage(t.Context(), nil, instance, container, "")
+
+		assert.Subset(t, volumeNames(podSpec.Volumes), []string{userConfigVolumeName, CABundleVolumeName})
+		assert.Equal(t, ManagedCABundleMountPath, mountPaths(container)[CABundleVolumeName])
+		assert.Equal(t, ManagedCABundleFilePath, envValue(container, "SSL_CERT_FILE"))
+	})
+
+	t.Run("projects the config and CA bundle into one volume", func(t *testing.T) {
+		instance := newInstance(true)
+		container := buildContainerSpec(t.Context(), nil, instance, "x:latest")
+		podSpec := configurePodStorage(t.Context(), nil, instance, container, "")
+
+		assert.NotContains(t, volumeNames(podSpec.Volumes), CABundleVolumeName)
+		assert.NotContains(t, mountPaths(container), CABundleVolumeName)
+		assert.Equal(t, userConfigMountPath, mountPaths(container)[userConfigVolumeName])
+		assert.Equal(t, "/etc/ogx/ca-bundle.crt", envValue(container, "SSL_CERT_FILE"))
+		assert.Equal(t, "/etc/ogx/config.yaml", envValue(container, "OGX_CONFIG"))
+
+		var volume *corev1.Volume
+		for i := range podSpec.Volumes {
+			if podSpec.Volumes[i].Name == userConfigVolumeName {
+				volume = &podSpec.Volumes[i]
+			}
+		}
+		require.NotNil(t, volume)
+		require.NotNil(t, volume.Projected)
+		assert.Equal(t, []corev1.VolumeProjection{
+			{ConfigMap: &corev1.ConfigMapProjection{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "cfg"},
+				Items:                []corev1.KeyToPath{{Key: "config.yaml", Path: "config.yaml"}},
+			}},
+			{ConfigMap: &corev1.ConfigMapProjection{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "test-ca-bundle"},
+				Items:                []corev1.KeyToPath{{Key: ManagedCABundleKey, Path: ManagedCABundleKey}},
+			}},
+		}, volume.Projected.Sources)
+	})
+
+	t.Run("projects every key when source keys are preserved", func(t *testing.T) {
+		instance := newInstance(true)
+		instance.Spec.TLS.Trust.PreserveKeys = true
+		var podSpec corev1.PodSpec
+		configureUserConfig(t.Context(), nil, instance, &podSpec)
+
+		require.Len(t, podSpec.Volumes, 1)
+		require.NotNil(t, podSpec.Volumes[0].Projected)
+		require.Len(t, podSpec.Volumes[0].Projected.Sources, 2)
+		assert.Empty(t, podSpec.Volumes[0].Projected.Sources[1].ConfigMap.Items)
+	})
+
+	t.Run("no effect without a CA bundle", func(t *testing.T) {
+		instance := newInstance(true)
+		instance.Spec.TLS = nil
+		var podSpec corev1.PodSpec
+		configureUserConfig(t.Context(), nil, instance, &podSpec)
+
+		require.Len(t, podSpec.Volumes, 1)
+		assert.NotNil(t, podSpec.Volumes[0].ConfigMap)
+		assert.Nil(t, podSpec.Volumes[0].Projected)
+	})
+}
+
+func TestConfigurePodStorage(t *testing.T) {
+	gpuAffinity := &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+					MatchExpressions: []corev1.NodeSelectorRequirement{{
+						Key:      "nvidia.com/gpu.product",
+						Operator: corev1.NodeSelectorOpIn,
+						Values:   []string{"NVIDIA-A100-SXM4-80GB"},
+					}},
+				}},
+			},
+		},
+	}
+	gpuToleration := corev1.Toleration{
+		Key:      "nvidia.com/gpu",
+		Operator: corev1.TolerationOpExists,
+		Effect:   corev1.TaintEffectNoSchedule,
+	}
+
+	newInstance := func(replicas int32) *ogxiov1beta1.OGXServer {
+		return &ogxiov1beta1.OGXServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "ns"},
+			Spec: ogxiov1beta1.OGXServerSpec{
+				Distribution: ogxiov1beta1.DistributionSpec{Image: "x:latest"},
+				Workload: &ogxiov1beta1.WorkloadSpec{
+					Replicas: int32Ptr(replicas),
+					Overrides: &ogxiov1beta1.WorkloadOverrides{
+						NodeSelector: map[string]string{"node-role.kubernetes.io/gpu": "true"},
+						Tolerations:  []corev1.Toleration{gpuToleration},
+						Affinity:     gpuAffinity,
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("applies scheduling overrides", func(t *testing.T) {
+		instance := newInstance(1)
+		podSpec := configurePodStorage(t.Context(), nil, instance, corev1.Container{Name: "c"}, "")
+
+		assert.Equal(t, map[string]string{"node-role.kubernetes.io/gpu": "true"}, podSpec.NodeSelector)
+		assert.Equal(t, []corev1.Toleration{gpuToleration}, podSpec.Tolerations)
+		assert.Equal(t, gpuAffinity, podSpec.Affinity)
+	})
+
+	t.Run("keeps default pod anti-affinity alongside node affinity", func(t *testing.T) {
+		instance := newInstance(2)
+		podSpec := configurePodStorage(t.Context(), nil, instance, corev1.Container{Name: "c"}, "")
+
+		require.NotNil(t, podSpec.Affinity)
+		assert.Equal(t, gpuAffinity.NodeAffinity, podSpec.Affinity.NodeAffinity)
+		assert.NotNil(t, podSpec.Affinity.PodAntiAffinity)
+		assert.Nil(t, instance.Spec.Workload.Overrides.Affinity.PodAntiAffinity,
+			"the instance spec must not be mutated")
+	})
+
+	t.Run("appends sidecars after the server container", func(t *testing.T) {
+		instance := newInstance(1)
+		instance.Spec.Workload.Overrides.Volumes = []corev1.Volume{{
+			Name:         "logs",
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		}}
+		instance.Spec.Workload.Overrides.VolumeMounts = []corev1.VolumeMount{{Name: "logs", MountPath: "/var/log/ogx"}}
+		sidecar := corev1.Container{
+			Name:         "log-forwarder",
+			Image:        "fluent/fluent-bit:latest",
+			VolumeMounts: []corev1.VolumeMount{{Name: "logs", MountPath: "/logs", ReadOnly: true}},
+		}
+		instance.Spec.Workload.Sidecars = []corev1.Container{sidecar}
+
+		podSpec := configurePodStorage(t.Context(), nil, instance, corev1.Container{Name: ogxiov1beta1.DefaultContainerName}, "")
+
+		require.Len(t, podSpec.Containers, 2)
+		assert.Equal(t, ogxiov1beta1.DefaultContainerName, podSpec.Containers[0].Name, "the server container must stay first")
+		assert.Contains(t, podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{Name: "logs", MountPath: "/var/log/ogx"},
+			"override volume mounts must target the server container")
+		assert.Equal(t, sidecar, podSpec.Containers[1])
+		require.NoError(t, validatePodVolumes(podSpec), "sidecars can mount override volumes")
+	})
+
+	t.Run("defaults the fsGroup without a pod security context", func(t *testing.T) {
+		podSpec := configurePodStorage(t.Context(), nil, newInstance(1), corev1.Container{Name: "c"}, "")
+
+		require.NotNil(t, podSpec.SecurityContext)
+		assert.Equal(t, &corev1.PodSecurityContext{FSGroup: ptr.To(FSGroup)}, podSpec.SecurityContext)
+	})
+
+	t.Run("applies the pod security context override", func(t *testing.T) {
+		instance := newInstance(1)
+		instance.Spec.Workload.Overrides.PodSecurityContext = &corev1.PodSecurityContext{
+			RunAsUser:      ptr.To(int64(1000680000)),
+			RunAsNonRoot:   ptr.To(true),
+			SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+		}
+
+		podSpec := configurePodStorage(t.Context(), nil, instance, corev1.Container{Name: "c"}, "")
+
+		require.NotNil(t, podSpec.SecurityContext)
+		assert.Equal(t, ptr.To(int64(1000680000)), podSpec.SecurityContext.RunAsUser)
+		assert.Equal(t, ptr.To(true), podSpec.SecurityContext.RunAsNonRoot)
+		assert.Equal(t, corev1.SeccompProfileTypeRuntimeDefault, podSpec.SecurityContext.SeccompProfile.Type)
+		assert.Equal(t, ptr.To(FSGroup), podSpec.SecurityContext.FSGroup, "fsGroup keeps its default when unset")
+		assert.Nil(t, instance.Spec.Workload.Overrides.PodSecurityContext.FSGroup, "the spec must not be mutated")
+
+		instance.Spec.Workload.Overrides.PodSecurityContext.FSGroup = ptr.To(int64(2000))
+		podSpec = configurePodStorage(t.Context(), nil, instance, corev1.Container{Name: "c"}, "")
+		assert.Equal(t, ptr.To(int64(2000)), podSpec.SecurityContext.FSGroup)
+	})
+
+	t.Run("sets the termination grace period", func(t *testing.T) {
+		podSpec := configurePodStorage(t.Context(), nil, newInstance(1), corev1.Container{Name: "c"}, "")
+		assert.Equal(t, ptr.To(defaultTerminationGracePeriodSeconds), podSpec.TerminationGracePeriodSeconds)
+
+		instance := newInstance(1)
+		instance.Spec.Workload.Overrides.TerminationGracePeriodSeconds = ptr.To(int64(300))
+		podSpec = configurePodStorage(t.Context(), nil, instance, corev1.Container{Name: "c"}, "")
+		assert.Equal(t, ptr.To(int64(300)), podSpec.TerminationGracePeriodSeconds)
+	})
+
+	t.Run("sets host aliases and DNS config", func(t *testing.T) {
+		podSpec := configurePodStorage(t.Context(), nil, newInstance(1), corev1.Container{Name: "c"}, "")
+		assert.Empty(t, podSpec.HostAliases)
+		assert.Nil(t, podSpec.DNSConfig)
+
+		instance := newInstance(1)
+		hostAliases := []corev1.HostAlias{{IP: "10.0.0.10", Hostnames: []string{"vllm.internal", "registry.internal"}}}
+		dnsConfig := &corev1.PodDNSConfig{
+			Nameservers: []string{"10.0.0.53"},
+			Searches:    []string{"corp.internal"},
+			Options:     []corev1.PodDNSConfigOption{{Name: "ndots", Value: ptr.To("2")}},
+		}
+		instance.Spec.Workload.Overrides.HostAliases = hostAliases
+		instance.Spec.Workload.Overrides.DNSConfig = dnsConfig
+
+		podSpec = configurePodStorage(t.Context(), nil, instance, corev1.Container{Name: "c"}, "")
+		assert.Equal(t, hostAliases, podSpec.HostAliases)
+		assert.Equal(t, dnsConfig, podSpec.DNSConfig)
+		assert.NotSame(t, dnsConfig, podSpec.DNSConfig, "the spec must not be shared with the pod")
+	})
+
+	t.Run("sets image pull secrets", func(t *testing.T) {
+		podSpec := configurePodStorage(t.Context(), nil, newInstance(1), corev1.Container{Name: "c"}, "")
+		assert.Empty(t, podSpec.ImagePullSecrets)
+
+		instance := newInstance(1)
+		instance.Spec.Workload.ImagePullSecrets = []corev1.LocalObjectReference{{Name: "registry-credentials"}}
+		podSpec = configurePodStorage(t.Context(), nil, instance, corev1.Container{Name: "c"}, "")
+		assert.Equal(t, []corev1.LocalObjectReference{{Name: "registry-credentials"}}, podSpec.ImagePullSecrets)
+	})
+
+	t.Run("mounts the storage volume into init containers", func(t *testing.T) {
+		instance := newInstance(1)
+		instance.Spec.Workload.InitContainers = []corev1.Container{
+			{Name: "download-model", Image: "registry.example.com/downloader:latest"},
+			{
+				Name:         "custom-path",
+				Image:        "registry.example.com/downloader:latest",
+				VolumeMounts: []corev1.VolumeMount{{Name: storageVolumeName, MountPath: "/models"}},
+			},
+		}
+
+		podSpec := configurePodStorage(t.Context(), nil, instance, corev1.Container{Name: ogxiov1beta1.DefaultContainerName}, "")
+
+		require.Len(t, podSpec.InitContainers, 2)
+		assert.Equal(t, "download-model", podSpec.InitContainers[0].Name)
+		assert.Equal(t, []corev1.VolumeMount{{Name: storageVolumeName, MountPath: ogxiov1beta1.DefaultMountPath}},
+			podSpec.InitContainers[0].VolumeMounts)
+		assert.Equal(t, []corev1.VolumeMount{{Name: storageVolumeName, MountPath: "/models"}},
+			podSpec.InitContainers[1].VolumeMounts, "an existing storage mount is kept")
+		assert.Empty(t, instance.Spec.Workload.InitContainers[0].VolumeMounts, "the spec must not be mutated")
+		require.NoError(t, validatePodVolumes(podSpec))
+	})
+
+	t.Run("mounts a writable /tmp with a read-only root filesystem", func(t *testing.T) {
+		tmpMount := corev1.VolumeMount{Name: tmpVolumeName, MountPath: tmpMountPath}
+		build := func(instance *ogxiov1beta1.OGXServer) corev1.PodSpec {
+			container := buildContainerSpec(t.Context(), nil, instance, "test-image:latest")
+			return configurePodStorage(t.Context(), nil, instance, container, "")
+		}
+
+		podSpec := build(newInstance(1))
+		assert.NotContains(t, podSpec.Containers[0].VolumeMounts, tmpMount, "/tmp is only mounted for a read-only root")
+
+		instance := newInstance(1)
+		instance.Spec.Workload.Overrides.SecurityContext = &corev1.SecurityContext{ReadOnlyRootFilesystem: ptr.To(true)}
+		podSpec = build(instance)
+		assert.Contains(t, podSpec.Volumes, corev1.Volume{
+			Name:         tmpVolumeName,
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		})
+		assert.Contains(t, podSpec.Containers[0].VolumeMounts, tmpMount)
+		assert.True(t, *podSpec.Containers[0].SecurityContext.ReadOnlyRootFilesystem)
+		require.NoError(t, validatePodVolumes(podSpec))
+
+		instance.Spec.Workload.Overrides.Volumes = []corev1.Volume{{
+			Name:         "scratch",
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{Medium: corev1.StorageMediumMemory}},
+		}}
+		instance.Spec.Workload.Overrides.VolumeMounts = []corev1.VolumeMount{{Name: "scratch", MountPath: "/tmp/"}}
+		podSpec = build(instance)
+		assert.NotContains(t, podSpec.Containers[0].VolumeMounts, tmpMount, "a user /tmp mount is kept")
+		require.NoError(t, validatePodVolumes(podSpec))
+	})
+}
+
+func TestFindWorkloadOverrideConflicts(t *testing.T) {
+	newInstance := func(overrides *ogxiov1beta1.WorkloadOverrides) *ogxiov1beta1.OGXServer {
+		return &ogxiov1beta1.OGXServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "ns"},
+			Spec: ogxiov1beta1.OGXServerSpec{
+				Distribution: ogxiov1beta1.DistributionSpec{Image: "x:latest"},
+				Workload:     &ogxiov1beta1.WorkloadSpec{Overrides: overrides},
+			},
+		}
+	}
+	withVolume := func(name string) *ogxiov1beta1.OGXServer {
+		return newInstance(&ogxiov1beta1.WorkloadOverrides{
+			Volumes: []corev1.Volume{{Name: name}},
+		})
+	}
+	withMount := func(mountPath string) *ogxiov1beta1.OGXServer {
+		return newInstance(&ogxiov1beta1.WorkloadOverrides{
+			VolumeMounts: []corev1.VolumeMount{{Name: "extra", MountPath: mountPath}},
+		})
+	}
+
+	tests := []struct {
+		name         string
+		instance     *ogxiov1beta1.OGXServer
+		wantConflict string
+	}{
+		{
+			name:         "storage volume name",
+			instance:     withVolume("ogx-storage"),
+			wantConflict: `volume "ogx-storage" conflicts with an operator-managed volume`,
+		},
+		{
+			name: "user config volume name when override config is set",
+			instance: func() *ogxiov1beta1.OGXServer {
+				inst := withVolume("user-config")
+				inst.Spec.OverrideConfig = &ogxiov1beta1.ConfigMapKeyRef{Name: "cfg", Key: "config.yaml"}
+				return inst
+			}(),
+			wantConflict: `volume "user-config" conflicts with an operator-managed volume`,
+		},
+		{
+			name: "CA bundle volume name when CA certificates are set",
+			instance: func() *ogxiov1beta1.OGXServer {
+				inst := withVolume("ca-bundle")
+				inst.Spec.TLS = &ogxiov1beta1.TLSClientConfig{Trust: &ogxiov1beta1.TrustConfig{
+					CACertificates: []ogxiov1beta1.ConfigMapKeyRef{{Name: "ca", Key: "ca.crt"}},
+				}}
+				return inst
+			}(),
+			wantConflict: `volume "ca-bundle" conflicts with an operator-managed volume`,
+		},
+		{
+			name: "serving TLS volume name when serving TLS is set",
+			instance: func() *ogxiov1beta1.OGXServer {
+				inst := withVolume(servingTLSVolumeName)
+				inst.Spec.Network = &ogxiov1beta1.NetworkSpec{TLS: &ogxiov1beta1.TLSSpec{SecretName: "serving-cert"}}
+				return inst
+			}(),
+			wantConflict: `conflicts with an operator-managed volume`,
+		},
+		{
+			name: "tmp volume name with a read-only root filesystem",
+			instance: newInstance(&ogxiov1beta1.WorkloadOverrides{
+				SecurityContext: &corev1.SecurityContext{ReadOnlyRootFilesystem: ptr.To(true)},
+				Volumes:         []corev1.Volume{{Name: tmpVolumeName}},
+			}),
+			wantConflict: `volume "tmp" conflicts with an operator-managed volume`,
+		},
+		{
+			name: "names of disabled features are free",
+			instance: newInstance(&ogxiov1beta1.WorkloadOverrides{Volumes: []corev1.Volume{
+				{Name: "user-config"}, {Name: "ca-bundle"}, {Name: servingTLSVolumeName},
+				{Name: startupScriptVolumeName}, {Name: modelCacheVolumeName}, {Name: tmpVolumeName},
+			}}),
+		},
+		{
+			name: "tmp mount path replaces the tmp volume",
+			instance: newInstance(&ogxiov1beta1.WorkloadOverrides{
+				SecurityContext: &corev1.SecurityContext{ReadOnlyRootFilesystem: ptr.To(true)},
+				Volumes:         []corev1.Volume{{Name: tmpVolumeName}},
+				VolumeMounts:    []corev1.VolumeMount{{Name: tmpVolumeName, MountPath: tmpMountPath}},
+			}),
+		},
+		{
+			name:         "storage mount path",
+			instance:     withMount(ogxiov1beta1.DefaultMountPath + "/"),
+			wantConflict: `volumeMount "extra" uses the mountPath "` + ogxiov1beta1.DefaultMountPath + `/" of operator-managed volume "ogx-storage"`,
+		},
+		{
+			name: "user config mount path when override config is set",
+			instance: func() *ogxiov1beta1.OGXServer {
+				inst := withMount("/etc/ogx")
+				inst.Spec.OverrideConfig = &ogxiov1beta1.ConfigMapKeyRef{Name: "cfg", Key: "config.yaml"}
+				return inst
+			}(),
+			wantConflict: `of operator-managed volume "user-config"`,
+		},
+		{
+			name: "CA bundle mount path when CA certificates are set",
+			instance: func() *ogxiov1beta1.OGXServer {
+				inst := withMount(ManagedCABundleMountPath)
+				inst.Spec.TLS = &ogxiov1beta1.TLSClientConfig{Trust: &ogxiov1beta1.TrustConfig{
+					CACertificates: []ogxiov1beta1.ConfigMapKeyRef{{Name: "ca", Key: "ca.crt"}},
+				}}
+				return inst
+			}(),
+			wantConflict: `of operator-managed volume "ca-bundle"`,
+		},
+		{
+			name:     "user config mount path is free without override config",
+			instance: withMount("/etc/ogx"),
+		},
+		{
+			name:     "unrelated volume and mount",
+			instance: withVolume("extra"),
+		},
+		{
+			name:     "no overrides",
+			instance: newInstance(nil),
+		},
 	}
-	for _, tc := range cases {
-		t.Run(tc.name, func(t *testing.T) {
-			r := &OGXServerReconciler{ClusterInfo: clusterInfo}
-			img, err := r.resolveImage(tc.instance.Spec.Distribution)
-			if tc.expectErr {
-				require.Error(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conflicts := findWorkloadOverrideConflicts(t.Context(), nil, tt.instance)
+			if tt.wantConflict == "" {
+				assert.Empty(t, conflicts)
 				return
 			}
-			require.NoError(t, err)
-			assert.Equal(t, tc.want, img)
+			require.Len(t, conflicts, 1)
+			assert.Contains(t, conflicts[0], tt.wantConflict)
 		})
 	}
 }
 
-func TestDistributionValidation(t *testing.T) {
-	clusterInfo := setupTestClusterInfo(map[string]string{"ollama": "lls/lls-ollama:1.0"})
-	cases := []struct {
-		name      string
-		instance  *ogxiov1beta1.OGXServer
-		wantError bool
+func TestValidatePodVolumes(t *testing.T) {
+	newInstance := func(trust *ogxiov1beta1.TrustConfig) *ogxiov1beta1.OGXServer {
+		instance := &ogxiov1beta1.OGXServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "ns"},
+			Spec: ogxiov1beta1.OGXServerSpec{
+				Distribution:   ogxiov1beta1.DistributionSpec{Image: "x:latest"},
+				OverrideConfig: &ogxiov1beta1.ConfigMapKeyRef{Name: "cfg", Key: "config.yaml"},
+				Workload:       &ogxiov1beta1.WorkloadSpec{Storage: &ogxiov1beta1.PVCStorageSpec{}},
+			},
+		}
+		if trust != nil {
+			instance.Spec.TLS = &ogxiov1beta1.TLSClientConfig{Trust: trust}
+		}
+		return instance
+	}
+	buildPodSpec := func(instance *ogxiov1beta1.OGXServer) corev1.PodSpec {
+		container := buildContainerSpec(t.Context(), nil, instance, "x:latest")
+		return configurePodStorage(t.Context(), nil, instance, container, "test-instance-pvc")
+	}
+	configMapSource := []ogxiov1beta1.ConfigMapKeyRef{{Name: "corporate-ca", Key: "ca.crt"}}
+	secretSource := []ogxiov1beta1.SecretKeyRef{{Name: "vendor-ca", Key: "ca.crt"}}
+
+	tests := []struct {
+		name  string
+		trust *ogxiov1beta1.TrustConfig
 	}{
-		{"valid name", createTestOGX("ollama", ""), false},
-		{"valid image", createTestOGX("", "test:latest"), false},
-		{"invalid name", createTestOGX("invalid", ""), true},
+		{name: "explicit ConfigMap", trust: &ogxiov1beta1.TrustConfig{CACertificates: configMapSource}},
+		{name: "explicit Secret", trust: &ogxiov1beta1.TrustConfig{CACertificateSecrets: secretSource}},
+		{
+			name:  "explicit ConfigMap with preserved keys",
+			trust: &ogxiov1beta1.TrustConfig{CACertificates: configMapSource, PreserveKeys: true},
+		},
+		{
+			name:  "explicit Secret with preserved keys",
+			trust: &ogxiov1beta1.TrustConfig{CACertificateSecrets: secretSource, PreserveKeys: true},
+		},
+		{
+			name:  "explicit ConfigMap and Secret",
+			trust: &ogxiov1beta1.TrustConfig{CACertificates: configMapSource, CACertificateSecrets: secretSource},
+		},
 	}
-	for _, tc := range cases {
-		t.Run(tc.name, func(t *testing.T) {
-			r := &OGXServerReconciler{ClusterInfo: clusterInfo}
-			err := r.validateDistribution(tc.instance)
-			if tc.wantError {
-				assert.Error(t, err)
-			} else {
-				assert.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name+" composes with storage and user config", func(t *testing.T) {
+			podSpec := buildPodSpec(newInstance(tt.trust))
+
+			require.NoError(t, validatePodVolumes(podSpec))
+			caVolumes := 0
+			for _, volume := range podSpec.Volumes {
+				if volume.Name == CABundleVolumeName {
+					caVolumes++
+				}
 			}
+			assert.Equal(t, 1, caVolumes, "all CA sources should share a single managed volume")
 		})
 	}
-}
 
-func TestDistributionWithoutClusterInfo(t *testing.T) {
-	r := &OGXServerReconciler{ClusterInfo: nil}
-	err := r.validateDistribution(createTestOGX("ollama", ""))
-	require.Error(t, err)
+	t.Run("rejects storage mounted at the CA bundle path", func(t *testing.T) {
+		instance := newInstance(&ogxiov1beta1.TrustConfig{CACertificates: configMapSource})
+		instance.Spec.Workload.Storage.MountPath = ManagedCABundleMountPath + "/"
+
+		err := validatePodVolumes(buildPodSpec(instance))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `mounts volumes "ogx-storage" and "ca-bundle" at the same path`)
+	})
+
+	t.Run("rejects storage mounted at the user config path", func(t *testing.T) {
+		instance := newInstance(nil)
+		instance.Spec.Workload.Storage.MountPath = "/etc/ogx"
+
+		err := validatePodVolumes(buildPodSpec(instance))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `mounts volumes "ogx-storage" and "user-config" at the same path`)
+	})
+
+	t.Run("rejects duplicate volume names", func(t *testing.T) {
+		podSpec := buildPodSpec(newInstance(nil))
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{Name: CABundleVolumeName})
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{Name: CABundleVolumeName})
+
+		err := validatePodVolumes(podSpec)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `volume "ca-bundle" is declared more than once`)
+	})
+
+	t.Run("rejects mounts of undeclared volumes", func(t *testing.T) {
+		podSpec := buildPodSpec(newInstance(nil))
+		podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts,
+			corev1.VolumeMount{Name: CABundleVolumeName, MountPath: ManagedCABundleMountPath})
+
+		err := validatePodVolumes(podSpec)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `mounts undeclared volume "ca-bundle"`)
+	})
+
+	t.Run("checks init containers", func(t *testing.T) {
+		podSpec := buildPodSpec(newInstance(nil))
+		podSpec.InitContainers = []corev1.Container{{
+			Name: "download",
+			VolumeMounts: []corev1.VolumeMount{
+				{Name: storageVolumeName, MountPath: "/data"},
+				{Name: "models", MountPath: "/data/"},
+			},
+		}}
+
+		err := validatePodVolumes(podSpec)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `container "download" mounts undeclared volume "models"`)
+		assert.Contains(t, err.Error(), `container "download" mounts volumes "ogx-storage" and "models" at the same path "/data"`)
+	})
 }
 
-func TestPodOverridesWithServiceAccount(t *testing.T) {
+func TestConfigureTLSCABundleSecretSources(t *testing.T) {
 	instance := &ogxiov1beta1.OGXServer{
 		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "ns"},
 		Spec: ogxiov1beta1.OGXServerSpec{
 			Distribution: ogxiov1beta1.DistributionSpec{Image: "x:latest"},
-			Workload: &ogxiov1beta1.WorkloadSpec{
-				Overrides: &ogxiov1beta1.WorkloadOverrides{ServiceAccountName: "custom-sa"},
+			TLS: &ogxiov1beta1.TLSClientConfig{Trust: &ogxiov1beta1.TrustConfig{
+				CACertificates: []ogxiov1beta1.ConfigMapKeyRef{{Name: "corporate-ca", Key: "corporate.crt"}},
+				CACertificateSecrets: []ogxiov1beta1.SecretKeyRef{
+					{Name: "vendor-ca", Key: "vendor.crt"},
+					{Name: "partner-ca", Key: "partner.crt"},
+				},
+			}},
+		},
+	}
+	container := buildContainerSpec(t.Context(), nil, instance, "x:latest")
+	podSpec := configurePodStorage(t.Context(), nil, instance, container, "")
+
+	require.NoError(t, validatePodVolumes(podSpec))
+	volumes := map[string]corev1.Volume{}
+	for _, volume := range podSpec.Volumes {
+		volumes[volume.Name] = volume
+	}
+
+	caBundle := volumes[CABundleVolumeName]
+	require.NotNil(t, caBundle.EmptyDir, "the server should read the bundle assembled by the init container")
+
+	sources := volumes[CABundleSourcesVolumeName]
+	require.NotNil(t, sources.Projected)
+	require.Len(t, sources.Projected.Sources, 3)
+	assert.Equal(t, "test-instance-ca-bundle", sources.Projected.Sources[0].ConfigMap.Name)
+	assert.Equal(t, []corev1.KeyToPath{{Key: ManagedCABundleKey, Path: "configmap/ca-bundle.crt"}},
+		sources.Projected.Sources[0].ConfigMap.Items)
+	assert.Equal(t, "vendor-ca", sources.Projected.Sources[1].Secret.Name)
+	assert.Equal(t, []corev1.KeyToPath{{Key: "vendor.crt", Path: "secrets/0.crt"}}, sources.Projected.Sources[1].Secret.Items)
+	assert.Equal(t, "partner-ca", sources.Projected.Sources[2].Secret.Name)
+	assert.Equal(t, []corev1.KeyToPath{{Key: "partner.crt", Path: "secrets/1.crt"}}, sources.Projected.Sources[2].Secret.Items)
+
+	require.NotEmpty(t, podSpec.InitContainers)
+	initContainer := podSpec.InitContainers[0]
+	assert.Equal(t, ogxiov1beta1.CABundleInitContainerName, initContainer.Name)
+	assert.Equal(t, "x:latest", initContainer.Image)
+	assert.Equal(t, container.SecurityContext, initContainer.SecurityContext)
+	assert.Contains(t, initContainer.Command[2],
+		"for f in configmap/ca-bundle.crt secrets/0.crt secrets/1.crt; do", "Secrets should follow the ConfigMap bundle in the order listed")
+	assert.NotContains(t, initContainer.Command[2], "preserved")
+
+	t.Run("preserved keys are copied next to the bundle", func(t *testing.T) {
+		preserving := instance.DeepCopy()
+		preserving.Spec.TLS.Trust.PreserveKeys = true
+		podSpec := configurePodStorage(t.Context(), nil, preserving, container, "")
+
+		var sources *corev1.ProjectedVolumeSource
+		for _, volume := range podSpec.Volumes {
+			if volume.Name == CABundleSourcesVolumeName {
+				sources = volume.Projected
+			}
+		}
+		require.NotNil(t, sources)
+		assert.Equal(t, []corev1.KeyToPath{
+			{Key: ManagedCABundleKey, Path: "configmap/ca-bundle.crt"},
+			{Key: "corporate.crt", Path: "configmap/corporate.crt"},
+		}, sources.Sources[0].ConfigMap.Items)
+		assert.Contains(t, sources.Sources[1].Secret.Items, corev1.KeyToPath{Key: "vendor.crt", Path: "preserved/vendor.crt"})
+		assert.Contains(t, podSpec.InitContainers[0].Command[2], "cp configmap/* preserved/* "+ManagedCABundleMountPath+"/")
+	})
+
+	t.Run("is never projected into the override config", func(t *testing.T) {
+		projected := instance.DeepCopy()
+		projected.Spec.OverrideConfig = &ogxiov1beta1.ConfigMapKeyRef{Name: "cfg", Key: "config.yaml"}
+		projected.Spec.Workload = &ogxiov1beta1.WorkloadSpec{ProjectedConfig: true}
+
+		assert.False(t, projectsCABundleIntoConfig(t.Context(), nil, projected))
+	})
+}
+
+func TestValidateServerPort(t *testing.T) {
+	newInstance := func(port int32, env ...corev1.EnvVar) *ogxiov1beta1.OGXServer {
+		instance := &ogxiov1beta1.OGXServer{
+			Spec: ogxiov1beta1.OGXServerSpec{
+				Distribution: ogxiov1beta1.DistributionSpec{Image: "x:latest"},
+				Network:      &ogxiov1beta1.NetworkSpec{Port: port},
+			},
+		}
+		if len(env) > 0 {
+			instance.Spec.Workload = &ogxiov1beta1.WorkloadSpec{
+				Overrides: &ogxiov1beta1.WorkloadOverrides{Env: env},
+			}
+		}
+		return instance
+	}
+
+	t.Run("accepts the derived ports", func(t *testing.T) {
+		for _, port := range []int32{0, 9000} {
+			instance := newInstance(port)
+			require.NoError(t, validateServerPort(instance, buildContainerSpec(t.Context(), nil, instance, "x:latest")))
+		}
+	})
+
+	t.Run("rejects an OGX_PORT override", func(t *testing.T) {
+		instance := newInstance(9000, corev1.EnvVar{Name: "OGX_PORT", Value: "8080"})
+		err := validateServerPort(instance, buildContainerSpec(t.Context(), nil, instance, "x:latest"))
+		require.ErrorContains(t, err, `OGX_PORT "8080" does not match the Service target port 9000`)
+
+		instance = newInstance(9000, corev1.EnvVar{Name: "OGX_PORT", Value: "9000"})
+		require.NoError(t, validateServerPort(instance, buildContainerSpec(t.Context(), nil, instance, "x:latest")))
+	})
+
+	t.Run("rejects a container port that differs from the Service", func(t *testing.T) {
+		instance := newInstance(9000)
+		container := buildContainerSpec(t.Context(), nil, instance, "x:latest")
+		container.Ports[0].ContainerPort = 8321
+		require.ErrorContains(t, validateServerPort(instance, container),
+			"container port 8321 does not match the Service target port 9000")
+	})
+}
+
+func TestDetermineKindsToExcludeNetworkPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		network *ogxiov1beta1.NetworkSpec
+		exclude bool
+	}{
+		{name: "no network spec", network: nil},
+		{name: "policy not configured", network: &ogxiov1beta1.NetworkSpec{}},
+		{name: "policy enabled", network: &ogxiov1beta1.NetworkSpec{
+			Policy: &ogxiov1beta1.NetworkPolicySpec{Enabled: ptr.To(true)},
+		}},
+		{name: "policy disabled", network: &ogxiov1beta1.NetworkSpec{
+			Policy: &ogxiov1beta1.NetworkPolicySpec{Enabled: ptr.To(false)},
+		}, exclude: true},
+	}
+
+	r := &OGXServerReconciler{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := createTestOGX("", "x:latest")
+			instance.Name = "test"
+			instance.Spec.Network = tt.network
+
+			kinds := r.determineKindsToExclude(t.Context(), instance, "test-pvc", false)
+			assert.Equal(t, tt.exclude, slices.Contains(kinds, "NetworkPolicy"))
+		})
+	}
+}
+
+func TestDetermineKindsToExcludeClusterScopedResources(t *testing.T) {
+	instance := createTestOGX("", "x:latest")
+	instance.Name = "test"
+
+	r := &OGXServerReconciler{}
+	assert.NotContains(t, r.determineKindsToExclude(t.Context(), instance, "test-pvc", false), "RoleBinding",
+		"the SCC RoleBinding should be applied by default")
+
+	r.DisableClusterScopedResources = true
+	assert.Contains(t, r.determineKindsToExclude(t.Context(), instance, "test-pvc", false), "RoleBinding",
+		"the SCC RoleBinding should be skipped with namespace-only RBAC")
+}
+
+func TestDetermineKindsToExcludeIsSorted(t *testing.T) {
+	tests := []struct {
+		name     string
+		workload *ogxiov1beta1.WorkloadSpec
+		network  *ogxiov1beta1.NetworkSpec
+		pvcName  string
+		held     bool
+		noRBAC   bool
+		want     []string
+	}{
+		{
+			name:    "defaults",
+			pvcName: "test-pvc",
+			want:    []string{"HorizontalPodAutoscaler", "PersistentVolumeClaim", "PodDisruptionBudget"},
+		},
+		{
+			name: "storage and autoscaling keep their resources",
+			workload: &ogxiov1beta1.WorkloadSpec{
+				Storage:     &ogxiov1beta1.PVCStorageSpec{},
+				Autoscaling: &ogxiov1beta1.AutoscalingSpec{MaxReplicas: 3},
+			},
+			pvcName: "test-pvc",
+			want:    []string{"PodDisruptionBudget"},
+		},
+		{
+			name: "everything excluded",
+			network: &ogxiov1beta1.NetworkSpec{
+				Policy: &ogxiov1beta1.NetworkPolicySpec{Enabled: ptr.To(false)},
+			},
+			pvcName: "adopted-pvc",
+			held:    true,
+			noRBAC:  true,
+			want: []string{
+				"Deployment", "HorizontalPodAutoscaler", "NetworkPolicy",
+				"PersistentVolumeClaim", "PodDisruptionBudget", "RoleBinding",
 			},
 		},
 	}
-	spec := &corev1.PodSpec{Containers: []corev1.Container{{Name: "c"}}}
-	configurePodOverrides(instance, spec)
-	assert.Equal(t, "custom-sa", spec.ServiceAccountName)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := createTestOGX("", "x:latest")
+			instance.Name = "test"
+			instance.Spec.Workload = tt.workload
+			instance.Spec.Network = tt.network
+			r := &OGXServerReconciler{DisableClusterScopedResources: tt.noRBAC}
+
+			kinds := r.determineKindsToExclude(t.Context(), instance, tt.pvcName, tt.held)
+			assert.Equal(t, tt.want, kinds)
+			for range 5 {
+				assert.Equal(t, kinds, r.determineKindsToExclude(t.Context(), instance, tt.pvcName, tt.held))
+			}
+		})
+	}
+}
+
+func TestProxyEnv(t *testing.T) {
+	newInstance := func(proxy *ogxiov1beta1.ProxySpec) *ogxiov1beta1.OGXServer {
+		instance := createTestOGX("", "x:latest")
+		instance.Name = "test"
+		instance.Namespace = "default"
+		instance.Spec.Network = &ogxiov1beta1.NetworkSpec{Proxy: proxy}
+		return instance
+	}
+	envValue := func(container corev1.Container, name string) (string, bool) {
+		for _, env := range container.Env {
+			if env.Name == name {
+				return env.Value, true
+			}
+		}
+		return "", false
+	}
+
+	t.Run("sets the proxy env vars on the container", func(t *testing.T) {
+		instance := newInstance(&ogxiov1beta1.ProxySpec{
+			HTTPProxy:  "http://proxy.corp:3128",
+			HTTPSProxy: "http://proxy.corp:3129",
+			NoProxy:    "internal.corp, .svc",
+		})
+		container := buildContainerSpec(t.Context(), nil, instance, "x:latest")
+
+		value, _ := envValue(container, "HTTP_PROXY")
+		assert.Equal(t, "http://proxy.corp:3128", value)
+		value, _ = envValue(container, "HTTPS_PROXY")
+		assert.Equal(t, "http://proxy.corp:3129", value)
+		value, _ = envValue(container, "NO_PROXY")
+		assert.Equal(t, "internal.corp,.svc,localhost,127.0.0.1,default.svc,.cluster.local", value,
+			"the in-cluster domains should be added once")
+	})
+
+	t.Run("no proxy env vars without a proxy", func(t *testing.T) {
+		container := buildContainerSpec(t.Context(), nil, newInstance(&ogxiov1beta1.ProxySpec{NoProxy: "internal.corp"}), "x:latest")
+		for _, name := range []string{"HTTP_PROXY", "HTTPS_PROXY", "NO_PROXY"} {
+			_, found := envValue(container, name)
+			assert.False(t, found, name)
+		}
+	})
+
+	t.Run("operator defaults fill the fields the spec leaves empty", func(t *testing.T) {
+		r := &OGXServerReconciler{DefaultProxy: &ogxiov1beta1.ProxySpec{
+			HTTPProxy:  "http://default:3128",
+			HTTPSProxy: "http://default:3128",
+			NoProxy:    "default.corp",
+		}}
+		env := getProxyEnv(t.Context(), r, newInstance(&ogxiov1beta1.ProxySpec{HTTPSProxy: "http://proxy.corp:3129"}))
+		assert.Equal(t, []corev1.EnvVar{
+			{Name: "HTTP_PROXY", Value: "http://default:3128"},
+			{Name: "HTTPS_PROXY", Value: "http://proxy.corp:3129"},
+			{Name: "NO_PROXY", Value: "default.corp,localhost,127.0.0.1,default.svc,.svc,.cluster.local"},
+		}, env)
+	})
+
+	t.Run("user env overrides come after the proxy env vars", func(t *testing.T) {
+		instance := newInstance(&ogxiov1beta1.ProxySpec{HTTPProxy: "http://proxy.corp:3128"})
+		instance.Spec.Workload = &ogxiov1beta1.WorkloadSpec{Overrides: &ogxiov1beta1.WorkloadOverrides{
+			Env: []corev1.EnvVar{{Name: "HTTP_PROXY", Value: "http://other:3128"}},
+		}}
+		container := buildContainerSpec(t.Context(), nil, instance, "x:latest")
+		last := slices.IndexFunc(container.Env, func(env corev1.EnvVar) bool { return env.Value == "http://other:3128" })
+		first := slices.IndexFunc(container.Env, func(env corev1.EnvVar) bool { return env.Value == "http://proxy.corp:3128" })
+		assert.Greater(t, last, first)
+	})
+}
+
+func TestConfigureEphemeralStorage(t *testing.T) {
+	storageVolume := func(t *testing.T, podSpec corev1.PodSpec) corev1.Volume {
+		t.Helper()
+		i := slices.IndexFunc(podSpec.Volumes, func(v corev1.Volume) bool { return v.Name == storageVolumeName })
+		require.NotEqual(t, -1, i, "storage volume should exist")
+		return podSpec.Volumes[i]
+	}
+
+	t.Run("unbounded emptyDir by default", func(t *testing.T) {
+		instance := createTestOGX("", "x:latest")
+		volume := storageVolume(t, configurePodStorage(t.Context(), nil, instance, corev1.Container{}, ""))
+		assert.Equal(t, &corev1.EmptyDirVolumeSource{}, volume.EmptyDir)
+	})
+
+	t.Run("applies the size limit and medium", func(t *testing.T) {
+		instance := createTestOGX("", "x:latest")
+		sizeLimit := resource.MustParse("2Gi")
+		instance.Spec.Workload = &ogxiov1beta1.WorkloadSpec{EphemeralStorage: &ogxiov1beta1.EphemeralStorageSpec{
+			SizeLimit: &sizeLimit,
+			Medium:    corev1.StorageMediumMemory,
+		}}
+
+		volume := storageVolume(t, configurePodStorage(t.Context(), nil, instance, corev1.Container{}, ""))
+		require.NotNil(t, volume.EmptyDir)
+		assert.Equal(t, corev1.StorageMediumMemory, volume.EmptyDir.Medium)
+		require.NotNil(t, volume.EmptyDir.SizeLimit)
+		assert.True(t, volume.EmptyDir.SizeLimit.Equal(sizeLimit))
+	})
+}
+
+func TestConfigurePodScheduling(t *testing.T) {
+	newInstance := func(replicas int32) *ogxiov1beta1.OGXServer {
+		instance := createTestOGX("", "x:latest")
+		instance.Name = "test"
+		instance.Spec.Workload = &ogxiov1beta1.WorkloadSpec{Replicas: ptr.To(replicas)}
+		return instance
+	}
+
+	t.Run("applies user topology spread constraints", func(t *testing.T) {
+		instance := newInstance(1)
+		custom := corev1.TopologySpreadConstraint{
+			MaxSkew:           2,
+			TopologyKey:       "topology.kubernetes.io/zone",
+			WhenUnsatisfiable: corev1.DoNotSchedule,
+		}
+		instance.Spec.Workload.TopologySpreadConstraints = []corev1.TopologySpreadConstraint{custom}
+
+		podSpec := configurePodStorage(t.Context(), nil, instance, corev1.Container{}, "")
+		assert.Equal(t, []corev1.TopologySpreadConstraint{custom}, podSpec.TopologySpreadConstraints)
+	})
+
+	t.Run("spreads multi-replica servers across zones by default", func(t *testing.T) {
+		podSpec := configurePodStorage(t.Context(), nil, newInstance(3), corev1.Container{}, "")
+
+		require.NotEmpty(t, podSpec.TopologySpreadConstraints)
+		assert.Contains(t, podSpec.TopologySpreadConstraints, corev1.TopologySpreadConstraint{
+			MaxSkew:           1,
+			TopologyKey:       "topology.kubernetes.io/zone",
+			WhenUnsatisfiable: corev1.ScheduleAnyway,
+			LabelSelector:     &metav1.LabelSelector{MatchLabels: map[string]string{instanceLabelKey: "test"}},
+		})
+	})
+
+	t.Run("leaves single-replica servers unconstrained", func(t *testing.T) {
+		podSpec := configurePodStorage(t.Context(), nil, newInstance(1), corev1.Container{}, "")
+		assert.Empty(t, podSpec.TopologySpreadConstraints)
+	})
 }
 
 func TestNeedsPodDisruptionBudget(t *testing.T) {
@@ -208,9 +1822,124 @@ func TestNeedsPodDisruptionBudget(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.want, needsPodDisruptionBudget(tt.instance))
+			assert.Equal(t, tt.want, deploy.NeedsPodDisruptionBudget(tt.instance))
+		})
+	}
+}
+
+func TestIsSuspended(t *testing.T) {
+	tests := []struct {
+		name     string
+		workload *ogxiov1beta1.WorkloadSpec
+		want     bool
+	}{
+		{"default replicas", nil, false},
+		{"single replica", &ogxiov1beta1.WorkloadSpec{Replicas: int32Ptr(1)}, false},
+		{"zero replicas", &ogxiov1beta1.WorkloadSpec{Replicas: int32Ptr(0)}, true},
+		{
+			"zero replicas with autoscaling",
+			&ogxiov1beta1.WorkloadSpec{
+				Replicas:    int32Ptr(0),
+				Autoscaling: &ogxiov1beta1.AutoscalingSpec{MaxReplicas: 3},
+			},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &ogxiov1beta1.OGXServer{Spec: ogxiov1beta1.OGXServerSpec{Workload: tt.workload}}
+			assert.Equal(t, tt.want, isSuspended(instance))
+		})
+	}
+}
+
+func TestIsNamespaceTerminatingError(t *testing.T) {
+	gvr := schema.GroupResource{Resource: "configmaps"}
+	forbidden := k8serrors.NewForbidden(gvr, "cm", errors.New("denied"))
+	terminating := &k8serrors.StatusError{ErrStatus: metav1.Status{
+		Status: metav1.StatusFailure,
+		Code:   http.StatusForbidden,
+		Reason: metav1.StatusReasonForbidden,
+		Details: &metav1.StatusDetails{Causes: []metav1.StatusCause{{
+			Type: corev1.NamespaceTerminatingCause,
+		}}},
+	}}
+
+	tests := []struct {
+		name       string
+		phase      corev1.NamespacePhase
+		err        error
+		want       bool
+		wantLookup bool
+	}{
+		{"terminating cause", corev1.NamespaceActive, fmt.Errorf("failed to create: %w", terminating), true, false},
+		{"forbidden in terminating namespace", corev1.NamespaceTerminating, forbidden, true, true},
+		{"forbidden in active namespace", corev1.NamespaceActive, forbidden, false, true},
+		{"other error", corev1.NamespaceTerminating, errors.New("boom"), false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ns := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Status:     corev1.NamespaceStatus{Phase: tt.phase},
+			}
+			lookedUp := false
+			r := &OGXServerReconciler{Client: fake.NewClientBuilder().WithObjects(ns).WithInterceptorFuncs(interceptor.Funcs{
+				Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					lookedUp = true
+					return c.Get(ctx, key, obj, opts...)
+				},
+			}).Build()}
+
+			assert.Equal(t, tt.want, r.isNamespaceTerminatingError(t.Context(), "default", tt.err))
+			assert.Equal(t, tt.wantLookup, lookedUp)
 		})
 	}
+
+	t.Run("forbidden with the namespace not found", func(t *testing.T) {
+		r := &OGXServerReconciler{Client: fake.NewClientBuilder().Build()}
+		assert.False(t, r.isNamespaceTerminatingError(t.Context(), "default", forbidden))
+	})
+}
+
+func TestClearNamespaceTerminating(t *testing.T) {
+	newTerminatingInstance := func() *ogxiov1beta1.OGXServer {
+		instance := &ogxiov1beta1.OGXServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+		SetNamespaceTerminatingCondition(&instance.Status, true)
+		return instance
+	}
+	newReconciler := func(phase corev1.NamespacePhase) *OGXServerReconciler {
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Status:     corev1.NamespaceStatus{Phase: phase},
+		}
+		return &OGXServerReconciler{Client: fake.NewClientBuilder().WithObjects(ns).Build()}
+	}
+
+	t.Run("clears the condition in an active namespace", func(t *testing.T) {
+		instance := newTerminatingInstance()
+		newReconciler(corev1.NamespaceActive).clearNamespaceTerminating(t.Context(), instance)
+		assert.True(t, IsConditionFalse(&instance.Status, ConditionTypeNamespaceTerminating))
+	})
+
+	t.Run("keeps the condition in a terminating namespace", func(t *testing.T) {
+		instance := newTerminatingInstance()
+		newReconciler(corev1.NamespaceTerminating).clearNamespaceTerminating(t.Context(), instance)
+		assert.True(t, IsConditionTrue(&instance.Status, ConditionTypeNamespaceTerminating))
+	})
+
+	t.Run("keeps the condition when the namespace lookup fails", func(t *testing.T) {
+		instance := newTerminatingInstance()
+		r := &OGXServerReconciler{Client: fake.NewClientBuilder().Build()}
+		r.clearNamespaceTerminating(t.Context(), instance)
+		assert.True(t, IsConditionTrue(&instance.Status, ConditionTypeNamespaceTerminating))
+	})
+
+	t.Run("leaves instances without the condition alone", func(t *testing.T) {
+		instance := &ogxiov1beta1.OGXServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+		newReconciler(corev1.NamespaceActive).clearNamespaceTerminating(t.Context(), instance)
+		assert.Nil(t, GetCondition(&instance.Status, ConditionTypeNamespaceTerminating))
+	})
 }
 
 func TestBuildPodDisruptionBudgetSpec(t *testing.T) {
@@ -0,0 +1,224 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// DefaultRolloutHealthWindow is how long after a rollout starts provider
+	// errors trigger a rollback when the health gate sets no window.
+	DefaultRolloutHealthWindow = 10 * time.Minute
+
+	// rolledBackGenerationAnnotation records on the Deployment the OGXServer
+	// generation whose rollout was rolled back. While it matches, the operator
+	// does not re-apply the Deployment.
+	rolledBackGenerationAnnotation = "ogx.io/rolled-back-generation"
+	// rolledBackRestartedAtAnnotation records on the Deployment the OGXServer's
+	// restarted-at annotation at the time of the rollback. Setting a new
+	// restarted-at value ends the hold without a spec change.
+	rolledBackRestartedAtAnnotation = "ogx.io/rolled-back-restarted-at"
+	// deploymentRevisionAnnotation is set by the Deployment controller on
+	// Deployments and their ReplicaSets.
+	deploymentRevisionAnnotation = "deployment.kubernetes.io/revision"
+)
+
+// rolloutHealthWindow returns the health gate window, or 0 when the gate is off.
+func rolloutHealthWindow(instance *ogxiov1beta1.OGXServer) time.Duration {
+	if instance.Spec.Workload == nil || instance.Spec.Workload.RolloutHealthGate == nil {
+		return 0
+	}
+	window := instance.Spec.Workload.RolloutHealthGate.Window
+	if window == nil || window.Duration <= 0 {
+		return DefaultRolloutHealthWindow
+	}
+	return window.Duration
+}
+
+// isRolledBack reports whether deployment was rolled back for the current
+// generation and restarted-at annotation of instance.
+func isRolledBack(instance *ogxiov1beta1.OGXServer, deployment *appsv1.Deployment) bool {
+	return deployment.Annotations[rolledBackGenerationAnnotation] == strconv.FormatInt(instance.Generation, 10) &&
+		deployment.Annotations[rolledBackRestartedAtAnnotation] == instance.Annotations[ogxiov1beta1.RestartedAtAnnotation]
+}
+
+// isRolloutHeld reports whether the Deployment must be left at the revision it
+// was rolled back to. The hold ends when the spec changes, the restarted-at
+// annotation is set to a new value or the gate is removed.
+func (r *OGXServerReconciler) isRolloutHeld(ctx context.Context, instance *ogxiov1beta1.OGXServer) (bool, error) {
+	if rolloutHealthWindow(instance) == 0 {
+		return false, nil
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := r.Get(ctx, types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}, deployment); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get deployment: %w", err)
+	}
+	return isRolledBack(instance, deployment), nil
+}
+
+// revisionOf returns the rollout revision recorded on obj, or 0 if unset.
+func revisionOf(obj metav1.Object) int64 {
+	revision, err := strconv.ParseInt(obj.GetAnnotations()[deploymentRevisionAnnotation], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return revision
+}
+
+// findRolloutReplicaSets returns the ReplicaSet of the current revision and the
+// one of the highest earlier revision, if any.
+func findRolloutReplicaSets(deployment *appsv1.Deployment, replicaSets []appsv1.ReplicaSet) (*appsv1.ReplicaSet, *appsv1.ReplicaSet) {
+	var current, previous *appsv1.ReplicaSet
+	currentRevision := revisionOf(deployment)
+	for i := range replicaSets {
+		rs := &replicaSets[i]
+		if !metav1.IsControlledBy(rs, deployment) {
+			continue
+		}
+		revision := revisionOf(rs)
+		switch {
+		case revision == currentRevision:
+			current = rs
+		case revision < currentRevision && (previous == nil || revision > revisionOf(previous)):
+			previous = rs
+		}
+	}
+	return current, previous
+}
+
+// gateRolloutOnProviderHealth rolls the Deployment back to the previous revision
+// when providers keep reporting errors within the health gate window after a
+// rollout. It must run after updateProviderHealth. Failures are logged, as the
+// gate is re-evaluated on the next reconcile.
+func (r *OGXServerReconciler) gateRolloutOnProviderHealth(ctx context.Context, instance *ogxiov1beta1.OGXServer) {
+	logger := log.FromContext(ctx)
+
+	window := rolloutHealthWindow(instance)
+	if window == 0 {
+		if GetCondition(&instance.Status, ConditionTypeRolloutHealthy) != nil {
+			SetRolloutHealthyCondition(&instance.Status, true, "", "")
+		}
+		return
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := r.Get(ctx, types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}, deployment); err != nil {
+		logger.Error(err, "failed to get deployment for rollout health gate")
+		return
+	}
+	if isRolledBack(instance, deployment) {
+		return
+	}
+
+	replicaSets := &appsv1.ReplicaSetList{}
+	if err := r.directList(ctx, replicaSets,
+		client.InNamespace(instance.Namespace),
+		client.MatchingLabels{instanceLabelKey: instance.Name},
+	); err != nil {
+		logger.Error(err, "failed to list replica sets for rollout health gate")
+		return
+	}
+	current, previous := findRolloutReplicaSets(deployment, replicaSets.Items)
+	if current == nil {
+		return
+	}
+
+	deadline := current.CreationTimestamp.Add(window)
+	previousCondition := GetCondition(&instance.Status, ConditionTypeRolloutHealthy)
+	providers := GetCondition(&instance.Status, ConditionTypeProvidersHealthy)
+	degraded := providers != nil && providers.Reason == ReasonProvidersDegraded
+
+	switch {
+	case !degraded && time.Now().After(deadline):
+		if previousCondition != nil && previousCondition.Reason == ReasonRolloutVerifying {
+			r.recordEvent(instance, corev1.EventTypeNormal, ReasonRolloutHealthy,
+				fmt.Sprintf("Revision %d passed the provider health gate", revisionOf(current)))
+		}
+		SetRolloutHealthyCondition(&instance.Status, true, "", "")
+	case !degraded:
+		SetRolloutHealthyCondition(&instance.Status, false, ReasonRolloutVerifying,
+			fmt.Sprintf("Watching providers of revision %d until %s", revisionOf(current), deadline.UTC().Format(time.RFC3339)))
+	case time.Now().After(deadline):
+		// Errors that start after the window are reported by ProvidersHealthy only.
+		if previousCondition == nil || previousCondition.Reason == ReasonRolloutVerifying {
+			SetRolloutHealthyCondition(&instance.Status, true, "", "")
+		}
+	case previous == nil:
+		msg := fmt.Sprintf("Providers of revision %d report errors and there is no previous revision to roll back to: %s",
+			revisionOf(current), providers.Message)
+		if previousCondition == nil || previousCondition.Reason != ReasonRolloutRollbackUnavailable {
+			r.recordEvent(instance, corev1.EventTypeWarning, ReasonRolloutRollbackUnavailable, msg)
+		}
+		SetRolloutHealthyCondition(&instance.Status, false, ReasonRolloutRollbackUnavailable, msg)
+	default:
+		if err := r.rollBackDeployment(ctx, instance, deployment, previous); err != nil {
+			logger.Error(err, "failed to roll back deployment")
+			return
+		}
+		msg := fmt.Sprintf("Rolled back from revision %d to revision %d after provider errors; "+
+			"update the spec or set a new %s annotation to retry: %s",
+			revisionOf(current), revisionOf(previous), ogxiov1beta1.RestartedAtAnnotation, providers.Message)
+		logger.Info("Rolled back deployment after provider errors",
+			"fromRevision", revisionOf(current), "toRevision", revisionOf(previous))
+		r.recordEvent(instance, corev1.EventTypeWarning, ReasonRolloutRolledBack, msg)
+		SetRolloutHealthyCondition(&instance.Status, false, ReasonRolloutRolledBack, msg)
+	}
+}
+
+// rollBackDeployment restores the pod template of previous on deployment, as
+// `kubectl rollout undo` does, and marks the current generation as rolled back.
+func (r *OGXServerReconciler) rollBackDeployment(ctx context.Context, instance *ogxiov1beta1.OGXServer,
+	deployment *appsv1.Deployment, previous *appsv1.ReplicaSet) error {
+	template := previous.Spec.Template.DeepCopy()
+	delete(template.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+	deployment.Spec.Template = *template
+
+	if deployment.Annotations == nil {
+		deployment.Annotations = make(map[string]string)
+	}
+	deployment.Annotations[rolledBackGenerationAnnotation] = strconv.FormatInt(instance.Generation, 10)
+	deployment.Annotations[rolledBackRestartedAtAnnotation] = instance.Annotations[ogxiov1beta1.RestartedAtAnnotation]
+
+	if err := r.Update(ctx, deployment); err != nil {
+		return fmt.Errorf("failed to update deployment: %w", err)
+	}
+	return nil
+}
+
+// recordEvent emits an Event on instance when an event recorder is configured.
+func (r *OGXServerReconciler) recordEvent(instance *ogxiov1beta1.OGXServer, eventType, reason, message string) {
+	if r.Recorder != nil {
+		r.Recorder.Event(instance, eventType, reason, message)
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+func TestRolloutHealthWindow(t *testing.T) {
+	withGate := func(gate *ogxiov1beta1.RolloutHealthGateSpec) *ogxiov1beta1.OGXServer {
+		return &ogxiov1beta1.OGXServer{
+			Spec: ogxiov1beta1.OGXServerSpec{
+				Workload: &ogxiov1beta1.WorkloadSpec{RolloutHealthGate: gate},
+			},
+		}
+	}
+
+	assert.Zero(t, rolloutHealthWindow(&ogxiov1beta1.OGXServer{}), "gate is off without a workload")
+	assert.Zero(t, rolloutHealthWindow(withGate(nil)), "gate is off when unset")
+	assert.Equal(t, DefaultRolloutHealthWindow, rolloutHealthWindow(withGate(&ogxiov1beta1.RolloutHealthGateSpec{})))
+	assert.Equal(t, DefaultRolloutHealthWindow, rolloutHealthWindow(withGate(&ogxiov1beta1.RolloutHealthGateSpec{
+		Window: &metav1.Duration{},
+	})))
+	assert.Equal(t, 3*time.Minute, rolloutHealthWindow(withGate(&ogxiov1beta1.RolloutHealthGateSpec{
+		Window: &metav1.Duration{Duration: 3 * time.Minute},
+	})))
+}
+
+func TestFindRolloutReplicaSets(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test",
+			UID:         types.UID("deployment-uid"),
+			Annotations: map[string]string{deploymentRevisionAnnotation: "4"},
+		},
+	}
+	newReplicaSet := func(name, revision string, owner types.UID) appsv1.ReplicaSet {
+		return appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Annotations: map[string]string{deploymentRevisionAnnotation: revision},
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       "test",
+					UID:        owner,
+					Controller: ptr.To(true),
+				}},
+			},
+		}
+	}
+
+	t.Run("picks the current and highest earlier revision", func(t *testing.T) {
+		current, previous := findRolloutReplicaSets(deployment, []appsv1.ReplicaSet{
+			newReplicaSet("rev-1", "1", deployment.UID),
+			newReplicaSet("rev-4", "4", deployment.UID),
+			newReplicaSet("rev-3", "3", deployment.UID),
+			newReplicaSet("foreign-rev-3", "3", types.UID("other")),
+		})
+
+		require.NotNil(t, current)
+		assert.Equal(t, "rev-4", current.Name)
+		require.NotNil(t, previous)
+		assert.Equal(t, "rev-3", previous.Name)
+	})
+
+	t.Run("ignores replica sets of other deployments", func(t *testing.T) {
+		current, previous := findRolloutReplicaSets(deployment, []appsv1.ReplicaSet{
+			newReplicaSet("rev-4", "4", deployment.UID),
+			newReplicaSet("foreign-rev-3", "3", types.UID("other")),
+		})
+
+		require.NotNil(t, current)
+		assert.Nil(t, previous, "the first revision has nothing to roll back to")
+	})
+}
+
+func TestIsRolledBack(t *testing.T) {
+	instance := &ogxiov1beta1.OGXServer{ObjectMeta: metav1.ObjectMeta{Generation: 3}}
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+		Annotations: map[string]string{rolledBackGenerationAnnotation: "3"},
+	}}
+
+	assert.True(t, isRolledBack(instance, deployment))
+
+	restarted := instance.DeepCopy()
+	restarted.Annotations = map[string]string{ogxiov1beta1.RestartedAtAnnotation: "2025-01-01T00:00:00Z"}
+	assert.False(t, isRolledBack(restarted, deployment), "a new restarted-at value ends the hold")
+	deployment.Annotations[rolledBackRestartedAtAnnotation] = "2025-01-01T00:00:00Z"
+	assert.True(t, isRolledBack(restarted, deployment), "the restarted-at value at the rollback keeps the hold")
+
+	restarted.Generation = 4
+	assert.False(t, isRolledBack(restarted, deployment), "a spec change ends the hold")
+	assert.False(t, isRolledBack(instance, &appsv1.Deployment{}))
+}
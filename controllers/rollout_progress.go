@@ -0,0 +1,82 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// maxWaitingReasons bounds the number of container waiting reasons reported in
+// the DeploymentReady condition message.
+const maxWaitingReasons = 5
+
+// deploymentStallMessage returns why the rollout of deployment cannot progress,
+// such as an exceeded progress deadline, or an empty string while it progresses.
+func deploymentStallMessage(deployment *appsv1.Deployment) string {
+	for _, condition := range deployment.Status.Conditions {
+		stalled := (condition.Type == appsv1.DeploymentProgressing && condition.Status == corev1.ConditionFalse) ||
+			(condition.Type == appsv1.DeploymentReplicaFailure && condition.Status == corev1.ConditionTrue)
+		if stalled {
+			return fmt.Sprintf("%s: %s", condition.Reason, condition.Message)
+		}
+	}
+	return ""
+}
+
+// podWaitingReasons returns the waiting reasons of the containers of pods that
+// are not Ready, such as "pod/container: ImagePullBackOff", sorted and capped
+// at maxWaitingReasons.
+func podWaitingReasons(pods []corev1.Pod) []string {
+	var reasons []string
+	for i := range pods {
+		pod := &pods[i]
+		if isPodReady(pod) {
+			continue
+		}
+		statuses := slices.Concat(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses)
+		for _, status := range statuses {
+			if status.State.Waiting == nil || status.State.Waiting.Reason == "" {
+				continue
+			}
+			reasons = append(reasons, fmt.Sprintf("%s/%s: %s", pod.Name, status.Name, status.State.Waiting.Reason))
+		}
+	}
+	slices.Sort(reasons)
+	if len(reasons) > maxWaitingReasons {
+		reasons = reasons[:maxWaitingReasons]
+	}
+	return reasons
+}
+
+// describeRolloutProblems appends the rollout stall reason and the waiting
+// reasons of unavailable pods to message, so that a rollout that does not
+// become ready reports its cause.
+func describeRolloutProblems(message string, deployment *appsv1.Deployment, pods []corev1.Pod) string {
+	parts := []string{message}
+	if stall := deploymentStallMessage(deployment); stall != "" {
+		parts = append(parts, "rollout stalled: "+stall)
+	}
+	if reasons := podWaitingReasons(pods); len(reasons) > 0 {
+		parts = append(parts, "waiting containers: "+strings.Join(reasons, ", "))
+	}
+	return strings.Join(parts, "; ")
+}
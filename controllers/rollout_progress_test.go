@@ -0,0 +1,124 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDeploymentStallMessage(t *testing.T) {
+	withConditions := func(conditions ...appsv1.DeploymentCondition) *appsv1.Deployment {
+		return &appsv1.Deployment{Status: appsv1.DeploymentStatus{Conditions: conditions}}
+	}
+
+	assert.Empty(t, deploymentStallMessage(withConditions()))
+	assert.Empty(t, deploymentStallMessage(withConditions(appsv1.DeploymentCondition{
+		Type:   appsv1.DeploymentProgressing,
+		Status: corev1.ConditionTrue,
+		Reason: "ReplicaSetUpdated",
+	})), "a progressing rollout is not stalled")
+	assert.Equal(t, `ProgressDeadlineExceeded: ReplicaSet "test-5d8f" has timed out progressing.`,
+		deploymentStallMessage(withConditions(
+			appsv1.DeploymentCondition{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionFalse},
+			appsv1.DeploymentCondition{
+				Type:    appsv1.DeploymentProgressing,
+				Status:  corev1.ConditionFalse,
+				Reason:  "ProgressDeadlineExceeded",
+				Message: `ReplicaSet "test-5d8f" has timed out progressing.`,
+			},
+		)))
+	assert.Equal(t, "FailedCreate: exceeded quota",
+		deploymentStallMessage(withConditions(appsv1.DeploymentCondition{
+			Type:    appsv1.DeploymentReplicaFailure,
+			Status:  corev1.ConditionTrue,
+			Reason:  "FailedCreate",
+			Message: "exceeded quota",
+		})))
+}
+
+func TestPodWaitingReasons(t *testing.T) {
+	newPod := func(name string, ready bool, statuses ...corev1.ContainerStatus) corev1.Pod {
+		readyStatus := corev1.ConditionFalse
+		if ready {
+			readyStatus = corev1.ConditionTrue
+		}
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: corev1.PodStatus{
+				Conditions:        []corev1.PodCondition{{Type: corev1.PodReady, Status: readyStatus}},
+				ContainerStatuses: statuses,
+			},
+		}
+	}
+	waiting := func(container, reason string) corev1.ContainerStatus {
+		return corev1.ContainerStatus{
+			Name:  container,
+			State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: reason}},
+		}
+	}
+
+	t.Run("reports waiting containers of unavailable pods", func(t *testing.T) {
+		pods := []corev1.Pod{
+			newPod("server-b", false, waiting("ogx", "CrashLoopBackOff")),
+			newPod("server-a", false, waiting("ogx", "ImagePullBackOff"),
+				corev1.ContainerStatus{Name: "log-forwarder", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}}),
+			newPod("server-c", true, waiting("log-forwarder", "ContainerCreating")),
+		}
+		pods[1].Status.InitContainerStatuses = []corev1.ContainerStatus{waiting("download-model", "ErrImagePull")}
+
+		assert.Equal(t, []string{
+			"server-a/download-model: ErrImagePull",
+			"server-a/ogx: ImagePullBackOff",
+			"server-b/ogx: CrashLoopBackOff",
+		}, podWaitingReasons(pods))
+	})
+
+	t.Run("caps the reported reasons", func(t *testing.T) {
+		var pods []corev1.Pod
+		for i := range maxWaitingReasons + 2 {
+			pods = append(pods, newPod(fmt.Sprintf("server-%d", i), false, waiting("ogx", "ImagePullBackOff")))
+		}
+		assert.Len(t, podWaitingReasons(pods), maxWaitingReasons)
+	})
+}
+
+func TestDescribeRolloutProblems(t *testing.T) {
+	deployment := &appsv1.Deployment{Status: appsv1.DeploymentStatus{Conditions: []appsv1.DeploymentCondition{{
+		Type:    appsv1.DeploymentProgressing,
+		Status:  corev1.ConditionFalse,
+		Reason:  "ProgressDeadlineExceeded",
+		Message: "timed out",
+	}}}}
+	pods := []corev1.Pod{{
+		ObjectMeta: metav1.ObjectMeta{Name: "server-a"},
+		Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{
+			Name:  "ogx",
+			State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}},
+		}}},
+	}}
+
+	assert.Equal(t, MessageDeploymentPending, describeRolloutProblems(MessageDeploymentPending, &appsv1.Deployment{}, nil))
+	assert.Equal(t, "Deployment is pending; rollout stalled: ProgressDeadlineExceeded: timed out; "+
+		"waiting containers: server-a/ogx: ImagePullBackOff",
+		describeRolloutProblems(MessageDeploymentPending, deployment, pods))
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"regexp"
+	"slices"
+
+	"github.com/blang/semver/v4"
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// ServerCapability names a server behavior that depends on the OGX server version.
+type ServerCapability string
+
+const (
+	// CapabilityCoreServerModule means the server entrypoint lives at ogx.core.server.server.
+	CapabilityCoreServerModule ServerCapability = "CoreServerModule"
+	// CapabilityUvicornCLI means the server is started through the uvicorn CLI.
+	CapabilityUvicornCLI ServerCapability = "UvicornCLI"
+	// CapabilityMultipleWorkers means the server honors OGX_WORKERS values above 1.
+	CapabilityMultipleWorkers ServerCapability = "MultipleWorkers"
+)
+
+const (
+	// legacyServerModule is the server entrypoint of versions before 0.2.17.
+	legacyServerModule = "ogx.distribution.server.server"
+	// coreServerModule is the server entrypoint from version 0.2.17.
+	coreServerModule = "ogx.core.server.server"
+)
+
+// serverCapabilityMinVersions maps each capability to the first server version
+// that supports it. The thresholds are the OGX releases that changed how the
+// server starts, and are the same ones startupScript branches on at runtime:
+// 0.2.17 moved the entrypoint from ogx.distribution.server.server to
+// ogx.core.server.server, and 0.3.0 started it through the uvicorn CLI, whose
+// --workers flag is what makes OGX_WORKERS take effect. Update this map and
+// startupScript together when a release changes the startup contract.
+var serverCapabilityMinVersions = map[ServerCapability]semver.Version{
+	CapabilityCoreServerModule: semver.MustParse("0.2.17"),
+	CapabilityUvicornCLI:       semver.MustParse("0.3.0"),
+	CapabilityMultipleWorkers:  semver.MustParse("0.3.0"),
+}
+
+// serverVersionPattern matches the numeric release prefix of a server version.
+var serverVersionPattern = regexp.MustCompile(`^v?(\d+)\.(\d+)(?:\.(\d+))?`)
+
+// parseServerVersion parses a server version such as "0.3.0", "v0.3.0" or "0.3.0rc2".
+// Pre-release and build suffixes are ignored, matching the startup script's use
+// of the base version.
+func parseServerVersion(version string) (semver.Version, bool) {
+	match := serverVersionPattern.FindStringSubmatch(version)
+	if match == nil {
+		return semver.Version{}, false
+	}
+	if match[3] == "" {
+		match[3] = "0"
+	}
+	v, err := semver.Parse(match[1] + "." + match[2] + "." + match[3])
+	if err != nil {
+		return semver.Version{}, false
+	}
+	return v, true
+}
+
+// serverCapabilities returns the sorted capabilities enabled for version, or nil
+// when the version is unknown.
+func serverCapabilities(version string) []string {
+	v, ok := parseServerVersion(version)
+	if !ok {
+		return nil
+	}
+	var capabilities []string
+	for capability, minVersion := range serverCapabilityMinVersions {
+		if v.GTE(minVersion) {
+			capabilities = append(capabilities, string(capability))
+		}
+	}
+	slices.Sort(capabilities)
+	return capabilities
+}
+
+// supportsServerCapability reports whether version supports capability. Unknown
+// versions are assumed to support everything, since distribution images default
+// to the latest release.
+func supportsServerCapability(version string, capability ServerCapability) bool {
+	v, ok := parseServerVersion(version)
+	if !ok {
+		return true
+	}
+	return v.GTE(serverCapabilityMinVersions[capability])
+}
+
+// serverModule returns the module path that starts the server of version, or an
+// empty string when the version is unknown.
+func serverModule(version string) string {
+	if _, ok := parseServerVersion(version); !ok {
+		return ""
+	}
+	if supportsServerCapability(version, CapabilityCoreServerModule) {
+		return coreServerModule
+	}
+	return legacyServerModule
+}
+
+// serverCommand returns the command that starts the server of version, mirroring
+// the branches of startupScript, or nil when the version is unknown. Arguments
+// reference the container environment through Kubernetes $(VAR) expansion.
+func serverCommand(version string) []string {
+	switch {
+	case serverModule(version) == "":
+		return nil
+	case !supportsServerCapability(version, CapabilityCoreServerModule):
+		return []string{"python3", "-m", legacyServerModule, "--config", "$(OGX_CONFIG)"}
+	case !supportsServerCapability(version, CapabilityUvicornCLI):
+		return []string{"python3", "-m", coreServerModule, "$(OGX_CONFIG)"}
+	default:
+		return uvicornServerCommand()
+	}
+}
+
+// uvicornServerCommand returns the command that starts the server through the
+// uvicorn CLI, used from version 0.3.0.
+func uvicornServerCommand() []string {
+	return []string{"uvicorn", coreServerModule + ":create_app", "--host", "0.0.0.0",
+		"--port", "$(OGX_PORT)", "--workers", "$(OGX_WORKERS)", "--factory"}
+}
+
+// updateServerCapabilities records the enabled capabilities and server module
+// for spec.distribution.version, the version the operator renders the pod
+// for, and warns about settings that version does not support.
+func (r *OGXServerReconciler) updateServerCapabilities(ctx context.Context, instance *ogxiov1beta1.OGXServer) {
+	version := instance.Spec.Distribution.Version
+	instance.Status.Version.Capabilities = serverCapabilities(version)
+	instance.Status.Version.ServerModule = serverModule(version)
+
+	if workers, set := getEffectiveWorkers(instance); set && workers > 1 &&
+		!supportsServerCapability(version, CapabilityMultipleWorkers) {
+		log.FromContext(ctx).Info("Server version does not support multiple workers, running a single worker",
+			"serverVersion", version, "workers", workers)
+	}
+}
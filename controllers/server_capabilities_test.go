@@ -0,0 +1,121 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestServerCapabilities(t *testing.T) {
+	tests := []struct {
+		version string
+		want    []string
+	}{
+		{version: "0.2.10", want: []string{}},
+		{version: "0.2.17", want: []string{"CoreServerModule"}},
+		{version: "0.3.0rc2", want: []string{"CoreServerModule", "MultipleWorkers", "UvicornCLI"}},
+		{version: "v0.4.1", want: []string{"CoreServerModule", "MultipleWorkers", "UvicornCLI"}},
+		{version: "latest", want: nil},
+		{version: "", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			got := serverCapabilities(tt.version)
+			if tt.want == nil {
+				assert.Nil(t, got)
+				return
+			}
+			assert.ElementsMatch(t, tt.want, got)
+		})
+	}
+}
+
+func TestSupportsServerCapability(t *testing.T) {
+	assert.False(t, supportsServerCapability("0.2.20", CapabilityMultipleWorkers))
+	assert.True(t, supportsServerCapability("0.3.0", CapabilityMultipleWorkers))
+	assert.True(t, supportsServerCapability("", CapabilityMultipleWorkers),
+		"unknown versions are assumed to support every capability")
+}
+
+func TestUpdateServerCapabilities(t *testing.T) {
+	r := &OGXServerReconciler{}
+
+	instance := createTestOGX("", "quay.io/ogx/starter:0.2.18")
+	instance.Status.Version = ogxiov1beta1.VersionInfo{ServerVersion: "0.3.2"}
+	r.updateServerCapabilities(t.Context(), instance)
+	assert.Nil(t, instance.Status.Version.Capabilities, "neither the image tag nor the reported version should be used")
+	assert.Empty(t, instance.Status.Version.ServerModule)
+
+	instance.Spec.Distribution.Version = "0.2.18"
+	r.updateServerCapabilities(t.Context(), instance)
+	assert.Equal(t, []string{"CoreServerModule"}, instance.Status.Version.Capabilities)
+	assert.Equal(t, "ogx.core.server.server", instance.Status.Version.ServerModule,
+		"capabilities and server module should come from the same version")
+}
+
+func TestServerCommand(t *testing.T) {
+	tests := []struct {
+		version    string
+		wantModule string
+		want       []string
+	}{
+		{
+			version:    "0.2.16",
+			wantModule: "ogx.distribution.server.server",
+			want:       []string{"python3", "-m", "ogx.distribution.server.server", "--config", "$(OGX_CONFIG)"},
+		},
+		{
+			version:    "0.2.18",
+			wantModule: "ogx.core.server.server",
+			want:       []string{"python3", "-m", "ogx.core.server.server", "$(OGX_CONFIG)"},
+		},
+		{
+			version:    "0.3.1",
+			wantModule: "ogx.core.server.server",
+			want: []string{"uvicorn", "ogx.core.server.server:create_app", "--host", "0.0.0.0",
+				"--port", "$(OGX_PORT)", "--workers", "$(OGX_WORKERS)", "--factory"},
+		},
+		{version: "", wantModule: "", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			assert.Equal(t, tt.wantModule, serverModule(tt.version))
+			assert.Equal(t, tt.want, serverCommand(tt.version))
+		})
+	}
+}
+
+func TestConfigureContainerCommands_DistributionVersion(t *testing.T) {
+	instance := createTestOGX("", "quay.io/ogx/starter:latest")
+	instance.Spec.OverrideConfig = &ogxiov1beta1.ConfigMapKeyRef{Name: "user-config"}
+
+	container := corev1.Container{}
+	configureContainerCommands(t.Context(), nil, instance, &container)
+	assert.Equal(t, []string{"/bin/sh", "-c", startupScript}, container.Command, "the script detects unset versions")
+
+	instance.Spec.Distribution.Version = "0.2.18"
+	container = corev1.Container{}
+	configureContainerCommands(t.Context(), nil, instance, &container)
+	assert.Equal(t, []string{"python3", "-m", "ogx.core.server.server", "$(OGX_CONFIG)"}, container.Command)
+	assert.Empty(t, container.Args)
+}
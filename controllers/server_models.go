@@ -0,0 +1,93 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// getModelsInfo makes an HTTP request to the models endpoint.
+func (r *OGXServerReconciler) getModelsInfo(ctx context.Context, instance *ogxiov1beta1.OGXServer) ([]ogxiov1beta1.ModelInfo, error) {
+	u := r.getServerURL(instance, getAPIPath(instance, "models"))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create models request: %w", err)
+	}
+
+	if err := r.waitForServerQuery(ctx); err != nil {
+		return nil, err
+	}
+	httpClient, err := r.serverHTTPClient(ctx, instance)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make models request: %w", err)
+	}
+	// Close error after successful read is not actionable; anon func required to explicitly discard return value
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to query models endpoint: returned status code %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read models response: %w", err)
+	}
+
+	var response struct {
+		Data []struct {
+			Identifier string `json:"identifier"`
+			ProviderID string `json:"provider_id"`
+			ModelType  string `json:"model_type"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal models response: %w", err)
+	}
+
+	models := make([]ogxiov1beta1.ModelInfo, 0, len(response.Data))
+	for _, model := range response.Data {
+		models = append(models, ogxiov1beta1.ModelInfo{
+			Identifier: model.Identifier,
+			ProviderID: model.ProviderID,
+			ModelType:  model.ModelType,
+		})
+	}
+	return models, nil
+}
+
+// refreshModels records the models the server exposes in the status. When the
+// server cannot be queried, the last-known model list is kept.
+func (r *OGXServerReconciler) refreshModels(ctx context.Context, instance *ogxiov1beta1.OGXServer) {
+	models, err := r.getModelsInfo(ctx, instance)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "failed to get models from API endpoint, keeping the last-known model list")
+		return
+	}
+	instance.Status.DistributionConfig.Models = models
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRefreshModels(t *testing.T) {
+	const models = `{"data": [
+		{"identifier": "llama3.2:3b", "provider_id": "ollama", "provider_resource_id": "llama3.2:3b", "model_type": "llm"},
+		{"identifier": "all-MiniLM-L6-v2", "provider_id": "sentence-transformers", "model_type": "embedding"}
+	]}`
+
+	newReconciler := func(status int, body string) *OGXServerReconciler {
+		return &OGXServerReconciler{
+			httpClient: &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				if req.URL.Path != "/v1/models" {
+					return &http.Response{StatusCode: http.StatusNotFound, Body: http.NoBody}, nil
+				}
+				return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(body))}, nil
+			})},
+		}
+	}
+	newInstance := func() *ogxiov1beta1.OGXServer {
+		return &ogxiov1beta1.OGXServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Status:     ogxiov1beta1.OGXServerStatus{Phase: ogxiov1beta1.OGXServerPhaseReady},
+		}
+	}
+
+	t.Run("records the models the server exposes", func(t *testing.T) {
+		instance := newInstance()
+
+		newReconciler(http.StatusOK, models).refreshModels(t.Context(), instance)
+
+		assert.Equal(t, []ogxiov1beta1.ModelInfo{
+			{Identifier: "llama3.2:3b", ProviderID: "ollama", ModelType: "llm"},
+			{Identifier: "all-MiniLM-L6-v2", ProviderID: "sentence-transformers", ModelType: "embedding"},
+		}, instance.Status.DistributionConfig.Models)
+	})
+
+	t.Run("keeps the last-known models when the endpoint fails", func(t *testing.T) {
+		instance := newInstance()
+		known := []ogxiov1beta1.ModelInfo{{Identifier: "llama3.2:3b", ProviderID: "ollama", ModelType: "llm"}}
+		instance.Status.DistributionConfig.Models = known
+
+		newReconciler(http.StatusInternalServerError, "").refreshModels(t.Context(), instance)
+
+		assert.Equal(t, known, instance.Status.DistributionConfig.Models)
+	})
+
+	t.Run("an empty list clears the models", func(t *testing.T) {
+		instance := newInstance()
+		instance.Status.DistributionConfig.Models = []ogxiov1beta1.ModelInfo{{Identifier: "removed"}}
+
+		newReconciler(http.StatusOK, `{"data": []}`).refreshModels(t.Context(), instance)
+
+		assert.Empty(t, instance.Status.DistributionConfig.Models)
+	})
+
+	t.Run("rejects a malformed response", func(t *testing.T) {
+		_, err := newReconciler(http.StatusOK, "not json").getModelsInfo(t.Context(), newInstance())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to unmarshal models response")
+	})
+}
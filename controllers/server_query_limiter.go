@@ -0,0 +1,65 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultServerQueryRate is the number of provider and version queries per
+	// second the operator sends to all servers combined.
+	DefaultServerQueryRate = rate.Limit(10)
+	// DefaultServerQueryBurst is the number of server queries that may be sent
+	// at once before the rate applies.
+	DefaultServerQueryBurst = 20
+	// requeueJitterFraction is the largest fraction of a requeue interval added
+	// as random jitter, so that servers created together are re-checked apart.
+	requeueJitterFraction = 0.2
+)
+
+// newServerQueryLimiter returns the limiter shared by all queries to servers.
+func newServerQueryLimiter() *rate.Limiter {
+	return rate.NewLimiter(DefaultServerQueryRate, DefaultServerQueryBurst)
+}
+
+// waitForServerQuery blocks until the shared limiter allows another query to a
+// server, or the context is done.
+func (r *OGXServerReconciler) waitForServerQuery(ctx context.Context) error {
+	if r.serverQueryLimiter == nil {
+		return nil
+	}
+	if err := r.serverQueryLimiter.Wait(ctx); err != nil {
+		return fmt.Errorf("failed to wait for the server query rate limit: %w", err)
+	}
+	return nil
+}
+
+// withJitter returns interval extended by a random jitter of up to
+// requeueJitterFraction of it.
+func withJitter(interval time.Duration) time.Duration {
+	maxJitter := int64(float64(interval) * requeueJitterFraction)
+	if maxJitter <= 0 {
+		return interval
+	}
+	return interval + time.Duration(rand.Int64N(maxJitter+1)) //nolint:gosec // jitter needs no cryptographic randomness
+}
@@ -0,0 +1,54 @@
+package controllers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestServerQueryLimiter(t *testing.T) {
+	var queries int
+	r := &OGXServerReconciler{
+		httpClient: &http.Client{Transport: roundTripperFunc(func(*http.Request) (*http.Response, error) {
+			queries++
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"version": "1.2.3"}`))}, nil
+		})},
+		serverQueryLimiter: rate.NewLimiter(rate.Every(time.Hour), 1),
+	}
+	instance := &ogxiov1beta1.OGXServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+
+	_, err := r.getVersionInfo(t.Context(), instance)
+	require.NoError(t, err, "the burst allows the first query")
+	assert.Equal(t, 1, queries)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+	defer cancel()
+	_, err = r.getProviderInfo(ctx, instance)
+	require.ErrorContains(t, err, "rate limit")
+	_, err = r.getVersionInfo(ctx, instance)
+	require.ErrorContains(t, err, "rate limit")
+	assert.Equal(t, 1, queries, "queries over the limit are not sent")
+}
+
+func TestWithJitter(t *testing.T) {
+	interval := 10 * time.Second
+	seen := map[time.Duration]struct{}{}
+	for range 50 {
+		requeue := withJitter(interval)
+		assert.GreaterOrEqual(t, requeue, interval)
+		assert.LessOrEqual(t, requeue, 12*time.Second)
+		seen[requeue] = struct{}{}
+	}
+	assert.Greater(t, len(seen), 1, "requeue times should vary")
+
+	assert.Equal(t, time.Duration(0), withJitter(0))
+}
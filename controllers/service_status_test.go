@@ -0,0 +1,78 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestUpdateServiceStatus_LoadBalancerAddress(t *testing.T) {
+	newService := func(serviceType corev1.ServiceType, ingress ...corev1.LoadBalancerIngress) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-service", Namespace: "default"},
+			Spec:       corev1.ServiceSpec{Type: serviceType},
+			Status:     corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{Ingress: ingress}},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		service     *corev1.Service
+		wantAddress string
+	}{
+		{
+			name:    "cluster IP Service has no address",
+			service: newService(corev1.ServiceTypeClusterIP),
+		},
+		{
+			name:    "load balancer not provisioned yet",
+			service: newService(corev1.ServiceTypeLoadBalancer),
+		},
+		{
+			name:        "load balancer IP",
+			service:     newService(corev1.ServiceTypeLoadBalancer, corev1.LoadBalancerIngress{IP: "203.0.113.10"}),
+			wantAddress: "203.0.113.10",
+		},
+		{
+			name: "load balancer hostname wins over IP",
+			service: newService(corev1.ServiceTypeLoadBalancer,
+				corev1.LoadBalancerIngress{Hostname: "lb.example.com", IP: "203.0.113.10"}),
+			wantAddress: "lb.example.com",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &OGXServerReconciler{Client: fake.NewClientBuilder().WithObjects(tc.service).Build()}
+			instance := &ogxiov1beta1.OGXServer{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+				Status:     ogxiov1beta1.OGXServerStatus{LoadBalancerAddress: "198.51.100.1"},
+			}
+
+			r.updateServiceStatus(t.Context(), instance)
+
+			assert.Equal(t, tc.wantAddress, instance.Status.LoadBalancerAddress)
+			assert.True(t, IsConditionTrue(&instance.Status, ConditionTypeServiceReady))
+		})
+	}
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"sync"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// servingTLSVolumeName is the name of the volume holding the serving certificate.
+	servingTLSVolumeName = "serving-tls"
+	// servingTLSMountPath is where the serving certificate Secret is mounted.
+	servingTLSMountPath = "/etc/ogx-tls"
+	// servingTLSCAKey is the optional Secret key of the CA that signed the serving certificate.
+	servingTLSCAKey = "ca.crt"
+)
+
+// isServingTLSEnabled reports whether the server terminates TLS itself.
+func isServingTLSEnabled(instance *ogxiov1beta1.OGXServer) bool {
+	return instance.Spec.Network != nil && instance.Spec.Network.TLS != nil &&
+		instance.Spec.Network.TLS.SecretName != ""
+}
+
+// getServerScheme returns the URL scheme the server is reached with.
+func getServerScheme(instance *ogxiov1beta1.OGXServer) string {
+	if isServingTLSEnabled(instance) {
+		return "https"
+	}
+	return "http"
+}
+
+// getServingTLSArgs returns the uvicorn arguments that serve HTTPS with the
+// mounted certificate and key.
+func getServingTLSArgs() []string {
+	return []string{
+		"--ssl-certfile", path.Join(servingTLSMountPath, corev1.TLSCertKey),
+		"--ssl-keyfile", path.Join(servingTLSMountPath, corev1.TLSPrivateKeyKey),
+	}
+}
+
+// validateServingTLS rejects serving TLS for a declared server version that
+// predates the uvicorn CLI, as the older launchers only serve plain HTTP.
+func validateServingTLS(ctx context.Context, instance *ogxiov1beta1.OGXServer) error {
+	version := instance.Spec.Distribution.Version
+	if !isServingTLSEnabled(instance) || supportsServerCapability(version, CapabilityUvicornCLI) {
+		return nil
+	}
+	msg := fmt.Sprintf("network.tls requires server version %s or later, but spec.distribution.version is %s",
+		serverCapabilityMinVersions[CapabilityUvicornCLI], version)
+	log.FromContext(ctx).Error(nil, msg)
+	return &terminalError{message: msg}
+}
+
+// getServingTLSEnv returns the environment variables that make uvicorn serve
+// HTTPS with the mounted certificate and key.
+func getServingTLSEnv() []corev1.EnvVar {
+	return []corev1.EnvVar{
+		{Name: "UVICORN_SSL_CERTFILE", Value: path.Join(servingTLSMountPath, corev1.TLSCertKey)},
+		{Name: "UVICORN_SSL_KEYFILE", Value: path.Join(servingTLSMountPath, corev1.TLSPrivateKeyKey)},
+	}
+}
+
+// configureServingTLS adds the serving certificate Secret volume to the pod.
+func configureServingTLS(instance *ogxiov1beta1.OGXServer, podSpec *corev1.PodSpec) {
+	if !isServingTLSEnabled(instance) {
+		return
+	}
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name: servingTLSVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: instance.Spec.Network.TLS.SecretName},
+		},
+	})
+}
+
+// serverHTTPClient returns the client used to query the server. Servers that
+// terminate TLS get a client trusting the CA of their serving certificate.
+func (r *OGXServerReconciler) serverHTTPClient(ctx context.Context, instance *ogxiov1beta1.OGXServer) (*http.Client, error) {
+	instanceKey := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+	if !isServingTLSEnabled(instance) {
+		r.servingTLSClients.forget(instanceKey)
+		return r.httpClient, nil
+	}
+	// The cache only holds Secrets labelled ogx.io/watch, and serving
+	// certificates issued by cert-manager or the service CA usually are not.
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Name: instance.Spec.Network.TLS.SecretName, Namespace: instance.Namespace}
+	if err := r.directGet(ctx, key, secret); err != nil {
+		return nil, fmt.Errorf("failed to get serving TLS Secret %s: %w", key.Name, err)
+	}
+
+	if httpClient := r.servingTLSClients.get(instanceKey, secret); httpClient != nil {
+		return httpClient, nil
+	}
+	httpClient, err := newServingTLSClient(r.httpClient, secret)
+	if err != nil {
+		return nil, err
+	}
+	r.servingTLSClients.put(instanceKey, secret, httpClient)
+	return httpClient, nil
+}
+
+// servingTLSClientCache keeps the client of each TLS server until its serving
+// certificate Secret changes, so that connections are reused across queries.
+type servingTLSClientCache struct {
+	mu      sync.Mutex
+	clients map[types.NamespacedName]servingTLSClient
+}
+
+// servingTLSClient is a client built from one version of a serving certificate Secret.
+type servingTLSClient struct {
+	secretName      string
+	resourceVersion string
+	client          *http.Client
+}
+
+// get returns the client of key when it was built from the current version of
+// secret, or nil.
+func (c *servingTLSClientCache) get(key types.NamespacedName, secret *corev1.Secret) *http.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached, ok := c.clients[key]
+	if !ok || cached.secretName != secret.Name || cached.resourceVersion != secret.ResourceVersion {
+		return nil
+	}
+	return cached.client
+}
+
+// put stores the client of key, closing the idle connections of the client it replaces.
+func (c *servingTLSClientCache) put(key types.NamespacedName, secret *corev1.Secret, httpClient *http.Client) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.clients == nil {
+		c.clients = make(map[types.NamespacedName]servingTLSClient)
+	}
+	if old, ok := c.clients[key]; ok {
+		old.client.CloseIdleConnections()
+	}
+	c.clients[key] = servingTLSClient{
+		secretName:      secret.Name,
+		resourceVersion: secret.ResourceVersion,
+		client:          httpClient,
+	}
+}
+
+// forget drops the client of key, e.g. after the CR is deleted.
+func (c *servingTLSClientCache) forget(key types.NamespacedName) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if old, ok := c.clients[key]; ok {
+		old.client.CloseIdleConnections()
+		delete(c.clients, key)
+	}
+}
+
+// newServingTLSClient returns a copy of base that trusts the CA in the serving
+// certificate Secret, or the certificate itself when the Secret has no CA.
+func newServingTLSClient(base *http.Client, secret *corev1.Secret) (*http.Client, error) {
+	caPEM := secret.Data[servingTLSCAKey]
+	if len(caPEM) == 0 {
+		caPEM = secret.Data[corev1.TLSCertKey]
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, errors.New("serving TLS Secret contains no PEM certificates")
+	}
+
+	transport, ok := base.Transport.(*http.Transport)
+	if !ok || transport == nil {
+		transport, _ = http.DefaultTransport.(*http.Transport)
+	}
+	transport = transport.Clone()
+	transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+
+	return &http.Client{Transport: transport, Timeout: base.Timeout}, nil
+}
@@ -0,0 +1,188 @@
+package controllers
+
+import (
+	"crypto/x509"
+	"net/http"
+	"testing"
+	"time"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newServingTLSInstance() *ogxiov1beta1.OGXServer {
+	return NewOGXServerBuilder().WithName("test").WithImage("x:latest").WithServingTLS("serving-cert").Build()
+}
+
+func TestGetServerURLScheme(t *testing.T) {
+	r := &OGXServerReconciler{}
+
+	plain := newServingTLSInstance()
+	plain.Spec.Network = nil
+	assert.Equal(t, "http://test-service.default.svc.cluster.local:8321/v1/providers",
+		r.getServerURL(plain, "/v1/providers").String())
+
+	assert.Equal(t, "https://test-service.default.svc.cluster.local:8321/v1/providers",
+		r.getServerURL(newServingTLSInstance(), "/v1/providers").String())
+}
+
+func TestServingTLSPodSpec(t *testing.T) {
+	instance := newServingTLSInstance()
+
+	container := buildContainerSpec(t.Context(), nil, instance, "x:latest")
+	require.NotNil(t, container.StartupProbe)
+	assert.Equal(t, corev1.URISchemeHTTPS, container.StartupProbe.HTTPGet.Scheme)
+	assert.Contains(t, container.Env, corev1.EnvVar{Name: "UVICORN_SSL_CERTFILE", Value: "/etc/ogx-tls/tls.crt"})
+	assert.Contains(t, container.Env, corev1.EnvVar{Name: "UVICORN_SSL_KEYFILE", Value: "/etc/ogx-tls/tls.key"})
+	require.NotEmpty(t, container.Command)
+	assert.Equal(t, "uvicorn", container.Command[0], "only the uvicorn CLI serves HTTPS")
+	assert.Equal(t, []string{"--ssl-certfile", "/etc/ogx-tls/tls.crt", "--ssl-keyfile", "/etc/ogx-tls/tls.key"},
+		container.Command[len(container.Command)-4:])
+	assert.Contains(t, container.VolumeMounts, corev1.VolumeMount{
+		Name: servingTLSVolumeName, MountPath: servingTLSMountPath, ReadOnly: true,
+	})
+
+	podSpec := configurePodStorage(t.Context(), nil, instance, container, "")
+	assert.Contains(t, podSpec.Volumes, corev1.Volume{
+		Name:         servingTLSVolumeName,
+		VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "serving-cert"}},
+	})
+
+	instance.Spec.Network = nil
+	container = buildContainerSpec(t.Context(), nil, instance, "x:latest")
+	assert.Empty(t, container.StartupProbe.HTTPGet.Scheme, "plain servers keep the default probe scheme")
+	for _, mount := range container.VolumeMounts {
+		assert.NotEqual(t, servingTLSVolumeName, mount.Name)
+	}
+}
+
+func TestValidateServingTLS(t *testing.T) {
+	instance := newServingTLSInstance()
+	require.NoError(t, validateServingTLS(t.Context(), instance), "unknown versions are assumed to have the uvicorn CLI")
+
+	instance.Spec.Distribution.Version = "0.3.1"
+	require.NoError(t, validateServingTLS(t.Context(), instance))
+
+	instance.Spec.Distribution.Version = "0.2.20"
+	err := validateServingTLS(t.Context(), instance)
+	var termErr *terminalError
+	require.ErrorAs(t, err, &termErr)
+	assert.Contains(t, err.Error(), "requires server version 0.3.0 or later")
+
+	instance.Spec.Network = nil
+	require.NoError(t, validateServingTLS(t.Context(), instance), "plain servers run on any version")
+}
+
+func TestNewServingTLSClient(t *testing.T) {
+	expiry := time.Now().Add(24 * time.Hour)
+	caPEM := generateTestCertPEMExpiring(t, "serving-ca", expiry)
+	certPEM := generateTestCertPEMExpiring(t, "serving-cert", expiry)
+	base := &http.Client{
+		Timeout: 5 * time.Second,
+		Transport: roundTripperFunc(func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+		}),
+	}
+	poolOf := func(pemData string) *x509.CertPool {
+		pool := x509.NewCertPool()
+		require.True(t, pool.AppendCertsFromPEM([]byte(pemData)))
+		return pool
+	}
+
+	t.Run("trusts the CA of the serving certificate", func(t *testing.T) {
+		secret := &corev1.Secret{Data: map[string][]byte{
+			servingTLSCAKey:   []byte(caPEM),
+			corev1.TLSCertKey: []byte(certPEM),
+		}}
+
+		httpClient, err := newServingTLSClient(base, secret)
+		require.NoError(t, err)
+		assert.Equal(t, base.Timeout, httpClient.Timeout)
+		transport, ok := httpClient.Transport.(*http.Transport)
+		require.True(t, ok)
+		require.NotNil(t, transport.TLSClientConfig)
+		assert.True(t, transport.TLSClientConfig.RootCAs.Equal(poolOf(caPEM)))
+	})
+
+	t.Run("trusts a self-signed certificate without a CA", func(t *testing.T) {
+		secret := &corev1.Secret{Data: map[string][]byte{corev1.TLSCertKey: []byte(certPEM)}}
+
+		httpClient, err := newServingTLSClient(base, secret)
+		require.NoError(t, err)
+		transport, ok := httpClient.Transport.(*http.Transport)
+		require.True(t, ok)
+		assert.True(t, transport.TLSClientConfig.RootCAs.Equal(poolOf(certPEM)))
+	})
+
+	t.Run("rejects a Secret without certificates", func(t *testing.T) {
+		_, err := newServingTLSClient(base, &corev1.Secret{})
+		require.ErrorContains(t, err, "no PEM certificates")
+	})
+
+	t.Run("leaves the shared transport unchanged", func(t *testing.T) {
+		shared := &http.Transport{}
+		secret := &corev1.Secret{Data: map[string][]byte{corev1.TLSCertKey: []byte(certPEM)}}
+
+		_, err := newServingTLSClient(&http.Client{Transport: shared}, secret)
+		require.NoError(t, err)
+		if shared.TLSClientConfig != nil {
+			assert.Nil(t, shared.TLSClientConfig.RootCAs)
+		}
+	})
+}
+
+func TestServerHTTPClientReadsUnwatchedSecret(t *testing.T) {
+	instance := newServingTLSInstance()
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "serving-cert", Namespace: "default"},
+		Data: map[string][]byte{
+			corev1.TLSCertKey: []byte(generateTestCertPEMExpiring(t, "serving-cert", time.Now().Add(time.Hour))),
+		},
+	}
+	// The cached client only sees Secrets labelled ogx.io/watch, so the
+	// serving certificate is only visible to the direct client.
+	r := &OGXServerReconciler{
+		Client:       fake.NewClientBuilder().Build(),
+		DirectClient: fake.NewClientBuilder().WithObjects(secret).Build(),
+		httpClient:   &http.Client{Timeout: time.Second},
+	}
+
+	httpClient, err := r.serverHTTPClient(t.Context(), instance)
+	require.NoError(t, err)
+	assert.NotSame(t, r.httpClient, httpClient)
+}
+
+func TestServerHTTPClientIsCachedPerSecretVersion(t *testing.T) {
+	instance := newServingTLSInstance()
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "serving-cert", Namespace: "default"},
+		Data: map[string][]byte{
+			corev1.TLSCertKey: []byte(generateTestCertPEMExpiring(t, "serving-cert", time.Now().Add(time.Hour))),
+		},
+	}
+	r := &OGXServerReconciler{
+		Client:     fake.NewClientBuilder().WithObjects(secret).Build(),
+		httpClient: &http.Client{Timeout: time.Second},
+	}
+
+	first, err := r.serverHTTPClient(t.Context(), instance)
+	require.NoError(t, err)
+	second, err := r.serverHTTPClient(t.Context(), instance)
+	require.NoError(t, err)
+	assert.Same(t, first, second, "the client is reused while the Secret is unchanged")
+
+	secret.Data[servingTLSCAKey] = []byte(generateTestCertPEMExpiring(t, "serving-ca", time.Now().Add(time.Hour)))
+	require.NoError(t, r.Update(t.Context(), secret))
+	rotated, err := r.serverHTTPClient(t.Context(), instance)
+	require.NoError(t, err)
+	assert.NotSame(t, first, rotated, "a changed Secret rebuilds the client")
+
+	instance.Spec.Network = nil
+	plain, err := r.serverHTTPClient(t.Context(), instance)
+	require.NoError(t, err)
+	assert.Same(t, r.httpClient, plain)
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// startupScriptVolumeName is the name of the volume holding the custom startup script.
+	startupScriptVolumeName = "startup-script"
+	// startupScriptMountPath is where the custom startup script volume is mounted.
+	startupScriptMountPath = "/etc/ogx-startup"
+	// startupScriptFileName is the file name the custom startup script is mounted as.
+	startupScriptFileName = "startup.sh"
+)
+
+// getStartupScriptRef returns the custom startup script reference, or nil when
+// the default script is used.
+func getStartupScriptRef(instance *ogxiov1beta1.OGXServer) *ogxiov1beta1.ConfigMapKeyRef {
+	if instance.Spec.Workload == nil {
+		return nil
+	}
+	ref := instance.Spec.Workload.StartupScriptConfigMap
+	if ref == nil || ref.Name == "" || ref.Key == "" {
+		return nil
+	}
+	return ref
+}
+
+// getStartupScriptCommand returns the command that runs the startup script,
+// the custom one when configured and the embedded default otherwise.
+func getStartupScriptCommand(instance *ogxiov1beta1.OGXServer) []string {
+	if getStartupScriptRef(instance) != nil {
+		return []string{"/bin/sh", path.Join(startupScriptMountPath, startupScriptFileName)}
+	}
+	return []string{"/bin/sh", "-c", startupScript}
+}
+
+// configureStartupScript adds the custom startup script ConfigMap volume to the pod.
+func configureStartupScript(instance *ogxiov1beta1.OGXServer, podSpec *corev1.PodSpec) {
+	ref := getStartupScriptRef(instance)
+	if ref == nil {
+		return
+	}
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name: startupScriptVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: ref.Name},
+				Items:                []corev1.KeyToPath{{Key: ref.Key, Path: startupScriptFileName}},
+			},
+		},
+	})
+}
+
+// getStartupScriptConfigMap fetches the ConfigMap holding the custom startup script.
+func (r *OGXServerReconciler) getStartupScriptConfigMap(
+	ctx context.Context, instance *ogxiov1beta1.OGXServer, ref *ogxiov1beta1.ConfigMapKeyRef,
+) (*corev1.ConfigMap, error) {
+	// Read via direct client — user ConfigMaps lack operator labels
+	configMap := &corev1.ConfigMap{}
+	if err := r.directGet(ctx, types.NamespacedName{Name: ref.Name, Namespace: instance.Namespace}, configMap); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to find startup script ConfigMap %s/%s", instance.Namespace, ref.Name)
+		}
+		return nil, fmt.Errorf("failed to fetch startup script ConfigMap %s/%s: %w", instance.Namespace, ref.Name, err)
+	}
+	return configMap, nil
+}
+
+// validateStartupScript checks that the custom startup script exists and is not empty.
+func (r *OGXServerReconciler) validateStartupScript(ctx context.Context, instance *ogxiov1beta1.OGXServer) error {
+	ref := getStartupScriptRef(instance)
+	if ref == nil {
+		return nil
+	}
+	configMap, err := r.getStartupScriptConfigMap(ctx, instance, ref)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(configMap.Data[ref.Key]) == "" {
+		return fmt.Errorf("startup script ConfigMap %s/%s key '%s' is missing or empty", instance.Namespace, ref.Name, ref.Key)
+	}
+	return nil
+}
+
+// getStartupScriptHash returns a hash of the data of the custom startup script
+// ConfigMap, so that script changes restart the server.
+func (r *OGXServerReconciler) getStartupScriptHash(ctx context.Context, instance *ogxiov1beta1.OGXServer) (string, error) {
+	ref := getStartupScriptRef(instance)
+	if ref == nil {
+		return "", nil
+	}
+	configMap, err := r.getStartupScriptConfigMap(ctx, instance, ref)
+	if err != nil {
+		return "", err
+	}
+	return configMapContentHash(configMap), nil
+}
@@ -0,0 +1,106 @@
+package controllers
+
+import (
+	"testing"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newStartupScriptInstance() *ogxiov1beta1.OGXServer {
+	return NewOGXServerBuilder().WithName("test").WithImage("x:latest").WithStartupScript("startup", "run.sh").Build()
+}
+
+func TestStartupScriptPodSpec(t *testing.T) {
+	t.Run("runs the custom script when configured", func(t *testing.T) {
+		instance := newStartupScriptInstance()
+
+		container := buildContainerSpec(t.Context(), nil, instance, "x:latest")
+		assert.Equal(t, []string{"/bin/sh", "/etc/ogx-startup/startup.sh"}, container.Command)
+		assert.Empty(t, container.Args)
+		assert.Contains(t, container.VolumeMounts, corev1.VolumeMount{
+			Name: startupScriptVolumeName, MountPath: startupScriptMountPath, ReadOnly: true,
+		})
+
+		podSpec := configurePodStorage(t.Context(), nil, instance, container, "")
+		assert.Contains(t, podSpec.Volumes, corev1.Volume{
+			Name: startupScriptVolumeName,
+			VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "startup"},
+				Items:                []corev1.KeyToPath{{Key: "run.sh", Path: startupScriptFileName}},
+			}},
+		})
+	})
+
+	t.Run("replaces the default script with an override config", func(t *testing.T) {
+		instance := newStartupScriptInstance()
+		instance.Spec.OverrideConfig = &ogxiov1beta1.ConfigMapKeyRef{Name: "user-config", Key: "config.yaml"}
+
+		container := corev1.Container{}
+		configureContainerCommands(t.Context(), nil, instance, &container)
+		assert.Equal(t, []string{"/bin/sh", "/etc/ogx-startup/startup.sh"}, container.Command)
+	})
+
+	t.Run("uses the default script otherwise", func(t *testing.T) {
+		instance := newStartupScriptInstance()
+		instance.Spec.Workload = nil
+		instance.Spec.OverrideConfig = &ogxiov1beta1.ConfigMapKeyRef{Name: "user-config", Key: "config.yaml"}
+
+		container := buildContainerSpec(t.Context(), nil, instance, "x:latest")
+		assert.Equal(t, []string{"/bin/sh", "-c", startupScript}, container.Command)
+		for _, mount := range container.VolumeMounts {
+			assert.NotEqual(t, startupScriptVolumeName, mount.Name)
+		}
+	})
+
+	t.Run("override command takes precedence", func(t *testing.T) {
+		instance := newStartupScriptInstance()
+		instance.Spec.Workload.Overrides = &ogxiov1beta1.WorkloadOverrides{Command: []string{"/custom"}}
+
+		container := corev1.Container{}
+		configureContainerCommands(t.Context(), nil, instance, &container)
+		assert.Equal(t, []string{"/custom"}, container.Command)
+	})
+}
+
+func TestValidateStartupScript(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      map[string]string
+		wantError string
+	}{
+		{name: "valid script", data: map[string]string{"run.sh": "exec uvicorn app"}},
+		{name: "empty script", data: map[string]string{"run.sh": " \n"}, wantError: "missing or empty"},
+		{name: "missing key", data: map[string]string{"other.sh": "true"}, wantError: "missing or empty"},
+		{name: "missing ConfigMap", wantError: "failed to find startup script ConfigMap"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := fake.NewClientBuilder()
+			if tt.data != nil {
+				builder = builder.WithObjects(&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "startup", Namespace: "default"},
+					Data:       tt.data,
+				})
+			}
+			r := &OGXServerReconciler{Client: builder.Build()}
+
+			err := r.validateStartupScript(t.Context(), newStartupScriptInstance())
+			if tt.wantError == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.wantError)
+		})
+	}
+
+	t.Run("no custom script", func(t *testing.T) {
+		r := &OGXServerReconciler{Client: fake.NewClientBuilder().Build()}
+		require.NoError(t, r.validateStartupScript(t.Context(), createTestOGX("", "x:latest")))
+	})
+}
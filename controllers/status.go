@@ -21,6 +21,35 @@ const (
 	ConditionTypeNetworkingAdopted = "NetworkingAdopted"
 	// ConditionTypeAdoptionConfigInvalid indicates whether adoption annotation values are invalid.
 	ConditionTypeAdoptionConfigInvalid = "AdoptionConfigInvalid"
+	// ConditionTypeReconcileBackingOff indicates whether active reconcile retries are suspended.
+	ConditionTypeReconcileBackingOff = "ReconcileBackingOff"
+	// ConditionTypeWorkloadOverridesValid indicates whether workload overrides are compatible with managed volumes.
+	ConditionTypeWorkloadOverridesValid = "WorkloadOverridesValid"
+	// ConditionTypeProvidersHealthy indicates whether all providers report a healthy status.
+	ConditionTypeProvidersHealthy = "ProvidersHealthy"
+	// ConditionTypeNamespaceTerminating indicates whether the OGXServer namespace is being deleted.
+	ConditionTypeNamespaceTerminating = "NamespaceTerminating"
+	// ConditionTypeReconcileSuspended indicates whether reconciliation is suspended by annotation.
+	ConditionTypeReconcileSuspended = "ReconcileSuspended"
+	// ConditionTypeCatalogAvailable indicates whether the operator has a distribution catalog at all.
+	ConditionTypeCatalogAvailable = "CatalogAvailable"
+	// ConditionTypeDistributionResolved indicates whether spec.distribution resolves to an image.
+	ConditionTypeDistributionResolved = "DistributionResolved"
+	// ConditionTypeRolloutHealthy indicates whether the latest rollout passed the provider health gate.
+	ConditionTypeRolloutHealthy = "RolloutHealthy"
+	// ConditionTypeDegraded indicates whether a running server has providers that keep reporting errors
+	// or lost its override ConfigMap.
+	ConditionTypeDegraded = "Degraded"
+	// ConditionTypeOverrideConfigValid indicates whether the override ConfigMap holds a usable config.
+	ConditionTypeOverrideConfigValid = "OverrideConfigValid"
+	// ConditionTypeCABundleReady indicates whether the referenced CA certificates form a usable bundle.
+	ConditionTypeCABundleReady = "CABundleReady"
+	// ConditionTypeCABundleExpiring indicates whether a CA bundle certificate is expired or about to expire.
+	ConditionTypeCABundleExpiring = "CABundleExpiring"
+	// ConditionTypeMutableImageTag indicates whether the server image is referenced by an unpinned tag.
+	ConditionTypeMutableImageTag = "MutableImageTag"
+	// ConditionTypeStorageResizeBlocked indicates whether storage.size cannot be applied to the existing PVC.
+	ConditionTypeStorageResizeBlocked = "StorageResizeBlocked"
 )
 
 // Condition reasons.
@@ -31,6 +60,8 @@ const (
 	ReasonDeploymentFailed = "DeploymentFailed"
 	// ReasonDeploymentPending indicates the deployment is pending.
 	ReasonDeploymentPending = "DeploymentPending"
+	// ReasonDeploymentSuspended indicates the deployment is scaled to zero replicas.
+	ReasonDeploymentSuspended = "DeploymentSuspended"
 	// ReasonHealthCheckPassed indicates the health check passed.
 	ReasonHealthCheckPassed = "HealthCheckPassed"
 	// ReasonHealthCheckFailed indicates the health check failed.
@@ -49,6 +80,93 @@ const (
 	ReasonNetworkingAdopted = "NetworkingAdopted"
 	// ReasonAdoptionConfigInvalid indicates adoption annotation values are invalid.
 	ReasonAdoptionConfigInvalid = "AdoptionConfigInvalid"
+	// ReasonRepeatedFailures indicates reconcile failed repeatedly with the same error.
+	ReasonRepeatedFailures = "RepeatedFailures"
+	// ReasonRetrying indicates reconcile retries are active.
+	ReasonRetrying = "Retrying"
+	// ReasonWorkloadOverridesValid indicates workload overrides are valid.
+	ReasonWorkloadOverridesValid = "WorkloadOverridesValid"
+	// ReasonVolumeConflict indicates workload overrides collide with operator-managed volumes.
+	ReasonVolumeConflict = "VolumeConflict"
+	// ReasonProvidersHealthy indicates all providers report a healthy status.
+	ReasonProvidersHealthy = "ProvidersHealthy"
+	// ReasonProvidersInitializing indicates providers are still starting up.
+	ReasonProvidersInitializing = "ProvidersInitializing"
+	// ReasonProvidersDegraded indicates providers kept reporting errors after startup.
+	ReasonProvidersDegraded = "ProvidersDegraded"
+	// ReasonConfigMapMissing indicates the override ConfigMap of a running server was deleted.
+	ReasonConfigMapMissing = "ConfigMapMissing"
+	// ReasonNamespaceTerminating indicates the namespace is being deleted.
+	ReasonNamespaceTerminating = "NamespaceTerminating"
+	// ReasonNamespaceActive indicates the namespace is active.
+	ReasonNamespaceActive = "NamespaceActive"
+	// ReasonReconcileSuspended indicates reconciliation is suspended by annotation.
+	ReasonReconcileSuspended = "ReconcileSuspended"
+	// ReasonReconcileResumed indicates reconciliation resumed after the suspend annotation was removed.
+	ReasonReconcileResumed = "ReconcileResumed"
+	// ReasonCatalogAvailable indicates the distribution catalog is loaded.
+	ReasonCatalogAvailable = "CatalogAvailable"
+	// ReasonCatalogUnavailable indicates the operator has no distribution catalog.
+	ReasonCatalogUnavailable = "CatalogUnavailable"
+	// ReasonDistributionResolved indicates spec.distribution resolves to an image.
+	ReasonDistributionResolved = "DistributionResolved"
+	// ReasonDistributionNotFound indicates the distribution name is not in the catalog.
+	ReasonDistributionNotFound = "DistributionNotFound"
+	// ReasonDistributionUnset indicates neither a distribution name nor an image is set.
+	ReasonDistributionUnset = "DistributionUnset"
+	// ReasonDistributionInvalidImage indicates spec.distribution.image is not a valid image reference.
+	ReasonDistributionInvalidImage = "DistributionInvalidImage"
+	// ReasonRolloutHealthy indicates the latest rollout passed the provider health gate.
+	ReasonRolloutHealthy = "RolloutHealthy"
+	// ReasonRolloutVerifying indicates providers of the latest rollout are still being watched.
+	ReasonRolloutVerifying = "RolloutVerifying"
+	// ReasonRolloutRolledBack indicates the latest rollout was rolled back after provider errors.
+	ReasonRolloutRolledBack = "RolloutRolledBack"
+	// ReasonRolloutRollbackUnavailable indicates the latest rollout failed with no revision to roll back to.
+	ReasonRolloutRollbackUnavailable = "RolloutRollbackUnavailable"
+	// ReasonNotDegraded indicates no provider keeps reporting errors.
+	ReasonNotDegraded = "NotDegraded"
+	// ReasonConfigKeyMismatch indicates the override ConfigMap key differs from
+	// the config filename the distribution expects. Only used for Events.
+	ReasonConfigKeyMismatch = "ConfigKeyMismatch"
+	// ReasonPVCExpanding indicates the PVC is being resized to a larger storage
+	// size. Only used for Events.
+	ReasonPVCExpanding = "PVCExpanding"
+	// ReasonPVCExpansionUnsupported indicates a larger storage size cannot be
+	// applied because the StorageClass does not allow volume expansion.
+	ReasonPVCExpansionUnsupported = "PVCExpansionUnsupported"
+	// ReasonPVCShrinkUnsupported indicates a smaller storage size cannot be
+	// applied because PVCs cannot shrink.
+	ReasonPVCShrinkUnsupported = "PVCShrinkUnsupported"
+	// ReasonStorageSizeApplied indicates the PVC requests storage.size.
+	ReasonStorageSizeApplied = "StorageSizeApplied"
+	// ReasonOverrideConfigValid indicates the override ConfigMap holds a usable config.
+	ReasonOverrideConfigValid = "OverrideConfigValid"
+	// ReasonOverrideConfigInvalid indicates the override ConfigMap key is missing or not a valid config.
+	ReasonOverrideConfigInvalid = "OverrideConfigInvalid"
+	// ReasonCABundleReady indicates the CA bundle was built from all referenced certificates.
+	ReasonCABundleReady = "CABundleReady"
+	// ReasonCABundleNotFound indicates a referenced CA certificate ConfigMap or Secret does not exist.
+	ReasonCABundleNotFound = "CABundleNotFound"
+	// ReasonCABundleKeyMissing indicates a referenced CA certificate ConfigMap or Secret lacks the key.
+	ReasonCABundleKeyMissing = "CABundleKeyMissing"
+	// ReasonCABundleInvalid indicates a referenced key holds no valid PEM certificates
+	// or the bundle exceeds its limits.
+	ReasonCABundleInvalid = "CABundleInvalid"
+	// ReasonCABundleCertificatesCurrent indicates no CA bundle certificate expires within the warning window.
+	ReasonCABundleCertificatesCurrent = "CABundleCertificatesCurrent"
+	// ReasonCABundleCertificateExpiring indicates a CA bundle certificate expires within the warning window.
+	ReasonCABundleCertificateExpiring = "CABundleCertificateExpiring"
+	// ReasonCABundleCertificateExpired indicates a CA bundle certificate has expired.
+	ReasonCABundleCertificateExpired = "CABundleCertificateExpired"
+	// ReasonMutableImageTag indicates the image tag is used without pinning it to a digest.
+	ReasonMutableImageTag = "MutableImageTag"
+	// ReasonImageDigestResolutionFailed indicates the image tag could not be pinned to a digest.
+	ReasonImageDigestResolutionFailed = "ImageDigestResolutionFailed"
+	// ReasonImageDigestPinned indicates the image tag is pinned to a digest.
+	ReasonImageDigestPinned = "ImageDigestPinned"
+	// ReasonImageDigestReference indicates the image is referenced by digest.
+	ReasonImageDigestReference = "ImageDigestReference"
 )
 
 // Condition messages.
@@ -59,6 +177,8 @@ const (
 	MessageDeploymentFailed = "Deployment failed"
 	// MessageDeploymentPending indicates the deployment is pending.
 	MessageDeploymentPending = "Deployment is pending"
+	// MessageDeploymentSuspended indicates the deployment is scaled to zero replicas.
+	MessageDeploymentSuspended = "Deployment is suspended with zero replicas"
 	// MessageHealthCheckPassed indicates the health check passed.
 	MessageHealthCheckPassed = "Health check passed"
 	// MessageHealthCheckFailed indicates the health check failed.
@@ -71,6 +191,34 @@ const (
 	MessageServiceReady = "Service is ready"
 	// MessageServiceFailed indicates the service failed.
 	MessageServiceFailed = "Service failed"
+	// MessageRetrying indicates reconcile retries are active.
+	MessageRetrying = "Reconcile retries are active"
+	// MessageWorkloadOverridesValid indicates workload overrides are valid.
+	MessageWorkloadOverridesValid = "Workload overrides are valid"
+	// MessageProvidersHealthy indicates all providers report a healthy status.
+	MessageProvidersHealthy = "All providers are healthy"
+	// MessageNamespaceTerminating indicates reconciliation is paused while the namespace is deleted.
+	MessageNamespaceTerminating = "Namespace is terminating, reconciliation is paused"
+	// MessageNamespaceActive indicates the namespace is active.
+	MessageNamespaceActive = "Namespace is active"
+	// MessageReconcileSuspended indicates reconciliation is suspended by annotation.
+	MessageReconcileSuspended = "Reconciliation is suspended by the " + ogxiov1beta1.SuspendReconcileAnnotation + " annotation"
+	// MessageReconcileResumed indicates reconciliation resumed.
+	MessageReconcileResumed = "Reconciliation resumed"
+	// MessageCatalogAvailable indicates the distribution catalog is loaded.
+	MessageCatalogAvailable = "Distribution catalog is available"
+	// MessageDistributionResolved indicates spec.distribution resolves to an image.
+	MessageDistributionResolved = "Distribution resolves to an image"
+	// MessageRolloutHealthy indicates the latest rollout passed the provider health gate.
+	MessageRolloutHealthy = "Rollout passed the provider health gate"
+	// MessageNotDegraded indicates no provider keeps reporting errors.
+	MessageNotDegraded = "No providers keep reporting errors"
+	// MessageOverrideConfigValid indicates the override ConfigMap holds a usable config.
+	MessageOverrideConfigValid = "Override config is valid"
+	// MessageCABundleReady indicates the CA bundle was built from all referenced certificates.
+	MessageCABundleReady = "CA bundle is ready"
+	// MessageImageDigestReference indicates the image is referenced by digest.
+	MessageImageDigestReference = "Image is referenced by digest"
 )
 
 // SetDeploymentReadyCondition sets the deployment ready condition.
@@ -92,6 +240,17 @@ func SetDeploymentReadyCondition(status *ogxiov1beta1.OGXServerStatus, ready boo
 	SetCondition(status, condition)
 }
 
+// SetDeploymentSuspendedCondition marks the deployment as intentionally scaled to zero.
+func SetDeploymentSuspendedCondition(status *ogxiov1beta1.OGXServerStatus) {
+	SetCondition(status, metav1.Condition{
+		Type:               ConditionTypeDeploymentReady,
+		Status:             metav1.ConditionFalse,
+		Reason:             ReasonDeploymentSuspended,
+		Message:            MessageDeploymentSuspended,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	})
+}
+
 // SetHealthCheckCondition sets the health check condition.
 func SetHealthCheckCondition(status *ogxiov1beta1.OGXServerStatus, healthy bool, message string) {
 	condition := metav1.Condition{
@@ -149,6 +308,284 @@ func SetServiceReadyCondition(status *ogxiov1beta1.OGXServerStatus, ready bool,
 	SetCondition(status, condition)
 }
 
+// SetReconcileBackingOffCondition sets the reconcile backing off condition.
+func SetReconcileBackingOffCondition(status *ogxiov1beta1.OGXServerStatus, backingOff bool, message string) {
+	condition := metav1.Condition{
+		Type:               ConditionTypeReconcileBackingOff,
+		Status:             metav1.ConditionFalse,
+		Reason:             ReasonRetrying,
+		Message:            MessageRetrying,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	}
+
+	if backingOff {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = ReasonRepeatedFailures
+		condition.Message = message
+	}
+
+	SetCondition(status, condition)
+}
+
+// SetWorkloadOverridesValidCondition sets the workload overrides validation condition.
+func SetWorkloadOverridesValidCondition(status *ogxiov1beta1.OGXServerStatus, valid bool, message string) {
+	condition := metav1.Condition{
+		Type:               ConditionTypeWorkloadOverridesValid,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonWorkloadOverridesValid,
+		Message:            MessageWorkloadOverridesValid,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	}
+
+	if !valid {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = ReasonVolumeConflict
+		condition.Message = message
+	}
+
+	SetCondition(status, condition)
+}
+
+// SetProvidersHealthyCondition sets the providers health condition. The reason
+// distinguishes providers that are still starting up from degraded ones.
+func SetProvidersHealthyCondition(status *ogxiov1beta1.OGXServerStatus, healthy bool, reason, message string) {
+	condition := metav1.Condition{
+		Type:               ConditionTypeProvidersHealthy,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonProvidersHealthy,
+		Message:            MessageProvidersHealthy,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	}
+
+	if !healthy {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = reason
+		condition.Message = message
+	}
+
+	SetCondition(status, condition)
+}
+
+// SetNamespaceTerminatingCondition sets the namespace terminating condition.
+func SetNamespaceTerminatingCondition(status *ogxiov1beta1.OGXServerStatus, terminating bool) {
+	condition := metav1.Condition{
+		Type:               ConditionTypeNamespaceTerminating,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonNamespaceTerminating,
+		Message:            MessageNamespaceTerminating,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	}
+
+	if !terminating {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = ReasonNamespaceActive
+		condition.Message = MessageNamespaceActive
+	}
+
+	SetCondition(status, condition)
+}
+
+// SetReconcileSuspendedCondition sets the reconcile suspended condition.
+func SetReconcileSuspendedCondition(status *ogxiov1beta1.OGXServerStatus, suspended bool) {
+	condition := metav1.Condition{
+		Type:               ConditionTypeReconcileSuspended,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonReconcileSuspended,
+		Message:            MessageReconcileSuspended,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	}
+
+	if !suspended {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = ReasonReconcileResumed
+		condition.Message = MessageReconcileResumed
+	}
+
+	SetCondition(status, condition)
+}
+
+// SetDistributionResolvedCondition sets whether spec.distribution resolves to
+// an image, e.g. with ReasonDistributionNotFound when the catalog is loaded but
+// does not contain the requested name.
+func SetDistributionResolvedCondition(status *ogxiov1beta1.OGXServerStatus, resolved bool, reason, message string) {
+	condition := metav1.Condition{
+		Type:               ConditionTypeDistributionResolved,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonDistributionResolved,
+		Message:            MessageDistributionResolved,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	}
+
+	if !resolved {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = reason
+		condition.Message = message
+	}
+
+	SetCondition(status, condition)
+}
+
+// SetCatalogAvailableCondition sets the distribution catalog availability condition.
+func SetCatalogAvailableCondition(status *ogxiov1beta1.OGXServerStatus, available bool, message string) {
+	condition := metav1.Condition{
+		Type:               ConditionTypeCatalogAvailable,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonCatalogAvailable,
+		Message:            MessageCatalogAvailable,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	}
+
+	if !available {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = ReasonCatalogUnavailable
+		condition.Message = message
+	}
+
+	SetCondition(status, condition)
+}
+
+// SetDegradedCondition sets the degraded condition. Unlike the other
+// conditions, True signals a problem.
+func SetDegradedCondition(status *ogxiov1beta1.OGXServerStatus, degraded bool, message string) {
+	condition := metav1.Condition{
+		Type:               ConditionTypeDegraded,
+		Status:             metav1.ConditionFalse,
+		Reason:             ReasonNotDegraded,
+		Message:            MessageNotDegraded,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	}
+
+	if degraded {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = ReasonProvidersDegraded
+		condition.Message = message
+	}
+
+	SetCondition(status, condition)
+}
+
+// SetConfigMapMissingCondition marks the server as degraded because its
+// override ConfigMap is missing while the existing deployment keeps running.
+func SetConfigMapMissingCondition(status *ogxiov1beta1.OGXServerStatus, message string) {
+	SetCondition(status, metav1.Condition{
+		Type:               ConditionTypeDegraded,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonConfigMapMissing,
+		Message:            message,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	})
+}
+
+// SetRolloutHealthyCondition sets the rollout health gate condition. The reason
+// and message are only used when the rollout is not healthy.
+func SetRolloutHealthyCondition(status *ogxiov1beta1.OGXServerStatus, healthy bool, reason, message string) {
+	condition := metav1.Condition{
+		Type:               ConditionTypeRolloutHealthy,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonRolloutHealthy,
+		Message:            MessageRolloutHealthy,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	}
+
+	if !healthy {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = reason
+		condition.Message = message
+	}
+
+	SetCondition(status, condition)
+}
+
+// SetOverrideConfigValidCondition sets the override config validation condition.
+func SetOverrideConfigValidCondition(status *ogxiov1beta1.OGXServerStatus, valid bool, message string) {
+	condition := metav1.Condition{
+		Type:               ConditionTypeOverrideConfigValid,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonOverrideConfigValid,
+		Message:            MessageOverrideConfigValid,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	}
+
+	if !valid {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = ReasonOverrideConfigInvalid
+		condition.Message = message
+	}
+
+	SetCondition(status, condition)
+}
+
+// SetCABundleReadyCondition sets the CA bundle condition. The reason and
+// message are only used when the bundle is not ready.
+func SetCABundleReadyCondition(status *ogxiov1beta1.OGXServerStatus, ready bool, reason, message string) {
+	condition := metav1.Condition{
+		Type:               ConditionTypeCABundleReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonCABundleReady,
+		Message:            MessageCABundleReady,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	}
+
+	if !ready {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = reason
+		condition.Message = message
+	}
+
+	SetCondition(status, condition)
+}
+
+// SetCABundleExpiringCondition sets the CA bundle expiry condition.
+func SetCABundleExpiringCondition(status *ogxiov1beta1.OGXServerStatus, expiring bool, reason, message string) {
+	condition := metav1.Condition{
+		Type:               ConditionTypeCABundleExpiring,
+		Status:             metav1.ConditionFalse,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	}
+
+	if expiring {
+		condition.Status = metav1.ConditionTrue
+	}
+
+	SetCondition(status, condition)
+}
+
+// SetStorageResizeBlockedCondition sets the storage resize blocked condition.
+func SetStorageResizeBlockedCondition(status *ogxiov1beta1.OGXServerStatus, blocked bool, reason, message string) {
+	condition := metav1.Condition{
+		Type:               ConditionTypeStorageResizeBlocked,
+		Status:             metav1.ConditionFalse,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	}
+
+	if blocked {
+		condition.Status = metav1.ConditionTrue
+	}
+
+	SetCondition(status, condition)
+}
+
+// SetMutableImageTagCondition sets the mutable image tag condition.
+func SetMutableImageTagCondition(status *ogxiov1beta1.OGXServerStatus, mutable bool, reason, message string) {
+	condition := metav1.Condition{
+		Type:               ConditionTypeMutableImageTag,
+		Status:             metav1.ConditionFalse,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	}
+
+	if mutable {
+		condition.Status = metav1.ConditionTrue
+	}
+
+	SetCondition(status, condition)
+}
+
 // SetCondition sets a condition in the status.
 func SetCondition(status *ogxiov1beta1.OGXServerStatus, condition metav1.Condition) {
 	// Initialize conditions if needed
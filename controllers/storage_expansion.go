@@ -0,0 +1,130 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// reconcilePVCExpansion grows the PVC when storage.size exceeds its current
+// request. The manifest apply never patches existing PVCs, so this only
+// changes spec.resources.requests.storage and leaves every other field as
+// created. Smaller sizes, and larger ones the StorageClass cannot expand to,
+// are reported on the StorageResizeBlocked condition.
+func (r *OGXServerReconciler) reconcilePVCExpansion(ctx context.Context, instance *ogxiov1beta1.OGXServer) error {
+	if instance.Spec.Workload == nil || instance.Spec.Workload.Storage == nil || instance.Spec.Workload.Storage.Size == nil {
+		clearStorageResizeBlocked(instance, "No storage size is requested")
+		return nil
+	}
+	logger := log.FromContext(ctx)
+	desired := *instance.Spec.Workload.Storage.Size
+
+	pvcName, err := r.resolveEffectivePVCName(ctx, instance)
+	if err != nil {
+		return err
+	}
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := r.Get(ctx, types.NamespacedName{Name: pvcName, Namespace: instance.Namespace}, pvc); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get PVC %s/%s: %w", instance.Namespace, pvcName, err)
+	}
+
+	current := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	if desired.Cmp(current) == 0 {
+		clearStorageResizeBlocked(instance, fmt.Sprintf("PVC %s requests %s", pvcName, current.String()))
+		return nil
+	}
+	if desired.Cmp(current) < 0 {
+		r.blockStorageResize(ctx, instance, ReasonPVCShrinkUnsupported,
+			fmt.Sprintf("PVC %s cannot shrink from %s to %s: PVCs can only grow",
+				pvcName, current.String(), desired.String()))
+		return nil
+	}
+
+	expandable, err := r.storageClassAllowsExpansion(ctx, pvc)
+	if err != nil {
+		return err
+	}
+	if !expandable {
+		r.blockStorageResize(ctx, instance, ReasonPVCExpansionUnsupported,
+			fmt.Sprintf("PVC %s cannot grow from %s to %s: its StorageClass does not allow volume expansion",
+				pvcName, current.String(), desired.String()))
+		return nil
+	}
+
+	patch := client.MergeFrom(pvc.DeepCopy())
+	if pvc.Spec.Resources.Requests == nil {
+		pvc.Spec.Resources.Requests = corev1.ResourceList{}
+	}
+	pvc.Spec.Resources.Requests[corev1.ResourceStorage] = desired
+	if err := r.Patch(ctx, pvc, patch); err != nil {
+		return fmt.Errorf("failed to expand PVC %s/%s: %w", instance.Namespace, pvcName, err)
+	}
+
+	msg := fmt.Sprintf("Expanding PVC %s from %s to %s", pvcName, current.String(), desired.String())
+	logger.Info(msg)
+	r.recordEvent(instance, corev1.EventTypeNormal, ReasonPVCExpanding, msg)
+	clearStorageResizeBlocked(instance, msg)
+	return nil
+}
+
+// blockStorageResize sets the StorageResizeBlocked condition. The Warning
+// Event is only recorded when the condition changes, so that it is not
+// repeated on every reconcile.
+func (r *OGXServerReconciler) blockStorageResize(ctx context.Context, instance *ogxiov1beta1.OGXServer, reason, message string) {
+	previous := GetCondition(&instance.Status, ConditionTypeStorageResizeBlocked)
+	if previous == nil || previous.Status != metav1.ConditionTrue || previous.Reason != reason || previous.Message != message {
+		log.FromContext(ctx).Info(message)
+		r.recordEvent(instance, corev1.EventTypeWarning, reason, message)
+	}
+	SetStorageResizeBlockedCondition(&instance.Status, true, reason, message)
+}
+
+// clearStorageResizeBlocked clears a previously set StorageResizeBlocked condition.
+func clearStorageResizeBlocked(instance *ogxiov1beta1.OGXServer, message string) {
+	if GetCondition(&instance.Status, ConditionTypeStorageResizeBlocked) != nil {
+		SetStorageResizeBlockedCondition(&instance.Status, false, ReasonStorageSizeApplied, message)
+	}
+}
+
+// storageClassAllowsExpansion reports whether the StorageClass of pvc allows
+// volume expansion. PVCs without a StorageClass cannot be expanded.
+func (r *OGXServerReconciler) storageClassAllowsExpansion(ctx context.Context, pvc *corev1.PersistentVolumeClaim) (bool, error) {
+	if pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName == "" {
+		return false, nil
+	}
+	storageClass := &storagev1.StorageClass{}
+	if err := r.directGet(ctx, types.NamespacedName{Name: *pvc.Spec.StorageClassName}, storageClass); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get StorageClass %s: %w", *pvc.Spec.StorageClassName, err)
+	}
+	return storageClass.AllowVolumeExpansion != nil && *storageClass.AllowVolumeExpansion, nil
+}
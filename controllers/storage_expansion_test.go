@@ -0,0 +1,184 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestReconcilePVCExpansion(t *testing.T) {
+	pvcKey := types.NamespacedName{Name: "test-pvc", Namespace: "default"}
+
+	newPVC := func(storageClass, size string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: pvcKey.Name, Namespace: pvcKey.Namespace},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				StorageClassName: ptr.To(storageClass),
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(size)},
+				},
+			},
+		}
+	}
+	newStorageClass := func(name string, allowExpansion bool) *storagev1.StorageClass {
+		return &storagev1.StorageClass{
+			ObjectMeta:           metav1.ObjectMeta{Name: name},
+			Provisioner:          "example.com/csi",
+			AllowVolumeExpansion: ptr.To(allowExpansion),
+		}
+	}
+	newInstance := func(size string) *ogxiov1beta1.OGXServer {
+		return &ogxiov1beta1.OGXServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec: ogxiov1beta1.OGXServerSpec{
+				Workload: &ogxiov1beta1.WorkloadSpec{
+					Storage: &ogxiov1beta1.PVCStorageSpec{Size: ptr.To(resource.MustParse(size))},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		pvc          *corev1.PersistentVolumeClaim
+		storageClass *storagev1.StorageClass
+		size         string
+		wantSize     string
+		wantEvent    string
+	}{
+		{
+			name:         "grows the PVC when the size increases",
+			pvc:          newPVC("expandable", "10Gi"),
+			storageClass: newStorageClass("expandable", true),
+			size:         "20Gi",
+			wantSize:     "20Gi",
+			wantEvent:    ReasonPVCExpanding,
+		},
+		{
+			name:         "does nothing when the size is unchanged",
+			pvc:          newPVC("expandable", "10Gi"),
+			storageClass: newStorageClass("expandable", true),
+			size:         "10Gi",
+			wantSize:     "10Gi",
+		},
+		{
+			name:         "reports a smaller size without shrinking the PVC",
+			pvc:          newPVC("expandable", "10Gi"),
+			storageClass: newStorageClass("expandable", true),
+			size:         "5Gi",
+			wantSize:     "10Gi",
+			wantEvent:    ReasonPVCShrinkUnsupported,
+		},
+		{
+			name:         "reports a StorageClass without volume expansion",
+			pvc:          newPVC("fixed", "10Gi"),
+			storageClass: newStorageClass("fixed", false),
+			size:         "20Gi",
+			wantSize:     "10Gi",
+			wantEvent:    ReasonPVCExpansionUnsupported,
+		},
+		{
+			name:      "reports a missing StorageClass",
+			pvc:       newPVC("missing", "10Gi"),
+			size:      "20Gi",
+			wantSize:  "10Gi",
+			wantEvent: ReasonPVCExpansionUnsupported,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			builder := fake.NewClientBuilder().WithObjects(tc.pvc)
+			if tc.storageClass != nil {
+				builder = builder.WithObjects(tc.storageClass)
+			}
+			recorder := record.NewFakeRecorder(1)
+			r := &OGXServerReconciler{Client: builder.Build(), Recorder: recorder}
+			instance := newInstance(tc.size)
+
+			require.NoError(t, r.reconcilePVCExpansion(t.Context(), instance))
+
+			pvc := &corev1.PersistentVolumeClaim{}
+			require.NoError(t, r.Get(t.Context(), pvcKey, pvc))
+			size := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+			assert.Equal(t, tc.wantSize, size.String())
+			assert.Equal(t, []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}, pvc.Spec.AccessModes,
+				"only the storage request should change")
+			if tc.wantEvent == ReasonPVCExpanding {
+				assert.NotEqual(t, tc.pvc.ResourceVersion, pvc.ResourceVersion)
+			} else {
+				assert.Equal(t, tc.pvc.ResourceVersion, pvc.ResourceVersion, "the PVC should not be patched")
+			}
+
+			if tc.wantEvent == "" {
+				assert.Empty(t, recorder.Events)
+			} else {
+				require.Len(t, recorder.Events, 1)
+				assert.Contains(t, <-recorder.Events, tc.wantEvent)
+			}
+
+			blocked := tc.wantEvent == ReasonPVCShrinkUnsupported || tc.wantEvent == ReasonPVCExpansionUnsupported
+			if blocked {
+				assert.True(t, IsConditionTrue(&instance.Status, ConditionTypeStorageResizeBlocked))
+			} else {
+				assert.Nil(t, GetCondition(&instance.Status, ConditionTypeStorageResizeBlocked))
+			}
+		})
+	}
+
+	t.Run("records the Warning Event only when the condition changes", func(t *testing.T) {
+		recorder := record.NewFakeRecorder(3)
+		r := &OGXServerReconciler{
+			Client:   fake.NewClientBuilder().WithObjects(newPVC("fixed", "10Gi"), newStorageClass("fixed", false)).Build(),
+			Recorder: recorder,
+		}
+		instance := newInstance("20Gi")
+
+		require.NoError(t, r.reconcilePVCExpansion(t.Context(), instance))
+		require.NoError(t, r.reconcilePVCExpansion(t.Context(), instance))
+		require.Len(t, recorder.Events, 1, "an unchanged condition should not repeat the Event")
+		assert.Contains(t, <-recorder.Events, ReasonPVCExpansionUnsupported)
+
+		instance.Spec.Workload.Storage.Size = ptr.To(resource.MustParse("5Gi"))
+		require.NoError(t, r.reconcilePVCExpansion(t.Context(), instance))
+		require.Len(t, recorder.Events, 1)
+		assert.Contains(t, <-recorder.Events, ReasonPVCShrinkUnsupported)
+
+		instance.Spec.Workload.Storage.Size = ptr.To(resource.MustParse("10Gi"))
+		require.NoError(t, r.reconcilePVCExpansion(t.Context(), instance))
+		assert.Empty(t, recorder.Events)
+		assert.True(t, IsConditionFalse(&instance.Status, ConditionTypeStorageResizeBlocked))
+	})
+
+	t.Run("does nothing before the PVC exists", func(t *testing.T) {
+		r := &OGXServerReconciler{Client: fake.NewClientBuilder().Build()}
+		require.NoError(t, r.reconcilePVCExpansion(t.Context(), newInstance("20Gi")))
+	})
+}
@@ -40,106 +40,12 @@ const (
 	testInstanceName      = "test-instance"
 )
 
-// OGXServerBuilder - Builder pattern for test instances of operator custom resource.
-type OGXServerBuilder struct {
-	instance *ogxiov1beta1.OGXServer
-}
+// OGXServerBuilder is defined in the controllers package so that its unit
+// tests can share it.
+type OGXServerBuilder = controllers.OGXServerBuilder
 
 func NewOGXServerBuilder() *OGXServerBuilder {
-	return &OGXServerBuilder{
-		instance: &ogxiov1beta1.OGXServer{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      testInstanceName,
-				Namespace: "default",
-			},
-			Spec: ogxiov1beta1.OGXServerSpec{
-				Distribution: ogxiov1beta1.DistributionSpec{
-					Name: "starter",
-				},
-			},
-		},
-	}
-}
-
-func (b *OGXServerBuilder) WithName(name string) *OGXServerBuilder {
-	b.instance.Name = name
-	return b
-}
-
-func (b *OGXServerBuilder) WithNamespace(namespace string) *OGXServerBuilder {
-	b.instance.Namespace = namespace
-	return b
-}
-
-func (b *OGXServerBuilder) WithPort(port int32) *OGXServerBuilder {
-	if b.instance.Spec.Network == nil {
-		b.instance.Spec.Network = &ogxiov1beta1.NetworkSpec{}
-	}
-	b.instance.Spec.Network.Port = port
-	return b
-}
-
-func (b *OGXServerBuilder) WithReplicas(replicas int32) *OGXServerBuilder {
-	if b.instance.Spec.Workload == nil {
-		b.instance.Spec.Workload = &ogxiov1beta1.WorkloadSpec{}
-	}
-	b.instance.Spec.Workload.Replicas = &replicas
-	return b
-}
-
-func (b *OGXServerBuilder) WithStorage(storage *ogxiov1beta1.PVCStorageSpec) *OGXServerBuilder {
-	if b.instance.Spec.Workload == nil {
-		b.instance.Spec.Workload = &ogxiov1beta1.WorkloadSpec{}
-	}
-	b.instance.Spec.Workload.Storage = storage
-	return b
-}
-
-func (b *OGXServerBuilder) WithDistribution(distributionName string) *OGXServerBuilder {
-	b.instance.Spec.Distribution.Name = distributionName
-	return b
-}
-
-func (b *OGXServerBuilder) WithResources(resources corev1.ResourceRequirements) *OGXServerBuilder {
-	if b.instance.Spec.Workload == nil {
-		b.instance.Spec.Workload = &ogxiov1beta1.WorkloadSpec{}
-	}
-	b.instance.Spec.Workload.Resources = &resources
-	return b
-}
-
-func (b *OGXServerBuilder) WithServiceAccountName(serviceAccountName string) *OGXServerBuilder {
-	if b.instance.Spec.Workload == nil {
-		b.instance.Spec.Workload = &ogxiov1beta1.WorkloadSpec{}
-	}
-	if b.instance.Spec.Workload.Overrides == nil {
-		b.instance.Spec.Workload.Overrides = &ogxiov1beta1.WorkloadOverrides{}
-	}
-	b.instance.Spec.Workload.Overrides.ServiceAccountName = serviceAccountName
-	return b
-}
-
-func (b *OGXServerBuilder) WithOverrideConfig(configMapName, key string) *OGXServerBuilder {
-	b.instance.Spec.OverrideConfig = &ogxiov1beta1.ConfigMapKeyRef{
-		Name: configMapName,
-		Key:  key,
-	}
-	return b
-}
-
-func (b *OGXServerBuilder) WithCACertificates(refs ...ogxiov1beta1.ConfigMapKeyRef) *OGXServerBuilder {
-	if b.instance.Spec.TLS == nil {
-		b.instance.Spec.TLS = &ogxiov1beta1.TLSClientConfig{}
-	}
-	if b.instance.Spec.TLS.Trust == nil {
-		b.instance.Spec.TLS.Trust = &ogxiov1beta1.TrustConfig{}
-	}
-	b.instance.Spec.TLS.Trust.CACertificates = refs
-	return b
-}
-
-func (b *OGXServerBuilder) Build() *ogxiov1beta1.OGXServer {
-	return b.instance.DeepCopy()
+	return controllers.NewOGXServerBuilder().WithName(testInstanceName)
 }
 
 func DefaultTestStorage() *ogxiov1beta1.PVCStorageSpec {
@@ -21,6 +21,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"time"
 
 	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
 	"github.com/ogx-ai/ogx-k8s-operator/controllers"
@@ -73,11 +74,42 @@ func setupWebhook(mgr ctrl.Manager, clusterInfo *cluster.ClusterInfo) error {
 	return ogxiov1beta1.SetupWebhookWithManager(mgr, distNames)
 }
 
-func setupReconciler(ctx context.Context, cli client.Client, mgr ctrl.Manager, clusterInfo *cluster.ClusterInfo, directClient client.Reader) error {
+// reconcilerOptions holds the command-line tunables of the OGXServer reconciler.
+type reconcilerOptions struct {
+	failureThreshold            int
+	backoffInterval             time.Duration
+	providerErrorGracePeriod    time.Duration
+	providerQueryRetryInterval  time.Duration
+	configMapMissingGracePeriod time.Duration
+	reportReconcileTimings      bool
+	pinImageDigests             bool
+	strictImageOverrides        bool
+	maxConcurrentReconciles     int
+}
+
+func setupReconciler(ctx context.Context, cli client.Client, mgr ctrl.Manager, clusterInfo *cluster.ClusterInfo,
+	directClient client.Reader, opts reconcilerOptions) error {
+	if opts.maxConcurrentReconciles < 1 {
+		return fmt.Errorf("max-concurrent-reconciles must be positive, got %d", opts.maxConcurrentReconciles)
+	}
 	reconciler, err := controllers.NewOGXServerReconciler(ctx, cli, scheme, clusterInfo, directClient)
 	if err != nil {
 		return fmt.Errorf("failed to create reconciler: %w", err)
 	}
+	if opts.strictImageOverrides {
+		if err = reconciler.ValidateImageOverrides(); err != nil {
+			return err
+		}
+	}
+	reconciler.ReconcileFailureThreshold = opts.failureThreshold
+	reconciler.ReconcileBackoffInterval = opts.backoffInterval
+	reconciler.ProviderErrorGracePeriod = opts.providerErrorGracePeriod
+	reconciler.ProviderQueryRetryInterval = opts.providerQueryRetryInterval
+	reconciler.ConfigMapMissingGracePeriod = opts.configMapMissingGracePeriod
+	reconciler.ReportReconcileTimings = opts.reportReconcileTimings
+	reconciler.PinImageDigests = opts.pinImageDigests
+	reconciler.MaxConcurrentReconciles = opts.maxConcurrentReconciles
+	reconciler.Recorder = mgr.GetEventRecorderFor("ogx-operator")
 	if err = reconciler.SetupWithManager(ctx, mgr); err != nil {
 		return fmt.Errorf("failed to create controller: %w", err)
 	}
@@ -98,6 +130,11 @@ func newCacheOptions() cache.Options {
 					controllers.WatchLabelKey: controllers.WatchLabelValue,
 				}),
 			},
+			&corev1.Secret{}: {
+				Label: labels.SelectorFromSet(labels.Set{
+					controllers.WatchLabelKey: controllers.WatchLabelValue,
+				}),
+			},
 			&appsv1.Deployment{}:                     managedByFilter,
 			&policyv1.PodDisruptionBudget{}:          managedByFilter,
 			&autoscalingv2.HorizontalPodAutoscaler{}: managedByFilter,
@@ -123,11 +160,31 @@ func main() {
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
+	var reconcilerOpts reconcilerOptions
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.IntVar(&reconcilerOpts.failureThreshold, "reconcile-failure-threshold", controllers.DefaultReconcileFailureThreshold,
+		"Number of consecutive reconcile failures with the same error after which active retries stop. "+
+			"Set to 0 to disable.")
+	flag.DurationVar(&reconcilerOpts.backoffInterval, "reconcile-backoff-interval", controllers.DefaultReconcileBackoffInterval,
+		"Periodic retry interval for an OGXServer whose reconcile keeps failing with the same error.")
+	flag.DurationVar(&reconcilerOpts.providerErrorGracePeriod, "provider-error-grace-period", controllers.DefaultProviderErrorGracePeriod,
+		"How long providers may report errors after the server becomes ready before the OGXServer is marked degraded.")
+	flag.DurationVar(&reconcilerOpts.providerQueryRetryInterval, "provider-query-retry-interval", controllers.DefaultProviderQueryRetryInterval,
+		"How soon a Ready OGXServer is reconciled again when its providers cannot be queried. Set to 0 to wait for the regular resync.")
+	flag.DurationVar(&reconcilerOpts.configMapMissingGracePeriod, "configmap-missing-grace-period", controllers.DefaultConfigMapMissingGracePeriod,
+		"How long a running server keeps its deployment after the override ConfigMap is deleted before reconciliation fails.")
+	flag.BoolVar(&reconcilerOpts.reportReconcileTimings, "report-reconcile-timings", false,
+		"Record per-phase durations of the last reconcile in each OGXServer status.")
+	flag.BoolVar(&reconcilerOpts.pinImageDigests, "pin-image-digests", false,
+		"Pin server images referenced by tag to the digest the tag points to when the image is first deployed.")
+	flag.BoolVar(&reconcilerOpts.strictImageOverrides, "strict-image-overrides", false,
+		"Fail startup when the image-overrides of the operator config contain invalid entries instead of skipping them.")
+	flag.IntVar(&reconcilerOpts.maxConcurrentReconciles, "max-concurrent-reconciles", 1,
+		"Number of OGXServers reconciled in parallel.")
 	opts := zap.Options{
 		Development:     false,
 		StacktraceLevel: zapcore.PanicLevel, // Set higher than ErrorLevel to avoid stack traces in logs
@@ -198,7 +255,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err := setupReconciler(ctx, setupClient, mgr, clusterInfo, setupClient); err != nil {
+	if err := setupReconciler(ctx, setupClient, mgr, clusterInfo, setupClient, reconcilerOpts); err != nil {
 		setupLog.Error(err, "failed to set up reconciler")
 		os.Exit(1)
 	}
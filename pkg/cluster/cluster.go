@@ -4,18 +4,34 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/go-logr/logr"
 	"github.com/ogx-ai/ogx-k8s-operator/pkg/deploy"
 	rbacv1 "k8s.io/api/rbac/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
+// RouteGVK identifies the OpenShift Route API.
+var RouteGVK = schema.GroupVersionKind{Group: "route.openshift.io", Version: "v1", Kind: "Route"}
+
+// DefaultConfigFileName is the config filename a server reads unless its
+// distribution catalog entry names another one.
+const DefaultConfigFileName = "config.yaml"
+
 type ClusterInfo struct {
 	OperatorNamespace  string
 	DistributionImages map[string]string
+	// DistributionConfigFiles maps distributions whose server expects a config
+	// filename other than DefaultConfigFileName to that filename.
+	DistributionConfigFiles map[string]string
+	// IsOpenShift reports whether the cluster serves the Route API, in which
+	// case external access is exposed through a Route instead of an Ingress.
+	IsOpenShift bool
 }
 
 // NewClusterInfo creates a new ClusterInfo object using embedded distributions data.
@@ -25,17 +41,73 @@ func NewClusterInfo(ctx context.Context, client client.Client, embeddedDistribut
 		return nil, fmt.Errorf("failed to find operator namespace: %w", err)
 	}
 
-	var distributionImages map[string]string
-	if err := json.Unmarshal(embeddedDistributions, &distributionImages); err != nil {
+	distributionImages, distributionConfigFiles, err := ParseDistributions(embeddedDistributions)
+	if err != nil {
 		return nil, fmt.Errorf("failed to parse embedded distributions JSON: %w", err)
 	}
 
+	isOpenShift, err := isOpenShiftCluster(client)
+	if err != nil {
+		return nil, err
+	}
+
 	return &ClusterInfo{
-		OperatorNamespace:  operatorNamespace,
-		DistributionImages: distributionImages,
+		OperatorNamespace:       operatorNamespace,
+		DistributionImages:      distributionImages,
+		DistributionConfigFiles: distributionConfigFiles,
+		IsOpenShift:             isOpenShift,
 	}, nil
 }
 
+// distributionEntry is a distributions.json entry: either an image reference,
+// or an object that also names the config file the distribution expects.
+type distributionEntry struct {
+	Image      string `json:"image"`
+	ConfigFile string `json:"configFile,omitempty"`
+}
+
+func (e *distributionEntry) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &e.Image); err == nil {
+		return nil
+	}
+	type plainEntry distributionEntry
+	return json.Unmarshal(data, (*plainEntry)(e))
+}
+
+// ParseDistributions parses the distributions catalog into the image of each
+// distribution and the config filename of those that declare one.
+func ParseDistributions(data []byte) (map[string]string, map[string]string, error) {
+	var entries map[string]distributionEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, nil, err
+	}
+
+	images := make(map[string]string, len(entries))
+	configFiles := make(map[string]string)
+	for name, entry := range entries {
+		if strings.Contains(entry.ConfigFile, "/") || entry.ConfigFile == "." || entry.ConfigFile == ".." {
+			return nil, nil, fmt.Errorf("failed to validate distribution %q: configFile %q must be a file name", name, entry.ConfigFile)
+		}
+		images[name] = entry.Image
+		if entry.ConfigFile != "" {
+			configFiles[name] = entry.ConfigFile
+		}
+	}
+	return images, configFiles, nil
+}
+
+// isOpenShiftCluster reports whether the cluster serves the OpenShift Route API.
+func isOpenShiftCluster(client client.Client) (bool, error) {
+	_, err := client.RESTMapper().RESTMapping(RouteGVK.GroupKind(), RouteGVK.Version)
+	if err == nil {
+		return true, nil
+	}
+	if meta.IsNoMatchError(err) {
+		return false, nil
+	}
+	return false, fmt.Errorf("failed to discover the Route API: %w", err)
+}
+
 // PerformUpgradeCleanup performs one-time cleanup operations for seamless upgrades.
 func PerformUpgradeCleanup(ctx context.Context, client client.Client) error {
 	logger := log.FromContext(ctx).WithName("upgrade-cleanup")
@@ -1,7 +1,7 @@
 package cluster
 
 import (
-	"encoding/json"
+	"maps"
 	"os"
 	"testing"
 )
@@ -14,8 +14,8 @@ func TestDistributionsJSONIsValid(t *testing.T) {
 		t.Fatalf("failed to read distributions.json: %v", err)
 	}
 
-	var dist map[string]string
-	if err := json.Unmarshal(data, &dist); err != nil {
+	dist, _, err := ParseDistributions(data)
+	if err != nil {
 		t.Fatalf("failed to validate distributions.json: %v", err)
 	}
 
@@ -28,3 +28,36 @@ func TestDistributionsJSONIsValid(t *testing.T) {
 		}
 	}
 }
+
+// TestParseDistributions covers catalog entries that name the config file
+// their distribution expects.
+func TestParseDistributions(t *testing.T) {
+	images, configFiles, err := ParseDistributions([]byte(`{
+  "starter": "docker.io/ogx/starter:latest",
+  "legacy": {"image": "docker.io/llamastack/legacy:0.2.10", "configFile": "run.yaml"},
+  "object": {"image": "docker.io/ogx/object:latest"}
+}`))
+	if err != nil {
+		t.Fatalf("failed to parse distributions: %v", err)
+	}
+
+	wantImages := map[string]string{
+		"starter": "docker.io/ogx/starter:latest",
+		"legacy":  "docker.io/llamastack/legacy:0.2.10",
+		"object":  "docker.io/ogx/object:latest",
+	}
+	if !maps.Equal(images, wantImages) {
+		t.Fatalf("images = %v, want %v", images, wantImages)
+	}
+	wantConfigFiles := map[string]string{"legacy": "run.yaml"}
+	if !maps.Equal(configFiles, wantConfigFiles) {
+		t.Fatalf("config files = %v, want %v", configFiles, wantConfigFiles)
+	}
+
+	for _, configFile := range []string{"../run.yaml", "config/run.yaml", ".."} {
+		data := []byte(`{"legacy": {"image": "docker.io/llamastack/legacy:0.2.10", "configFile": "` + configFile + `"}}`)
+		if _, _, err := ParseDistributions(data); err == nil {
+			t.Fatalf("expected configFile %q to be rejected", configFile)
+		}
+	}
+}
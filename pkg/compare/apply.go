@@ -0,0 +1,210 @@
+package compare
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// NeedsApply reports whether a server-side apply of desired by fieldManager
+// would change existing. It returns false only when every field set in
+// desired already has the same value in existing, and every field
+// fieldManager applied before is still set in desired, so that applying would
+// neither change nor remove anything. Fields that desired leaves unset, such
+// as server defaults and status, are ignored. Without an earlier apply by
+// fieldManager, it always returns true.
+func NeedsApply(desired, existing *unstructured.Unstructured, fieldManager string) (bool, error) {
+	owned, found, err := appliedFields(existing, fieldManager)
+	if err != nil || !found {
+		return true, err
+	}
+	if !isSubset(desired.Object, existing.Object) {
+		return true, nil
+	}
+	return !ownedFieldsSet(owned, desired.Object), nil
+}
+
+// appliedFields returns the fields fieldManager owns through apply operations.
+func appliedFields(obj *unstructured.Unstructured, fieldManager string) (map[string]any, bool, error) {
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Manager != fieldManager || entry.Operation != metav1.ManagedFieldsOperationApply || entry.FieldsV1 == nil {
+			continue
+		}
+		var fields map[string]any
+		if err := json.Unmarshal(entry.FieldsV1.Raw, &fields); err != nil {
+			return nil, false, fmt.Errorf("failed to parse managed fields of %s: %w", fieldManager, err)
+		}
+		return fields, true, nil
+	}
+	return nil, false, nil
+}
+
+// isSubset reports whether every value set in desired equals the value at the
+// same path in existing. Lists must have the same length and match element by element.
+func isSubset(desired, existing any) bool {
+	switch d := desired.(type) {
+	case nil:
+		return true
+	case map[string]any:
+		e, ok := existing.(map[string]any)
+		if !ok {
+			return len(d) == 0 && existing == nil
+		}
+		for key, value := range d {
+			if !isSubset(value, e[key]) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		e, ok := existing.([]any)
+		if !ok {
+			return len(d) == 0 && existing == nil
+		}
+		if len(d) != len(e) {
+			return false
+		}
+		for i := range d {
+			if !isSubset(d[i], e[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return scalarEqual(desired, existing)
+	}
+}
+
+// ownedFieldsSet reports whether every field in the managed fields set owned
+// is still set in desired. See the FieldsV1 documentation for the format.
+func ownedFieldsSet(owned map[string]any, desired any) bool {
+	for key, child := range owned {
+		if key == "." {
+			continue
+		}
+		prefix, value, _ := strings.Cut(key, ":")
+		var next any
+		var ok bool
+		switch prefix {
+		case "f":
+			next, ok = lookupField(desired, value)
+		case "k":
+			next, ok = lookupListKey(desired, value)
+		case "v":
+			next, ok = lookupListValue(desired, value)
+		case "i":
+			next, ok = lookupListIndex(desired, value)
+		default:
+			return false
+		}
+		if !ok {
+			return false
+		}
+		if children, isMap := child.(map[string]any); isMap && !ownedFieldsSet(children, next) {
+			return false
+		}
+	}
+	return true
+}
+
+func lookupField(desired any, name string) (any, bool) {
+	m, ok := desired.(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	value, ok := m[name]
+	return value, ok && value != nil
+}
+
+// lookupListKey finds the list element identified by the JSON encoded key
+// fields. Key fields the element leaves unset, such as a defaulted port
+// protocol, are not compared.
+func lookupListKey(desired any, encodedKey string) (any, bool) {
+	list, ok := desired.([]any)
+	if !ok {
+		return nil, false
+	}
+	var key map[string]any
+	if err := json.Unmarshal([]byte(encodedKey), &key); err != nil {
+		return nil, false
+	}
+	for _, item := range list {
+		element, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		matches := true
+		for field, want := range key {
+			if got, set := element[field]; set && !scalarEqual(got, want) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return element, true
+		}
+	}
+	return nil, false
+}
+
+func lookupListValue(desired any, encodedValue string) (any, bool) {
+	list, ok := desired.([]any)
+	if !ok {
+		return nil, false
+	}
+	var want any
+	if err := json.Unmarshal([]byte(encodedValue), &want); err != nil {
+		return nil, false
+	}
+	for _, item := range list {
+		if scalarEqual(item, want) {
+			return item, true
+		}
+	}
+	return nil, false
+}
+
+func lookupListIndex(desired any, encodedIndex string) (any, bool) {
+	list, ok := desired.([]any)
+	if !ok {
+		return nil, false
+	}
+	index, err := strconv.Atoi(encodedIndex)
+	if err != nil || index < 0 || index >= len(list) {
+		return nil, false
+	}
+	return list[index], true
+}
+
+// scalarEqual compares scalars, treating numbers of different Go types as equal
+// when their values are.
+func scalarEqual(a, b any) bool {
+	if x, ok := toFloat(a); ok {
+		y, ok := toFloat(b)
+		return ok && x == y
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func toFloat(v any) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case float64:
+		return n, true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,155 @@
+package compare_test
+
+import (
+	"testing"
+
+	"github.com/ogx-ai/ogx-k8s-operator/pkg/compare"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const testFieldManager = "ogx-operator"
+
+// desiredDeployment returns the deployment as the operator would apply it.
+func desiredDeployment() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]any{
+			"name":      "test",
+			"namespace": "default",
+			"labels":    map[string]any{"app": "test"},
+		},
+		"spec": map[string]any{
+			"replicas": int64(1),
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []any{map[string]any{
+						"name":  "server",
+						"image": "server:1",
+						"env":   []any{map[string]any{"name": "OGX_PORT", "value": "8321"}},
+						"ports": []any{map[string]any{"containerPort": int64(8321)}},
+					}},
+				},
+			},
+		},
+	}}
+}
+
+// appliedFieldsV1 is the field set the operator owns after applying desiredDeployment.
+const appliedFieldsV1 = `{
+	"f:metadata": {"f:labels": {"f:app": {}}},
+	"f:spec": {
+		"f:replicas": {},
+		"f:template": {"f:spec": {"f:containers": {"k:{\"name\":\"server\"}": {
+			".": {},
+			"f:env": {"k:{\"name\":\"OGX_PORT\"}": {".": {}, "f:name": {}, "f:value": {}}},
+			"f:image": {},
+			"f:name": {},
+			"f:ports": {"k:{\"containerPort\":8321,\"protocol\":\"TCP\"}": {".": {}, "f:containerPort": {}}}
+		}}}}
+	}
+}`
+
+// existingDeployment returns desiredDeployment as stored by the API server,
+// with defaults, status and the operator's managed fields.
+func existingDeployment(t *testing.T) *unstructured.Unstructured {
+	t.Helper()
+	existing := desiredDeployment().DeepCopy()
+	existing.SetResourceVersion("42")
+	existing.SetUID("uid")
+	existing.SetManagedFields([]metav1.ManagedFieldsEntry{{
+		Manager:    testFieldManager,
+		Operation:  metav1.ManagedFieldsOperationApply,
+		APIVersion: "apps/v1",
+		FieldsType: "FieldsV1",
+		FieldsV1:   &metav1.FieldsV1{Raw: []byte(appliedFieldsV1)},
+	}})
+	spec := existing.Object["spec"].(map[string]any)
+	spec["revisionHistoryLimit"] = int64(10)
+	container := spec["template"].(map[string]any)["spec"].(map[string]any)["containers"].([]any)[0].(map[string]any)
+	container["terminationMessagePath"] = "/dev/termination-log"
+	container["ports"].([]any)[0].(map[string]any)["protocol"] = "TCP"
+	existing.Object["status"] = map[string]any{"replicas": int64(1)}
+	return existing
+}
+
+func TestNeedsApply(t *testing.T) {
+	testCases := []struct {
+		name     string
+		desired  func(d *unstructured.Unstructured)
+		existing func(e *unstructured.Unstructured)
+		want     bool
+	}{
+		{
+			name: "nothing changed apart from server-managed fields",
+			want: false,
+		},
+		{
+			name: "a desired value changed",
+			desired: func(d *unstructured.Unstructured) {
+				require.NoError(t, unstructured.SetNestedField(d.Object, int64(2), "spec", "replicas"))
+			},
+			want: true,
+		},
+		{
+			name: "a field was added",
+			desired: func(d *unstructured.Unstructured) {
+				require.NoError(t, unstructured.SetNestedField(d.Object, "Recreate", "spec", "strategy", "type"))
+			},
+			want: true,
+		},
+		{
+			name: "an applied field was removed",
+			desired: func(d *unstructured.Unstructured) {
+				unstructured.RemoveNestedField(d.Object, "metadata", "labels")
+			},
+			want: true,
+		},
+		{
+			name: "an applied list element was removed",
+			desired: func(d *unstructured.Unstructured) {
+				container := d.Object["spec"].(map[string]any)["template"].(map[string]any)["spec"].(map[string]any)["containers"].([]any)[0].(map[string]any)
+				delete(container, "env")
+			},
+			want: true,
+		},
+		{
+			name: "another manager changed an applied value",
+			existing: func(e *unstructured.Unstructured) {
+				require.NoError(t, unstructured.SetNestedField(e.Object, int64(3), "spec", "replicas"))
+			},
+			want: true,
+		},
+		{
+			name: "the operator never applied the resource",
+			existing: func(e *unstructured.Unstructured) {
+				e.SetManagedFields([]metav1.ManagedFieldsEntry{{
+					Manager:   "manager",
+					Operation: metav1.ManagedFieldsOperationUpdate,
+					FieldsV1:  &metav1.FieldsV1{Raw: []byte(appliedFieldsV1)},
+				}})
+			},
+			want: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			desired := desiredDeployment()
+			if tc.desired != nil {
+				tc.desired(desired)
+			}
+			existing := existingDeployment(t)
+			if tc.existing != nil {
+				tc.existing(existing)
+			}
+
+			got, err := compare.NeedsApply(desired, existing, testFieldManager)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -1,10 +1,12 @@
 package deploy
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"path/filepath"
 	"slices"
 	"sort"
@@ -34,7 +36,13 @@ import (
 	yamlpkg "sigs.k8s.io/yaml"
 )
 
-const deploymentKind = "Deployment"
+const (
+	deploymentKind            = "Deployment"
+	serviceKind               = "Service"
+	persistentVolumeClaimKind = "PersistentVolumeClaim"
+	// fieldManager is the field manager of server-side applies by the operator.
+	fieldManager = "ogx-operator"
+)
 
 // RenderManifest takes a manifest directory and transforms it through
 // kustomization and plugins to produce final Kubernetes resources.
@@ -141,7 +149,7 @@ func createResource(
 	if err != nil {
 		return fmt.Errorf("failed to determine resource scope: %w", err)
 	}
-	skipOwnerRef := isClusterScoped || gvk.Kind == "PersistentVolumeClaim"
+	skipOwnerRef := isClusterScoped || gvk.Kind == persistentVolumeClaimKind
 	if !skipOwnerRef {
 		if err := ctrl.SetControllerReference(ownerInstance, obj, scheme); err != nil {
 			return fmt.Errorf("failed to set controller reference for %s: %w", gvk.Kind, err)
@@ -181,15 +189,19 @@ func patchResource(ctx context.Context, cli client.Client, desired, existing *un
 	}
 
 	switch existing.GetKind() {
-	case "PersistentVolumeClaim":
+	case persistentVolumeClaimKind:
+		// Size increases are applied by the controller, which patches only the storage request.
 		logger.V(1).Info("Skipping PVC patch - PVCs are immutable after creation",
 			"name", existing.GetName(),
 			"namespace", existing.GetNamespace())
 		return nil
-	case "Service":
+	case serviceKind:
 		if err := compare.CheckAndLogServiceChanges(ctx, cli, desired); err != nil {
 			return fmt.Errorf("failed to validate resource mutations while patching: %w", err)
 		}
+		if err := removeExtraSelectorKeys(ctx, cli, desired, existing); err != nil {
+			return err
+		}
 	case deploymentKind:
 		// Some volume changes cannot be handled by SSA because the volumes were originally
 		// created via cli.Create (no SSA field manager tracking), so SSA cannot remove
@@ -204,6 +216,19 @@ func patchResource(ctx context.Context, cli client.Client, desired, existing *un
 		}
 	}
 
+	// Skip the apply when it would not change anything, to avoid resourceVersion churn.
+	needsApply, err := compare.NeedsApply(desired, existing, fieldManager)
+	if err != nil {
+		return fmt.Errorf("failed to compare desired and existing state: %w", err)
+	}
+	if !needsApply {
+		logger.V(1).Info("Skipping patch - resource is up to date",
+			"kind", existing.GetKind(),
+			"name", existing.GetName(),
+			"namespace", existing.GetNamespace())
+		return nil
+	}
+
 	data, err := json.Marshal(desired)
 	if err != nil {
 		return fmt.Errorf("failed to marshal desired state: %w", err)
@@ -214,10 +239,41 @@ func patchResource(ctx context.Context, cli client.Client, desired, existing *un
 		existing,
 		client.RawPatch(k8stypes.ApplyPatchType, data),
 		client.ForceOwnership,
-		client.FieldOwner("ogx-operator"),
+		client.FieldOwner(fieldManager),
 	)
 }
 
+// removeExtraSelectorKeys removes Service selector keys the operator does not set.
+// Server-side apply cannot remove keys owned by another field manager, so a
+// selector edited by hand would otherwise keep matching unrelated pods.
+func removeExtraSelectorKeys(ctx context.Context, cli client.Client, desired, existing *unstructured.Unstructured) error {
+	desiredSelector, _, _ := unstructured.NestedStringMap(desired.Object, "spec", "selector")
+	existingSelector, _, _ := unstructured.NestedStringMap(existing.Object, "spec", "selector")
+
+	extra := map[string]any{}
+	for key := range existingSelector {
+		if _, ok := desiredSelector[key]; !ok {
+			extra[key] = nil
+		}
+	}
+	if len(extra) == 0 {
+		return nil
+	}
+
+	log.FromContext(ctx).Info("Removing unexpected Service selector keys",
+		"service", existing.GetName(),
+		"namespace", existing.GetNamespace(),
+		"keys", slices.Sorted(maps.Keys(extra)))
+	data, err := json.Marshal(map[string]any{"spec": map[string]any{"selector": extra}})
+	if err != nil {
+		return fmt.Errorf("failed to marshal selector patch: %w", err)
+	}
+	if err := cli.Patch(ctx, existing, client.RawPatch(k8stypes.MergePatchType, data)); err != nil {
+		return fmt.Errorf("failed to remove unexpected Service selector keys: %w", err)
+	}
+	return nil
+}
+
 // applyPlugins runs all Go-based transformations on the resource map.
 func applyPlugins(resMap *resmap.ResMap, ownerInstance *ogxiov1beta1.OGXServer) error {
 	namePrefixPlugin := plugins.CreateNamePrefixPlugin(plugins.NamePrefixConfig{
@@ -244,6 +300,11 @@ func applyPlugins(resMap *resmap.ResMap, ownerInstance *ogxiov1beta1.OGXServer)
 		return fmt.Errorf("failed to apply field transformer: %w", err)
 	}
 
+	// Field mappings only target the first Service port, so additional ports are appended here
+	if err := addAdditionalServicePorts(*resMap, ownerInstance); err != nil {
+		return fmt.Errorf("failed to add additional Service ports: %w", err)
+	}
+
 	// Apply NetworkPolicy transformer to configure ingress rules based on spec.network
 	if err := applyNetworkPolicyTransformer(resMap, ownerInstance); err != nil {
 		return fmt.Errorf("failed to apply NetworkPolicy transformer: %w", err)
@@ -255,6 +316,96 @@ func applyPlugins(resMap *resmap.ResMap, ownerInstance *ogxiov1beta1.OGXServer)
 		}
 	}
 
+	// Storage metadata is applied before common metadata so that it takes precedence on the PVC.
+	if err := applyStorageMetadata(*resMap, ownerInstance); err != nil {
+		return err
+	}
+
+	// Likewise for the Deployment annotations on the Deployment.
+	if err := applyDeploymentAnnotations(*resMap, ownerInstance); err != nil {
+		return err
+	}
+
+	// Likewise for the load balancer annotations on the Service.
+	if err := applyLoadBalancerAnnotations(*resMap, ownerInstance); err != nil {
+		return err
+	}
+
+	// Common metadata is applied last so that it never overrides operator-managed keys.
+	var commonMetadata plugins.CommonMetadataConfig
+	if ownerInstance.Spec.Workload != nil {
+		commonMetadata.Labels = ownerInstance.Spec.Workload.CommonLabels
+		commonMetadata.Annotations = ownerInstance.Spec.Workload.CommonAnnotations
+	}
+	commonMetadataPlugin, err := plugins.CreateCommonMetadataPlugin(commonMetadata)
+	if err != nil {
+		return err
+	}
+	if err := commonMetadataPlugin.Transform(*resMap); err != nil {
+		return fmt.Errorf("failed to apply common metadata plugin: %w", err)
+	}
+
+	return nil
+}
+
+// applyStorageMetadata adds the storage labels and annotations to the PVC.
+func applyStorageMetadata(resMap resmap.ResMap, ownerInstance *ogxiov1beta1.OGXServer) error {
+	if ownerInstance.Spec.Workload == nil || ownerInstance.Spec.Workload.Storage == nil {
+		return nil
+	}
+	storage := ownerInstance.Spec.Workload.Storage
+	storageMetadataPlugin, err := plugins.CreateCommonMetadataPlugin(plugins.CommonMetadataConfig{
+		Labels:      storage.Labels,
+		Annotations: storage.Annotations,
+		Kinds:       []string{persistentVolumeClaimKind},
+	})
+	if err != nil {
+		return err
+	}
+	if err := storageMetadataPlugin.Transform(resMap); err != nil {
+		return fmt.Errorf("failed to apply storage metadata plugin: %w", err)
+	}
+	return nil
+}
+
+// applyDeploymentAnnotations adds the Deployment annotations to the metadata
+// of the Deployment. The pod template, which carries the restart hashes, is
+// left unchanged.
+func applyDeploymentAnnotations(resMap resmap.ResMap, ownerInstance *ogxiov1beta1.OGXServer) error {
+	if ownerInstance.Spec.Workload == nil || len(ownerInstance.Spec.Workload.DeploymentAnnotations) == 0 {
+		return nil
+	}
+	deploymentAnnotationsPlugin, err := plugins.CreateCommonMetadataPlugin(plugins.CommonMetadataConfig{
+		Annotations:     ownerInstance.Spec.Workload.DeploymentAnnotations,
+		Kinds:           []string{deploymentKind},
+		SkipPodTemplate: true,
+	})
+	if err != nil {
+		return err
+	}
+	if err := deploymentAnnotationsPlugin.Transform(resMap); err != nil {
+		return fmt.Errorf("failed to apply deployment annotations plugin: %w", err)
+	}
+	return nil
+}
+
+// applyLoadBalancerAnnotations adds the load balancer annotations to the
+// Service. They are ignored unless the Service is of type LoadBalancer.
+func applyLoadBalancerAnnotations(resMap resmap.ResMap, ownerInstance *ogxiov1beta1.OGXServer) error {
+	network := ownerInstance.Spec.Network
+	if network == nil || len(network.LoadBalancerAnnotations) == 0 || getServiceType(ownerInstance) != corev1.ServiceTypeLoadBalancer {
+		return nil
+	}
+	loadBalancerAnnotationsPlugin, err := plugins.CreateCommonMetadataPlugin(plugins.CommonMetadataConfig{
+		Annotations: network.LoadBalancerAnnotations,
+		Kinds:       []string{serviceKind},
+	})
+	if err != nil {
+		return err
+	}
+	if err := loadBalancerAnnotationsPlugin.Transform(resMap); err != nil {
+		return fmt.Errorf("failed to apply load balancer annotations plugin: %w", err)
+	}
 	return nil
 }
 
@@ -275,6 +426,51 @@ func applyNetworkPolicyTransformer(resMap *resmap.ResMap, ownerInstance *ogxiov1
 	return npTransformer.Transform(*resMap)
 }
 
+// addAdditionalServicePorts appends the additional ports of spec.network to the
+// Service, after the server port.
+func addAdditionalServicePorts(resMap resmap.ResMap, ownerInstance *ogxiov1beta1.OGXServer) error {
+	if ownerInstance.Spec.Network == nil || len(ownerInstance.Spec.Network.AdditionalPorts) == 0 {
+		return nil
+	}
+
+	for _, res := range resMap.Resources() {
+		if res.GetKind() != serviceKind {
+			continue
+		}
+
+		data, err := parseResourceYAML(res)
+		if err != nil {
+			return err
+		}
+
+		spec, ok := data["spec"].(map[string]any)
+		if !ok {
+			return errors.New("failed to find spec in Service")
+		}
+
+		ports, _ := spec["ports"].([]any)
+		for _, port := range ownerInstance.Spec.Network.AdditionalPorts {
+			protocol := port.Protocol
+			if protocol == "" {
+				protocol = corev1.ProtocolTCP
+			}
+			ports = append(ports, map[string]any{
+				"name":       port.Name,
+				"protocol":   string(protocol),
+				"port":       int64(port.ContainerPort),
+				"targetPort": int64(port.ContainerPort),
+			})
+		}
+		spec["ports"] = ports
+
+		if err := updateResourceFromData(res, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // removeDeploymentReplicas deletes spec.replicas from Deployment manifests so that
 // the HPA (or default Kubernetes behavior) controls the replica count.
 func removeDeploymentReplicas(resMap resmap.ResMap) error {
@@ -318,20 +514,153 @@ func getFieldMappings(ownerInstance *ogxiov1beta1.OGXServer) []plugins.FieldMapp
 
 	mappings := buildFieldMappings(instanceName, instanceNamespace, serviceAccountName, servicePort, storageSize, instanceLabelPath, GetEffectiveReplicas(ownerInstance))
 
-	// When persistent storage is configured, use Recreate strategy to avoid
-	// RWO PVC multi-attach deadlock during rolling updates
-	if ownerInstance.Spec.Workload != nil && ownerInstance.Spec.Workload.Storage != nil {
+	mappings = append(mappings, plugins.FieldMapping{
+		SourceValue:       getRevisionHistoryLimit(ownerInstance),
+		TargetField:       "/spec/revisionHistoryLimit",
+		TargetKind:        deploymentKind,
+		CreateIfNotExists: true,
+	})
+	mappings = append(mappings, plugins.FieldMapping{
+		SourceValue:       string(getServiceType(ownerInstance)),
+		TargetField:       "/spec/type",
+		TargetKind:        serviceKind,
+		CreateIfNotExists: true,
+	})
+	mappings = append(mappings, plugins.FieldMapping{
+		SourceValue:       ogxiov1beta1.DefaultLabelValue,
+		TargetField:       "/spec/selector/" + ogxiov1beta1.DefaultLabelKey,
+		TargetKind:        serviceKind,
+		CreateIfNotExists: true,
+	})
+	mappings = append(mappings, getStrategyMappings(ownerInstance)...)
+	mappings = append(mappings, getStorageMappings(ownerInstance)...)
+
+	return mappings
+}
+
+// getRevisionHistoryLimit returns the number of old ReplicaSets the Deployment keeps.
+func getRevisionHistoryLimit(ownerInstance *ogxiov1beta1.OGXServer) int32 {
+	if ownerInstance.Spec.Workload != nil && ownerInstance.Spec.Workload.RevisionHistoryLimit != nil {
+		return *ownerInstance.Spec.Workload.RevisionHistoryLimit
+	}
+	return ogxiov1beta1.DefaultRevisionHistoryLimit
+}
+
+// getStorageMappings returns the PVC access mode and StorageClass mappings.
+// Unset fields keep the base manifest and cluster defaults.
+func getStorageMappings(ownerInstance *ogxiov1beta1.OGXServer) []plugins.FieldMapping {
+	if ownerInstance.Spec.Workload == nil || ownerInstance.Spec.Workload.Storage == nil {
+		return nil
+	}
+	storage := ownerInstance.Spec.Workload.Storage
+
+	var mappings []plugins.FieldMapping
+	if len(storage.AccessModes) > 0 {
+		accessModes := make([]any, 0, len(storage.AccessModes))
+		for _, mode := range storage.AccessModes {
+			accessModes = append(accessModes, string(mode))
+		}
 		mappings = append(mappings, plugins.FieldMapping{
-			SourceValue:       "Recreate",
-			TargetField:       "/spec/strategy/type",
-			TargetKind:        "Deployment",
+			SourceValue:       accessModes,
+			TargetField:       "/spec/accessModes",
+			TargetKind:        persistentVolumeClaimKind,
 			CreateIfNotExists: true,
 		})
 	}
+	if storage.StorageClassName != nil {
+		mappings = append(mappings, plugins.FieldMapping{
+			SourceValue:       *storage.StorageClassName,
+			TargetField:       "/spec/storageClassName",
+			TargetKind:        persistentVolumeClaimKind,
+			CreateIfNotExists: true,
+		})
+	}
+	return mappings
+}
 
+// getStrategyMappings returns the Deployment update strategy mappings. An
+// explicit workload.deploymentStrategy wins. Otherwise storage that is not
+// ReadWriteMany selects Recreate to avoid the RWO PVC multi-attach deadlock
+// during rolling updates, and the rollout health gate keeps all old pods until
+// the new ones are Ready.
+func getStrategyMappings(ownerInstance *ogxiov1beta1.OGXServer) []plugins.FieldMapping {
+	strategyType, maxSurge, maxUnavailable := getStrategy(ownerInstance.Spec.Workload, nil)
+	if strategyType == "" {
+		return nil
+	}
+	mappings := []plugins.FieldMapping{{
+		SourceValue:       string(strategyType),
+		TargetField:       "/spec/strategy/type",
+		TargetKind:        deploymentKind,
+		CreateIfNotExists: true,
+	}}
+	if maxSurge != nil {
+		mappings = append(mappings, plugins.FieldMapping{
+			SourceValue:       intOrStringToInterface(maxSurge),
+			TargetField:       "/spec/strategy/rollingUpdate/maxSurge",
+			TargetKind:        deploymentKind,
+			CreateIfNotExists: true,
+		})
+	}
+	if maxUnavailable != nil {
+		mappings = append(mappings, plugins.FieldMapping{
+			SourceValue:       intOrStringToInterface(maxUnavailable),
+			TargetField:       "/spec/strategy/rollingUpdate/maxUnavailable",
+			TargetKind:        deploymentKind,
+			CreateIfNotExists: true,
+		})
+	}
 	return mappings
 }
 
+// GetEffectiveStrategyType returns the update strategy type of the Deployment,
+// RollingUpdate when the operator leaves the Kubernetes default in place.
+// pvcAccessModes are the access modes of the existing storage PVC, or nil
+// before it is created.
+func GetEffectiveStrategyType(instance *ogxiov1beta1.OGXServer,
+	pvcAccessModes []corev1.PersistentVolumeAccessMode) ogxiov1beta1.DeploymentStrategyType {
+	if strategyType, _, _ := getStrategy(instance.Spec.Workload, pvcAccessModes); strategyType != "" {
+		return strategyType
+	}
+	return ogxiov1beta1.DeploymentStrategyRollingUpdate
+}
+
+// getStrategy returns the update strategy type and rolling update parameters
+// selected for workload. An empty type leaves the Kubernetes default in place.
+// Access modes are immutable, so pvcAccessModes, those of the existing storage
+// PVC, take precedence over storage.accessModes when set.
+func getStrategy(workload *ogxiov1beta1.WorkloadSpec,
+	pvcAccessModes []corev1.PersistentVolumeAccessMode) (ogxiov1beta1.DeploymentStrategyType, *intstr.IntOrString, *intstr.IntOrString) {
+	if workload == nil {
+		return "", nil, nil
+	}
+	accessModes := pvcAccessModes
+	if len(accessModes) == 0 && workload.Storage != nil {
+		accessModes = workload.Storage.AccessModes
+	}
+
+	var strategyType ogxiov1beta1.DeploymentStrategyType
+	var maxSurge, maxUnavailable *intstr.IntOrString
+	switch {
+	case workload.DeploymentStrategy != nil:
+		strategyType = workload.DeploymentStrategy.Type
+		if strategyType == "" {
+			strategyType = ogxiov1beta1.DeploymentStrategyRollingUpdate
+		}
+		maxSurge = workload.DeploymentStrategy.MaxSurge
+		maxUnavailable = workload.DeploymentStrategy.MaxUnavailable
+	case workload.Storage != nil && !slices.Contains(accessModes, corev1.ReadWriteMany):
+		strategyType = ogxiov1beta1.DeploymentStrategyRecreate
+	}
+
+	if workload.RolloutHealthGate != nil && strategyType != ogxiov1beta1.DeploymentStrategyRecreate && maxUnavailable == nil {
+		strategyType = ogxiov1beta1.DeploymentStrategyRollingUpdate
+		zero := intstr.FromInt32(0)
+		maxUnavailable = &zero
+	}
+	return strategyType, maxSurge, maxUnavailable
+}
+
 // buildFieldMappings constructs the field mappings array.
 func buildFieldMappings(instanceName, instanceNamespace, serviceAccountName string,
 	servicePort any, storageSize, instanceLabelPath string, replicas int32) []plugins.FieldMapping {
@@ -341,7 +670,7 @@ func buildFieldMappings(instanceName, instanceNamespace, serviceAccountName stri
 			SourceValue:       storageSize,
 			DefaultValue:      ogxiov1beta1.DefaultStorageSize.String(),
 			TargetField:       "/spec/resources/requests/storage",
-			TargetKind:        "PersistentVolumeClaim",
+			TargetKind:        persistentVolumeClaimKind,
 			CreateIfNotExists: true,
 		},
 		{
@@ -439,6 +768,14 @@ func getServicePort(instance *ogxiov1beta1.OGXServer) any {
 	return nil
 }
 
+// getServiceType returns the type of the server Service, ClusterIP unless set.
+func getServiceType(instance *ogxiov1beta1.OGXServer) corev1.ServiceType {
+	if instance.Spec.Network != nil && instance.Spec.Network.ServiceType != "" {
+		return instance.Spec.Network.ServiceType
+	}
+	return corev1.ServiceTypeClusterIP
+}
+
 func isAutoscalingEnabled(instance *ogxiov1beta1.OGXServer) bool {
 	if instance == nil || instance.Spec.Workload == nil || instance.Spec.Workload.Autoscaling == nil {
 		return false
@@ -452,10 +789,19 @@ type ManifestContext struct {
 	ResolvedImage           string
 	ConfigMapHash           string
 	CABundleHash            string
+	SecretHash              string
+	EnvSourceHash           string
+	StartupScriptHash       string
+	ConfigMountsHash        string
+	RestartedAt             string
 	ContainerSpec           map[string]any
 	PodSpec                 map[string]any
 	PodDisruptionBudgetSpec *policyv1.PodDisruptionBudgetSpec
 	HPASpec                 *autoscalingv2.HorizontalPodAutoscalerSpec
+	// PVCAccessModes are the access modes of the existing storage PVC, nil
+	// before it is created. They select the update strategy in place of
+	// storage.accessModes, which cannot change those of an existing PVC.
+	PVCAccessModes []corev1.PersistentVolumeAccessMode
 }
 
 // RenderManifestWithContext renders manifests and enhances the Deployment with complex specs.
@@ -480,7 +826,7 @@ func RenderManifestWithContext(
 	for _, res := range (*resMap).Resources() {
 		switch res.GetKind() {
 		case deploymentKind:
-			if err := updateDeploymentSpec(res, manifestCtx); err != nil {
+			if err := updateDeploymentSpec(res, ownerInstance, manifestCtx); err != nil {
 				return nil, fmt.Errorf("failed to update Deployment: %w", err)
 			}
 		case "PodDisruptionBudget":
@@ -498,7 +844,7 @@ func RenderManifestWithContext(
 }
 
 // updateDeploymentSpec updates the Deployment spec with the manifest context.
-func updateDeploymentSpec(res *resource.Resource, manifestCtx *ManifestContext) error {
+func updateDeploymentSpec(res *resource.Resource, ownerInstance *ogxiov1beta1.OGXServer, manifestCtx *ManifestContext) error {
 	// Parse the deployment YAML
 	data, err := parseResourceYAML(res)
 	if err != nil {
@@ -530,10 +876,45 @@ func updateDeploymentSpec(res *resource.Resource, manifestCtx *ManifestContext)
 		return err
 	}
 
+	if manifestCtx.PVCAccessModes != nil {
+		if err := setDeploymentStrategy(data, ownerInstance.Spec.Workload, manifestCtx.PVCAccessModes); err != nil {
+			return err
+		}
+	}
+
 	// Update the resource with the manifest context
 	return updateResourceFromData(res, data)
 }
 
+// setDeploymentStrategy replaces the Deployment update strategy with the one
+// selected for workload and the access modes of the existing storage PVC.
+func setDeploymentStrategy(data map[string]any, workload *ogxiov1beta1.WorkloadSpec,
+	pvcAccessModes []corev1.PersistentVolumeAccessMode) error {
+	spec, ok := data["spec"].(map[string]any)
+	if !ok {
+		return errors.New("failed to find deployment spec in data")
+	}
+
+	strategyType, maxSurge, maxUnavailable := getStrategy(workload, pvcAccessModes)
+	if strategyType == "" {
+		delete(spec, "strategy")
+		return nil
+	}
+	strategy := map[string]any{"type": string(strategyType)}
+	rollingUpdate := map[string]any{}
+	if maxSurge != nil {
+		rollingUpdate["maxSurge"] = intOrStringToInterface(maxSurge)
+	}
+	if maxUnavailable != nil {
+		rollingUpdate["maxUnavailable"] = intOrStringToInterface(maxUnavailable)
+	}
+	if len(rollingUpdate) > 0 {
+		strategy["rollingUpdate"] = rollingUpdate
+	}
+	spec["strategy"] = strategy
+	return nil
+}
+
 // parseResourceYAML parses a resource YAML into a map.
 func parseResourceYAML(res *resource.Resource) (map[string]any, error) {
 	yamlBytes, err := res.AsYAML()
@@ -569,7 +950,8 @@ func getDeploymentTemplateSpec(data map[string]any) (map[string]any, error) {
 	return templateSpec, nil
 }
 
-// addConfigMapAnnotations adds ConfigMap hash annotations to the deployment template.
+// addConfigMapAnnotations adds ConfigMap hash annotations and the requested
+// restart time to the deployment template, so that changes roll out new pods.
 func addConfigMapAnnotations(data map[string]any, manifestCtx *ManifestContext) error {
 	spec, ok := data["spec"].(map[string]any)
 	if !ok {
@@ -599,6 +981,21 @@ func addConfigMapAnnotations(data map[string]any, manifestCtx *ManifestContext)
 	if manifestCtx.CABundleHash != "" {
 		annotations["configmap.hash/ca-bundle"] = manifestCtx.CABundleHash
 	}
+	if manifestCtx.SecretHash != "" {
+		annotations["secret.hash/ca-bundle"] = manifestCtx.SecretHash
+	}
+	if manifestCtx.EnvSourceHash != "" {
+		annotations["env.hash/sources"] = manifestCtx.EnvSourceHash
+	}
+	if manifestCtx.StartupScriptHash != "" {
+		annotations["configmap.hash/startup-script"] = manifestCtx.StartupScriptHash
+	}
+	if manifestCtx.ConfigMountsHash != "" {
+		annotations["configmap.hash/config-mounts"] = manifestCtx.ConfigMountsHash
+	}
+	if manifestCtx.RestartedAt != "" {
+		annotations[ogxiov1beta1.RestartedAtAnnotation] = manifestCtx.RestartedAt
+	}
 
 	return nil
 }
@@ -690,6 +1087,90 @@ func FilterExcludeKinds(resMap *resmap.ResMap, kindsToExclude []string) (*resmap
 	return &filteredResMap, nil
 }
 
+// ExcludeOptions holds the controller state that, together with the OGXServer
+// spec, decides which rendered kinds are not applied.
+type ExcludeOptions struct {
+	// PVCName is the PVC the Deployment mounts. Empty means the operator's own
+	// <name>-pvc. Any other name is an adopted PVC, which is not rendered.
+	PVCName string
+	// RolloutHeld leaves a rolled-back Deployment alone until the spec or the
+	// restart annotation changes.
+	RolloutHeld bool
+	// DisableClusterScopedResources skips the SCC RoleBinding, which references
+	// a ClusterRole that namespace-only RBAC can neither look up nor bind.
+	DisableClusterScopedResources bool
+}
+
+// ExcludedKinds returns the kinds the controller does not apply for instance.
+// The kinds are sorted and unique, so the result does not depend on the order
+// of the checks.
+func ExcludedKinds(instance *ogxiov1beta1.OGXServer, opts ExcludeOptions) []string {
+	var kinds []string
+
+	if opts.RolloutHeld {
+		kinds = append(kinds, "Deployment")
+	}
+
+	// Suppress PVC creation when the deployment uses an adopted PVC, either
+	// via annotation or discovered by label after annotation removal.
+	pvcName := cmp.Or(opts.PVCName, instance.Name+"-pvc")
+	if pvcName != instance.Name+"-pvc" || instance.Spec.Workload == nil || instance.Spec.Workload.Storage == nil {
+		kinds = append(kinds, "PersistentVolumeClaim")
+	}
+
+	// Per-CR NetworkPolicy toggle (default: enabled)
+	if instance.Spec.Network != nil && instance.Spec.Network.Policy != nil &&
+		instance.Spec.Network.Policy.Enabled != nil && !*instance.Spec.Network.Policy.Enabled {
+		kinds = append(kinds, "NetworkPolicy")
+	}
+
+	if !NeedsPodDisruptionBudget(instance) {
+		kinds = append(kinds, "PodDisruptionBudget")
+	}
+
+	if instance.Spec.Workload == nil || instance.Spec.Workload.Autoscaling == nil {
+		kinds = append(kinds, "HorizontalPodAutoscaler")
+	}
+
+	if opts.DisableClusterScopedResources {
+		kinds = append(kinds, "RoleBinding")
+	}
+
+	slices.Sort(kinds)
+	return slices.Compact(kinds)
+}
+
+// RenderForInstance renders the manifests for ownerInstance like the
+// controller does, without touching a cluster, so that tools can preview the
+// generated resources. It applies manifestCtx, which may be nil for the base
+// manifests, and drops the kinds ExcludedKinds returns for opts.
+func RenderForInstance(
+	fs filesys.FileSystem,
+	manifestsPath string,
+	ownerInstance *ogxiov1beta1.OGXServer,
+	manifestCtx *ManifestContext,
+	opts ExcludeOptions,
+) ([]unstructured.Unstructured, error) {
+	resMap, err := RenderManifestWithContext(fs, manifestsPath, ownerInstance, manifestCtx)
+	if err != nil {
+		return nil, err
+	}
+	filtered, err := FilterExcludeKinds(resMap, ExcludedKinds(ownerInstance, opts))
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]unstructured.Unstructured, 0, (*filtered).Size())
+	for _, res := range (*filtered).Resources() {
+		data, err := res.Map()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert %s/%s: %w", res.GetKind(), res.GetName(), err)
+		}
+		objects = append(objects, unstructured.Unstructured{Object: data})
+	}
+	return objects, nil
+}
+
 // hasVolume reports whether a volume with the given name exists in the slice.
 func hasVolume(volumes []corev1.Volume, name string) bool {
 	for _, vol := range volumes {
@@ -773,6 +1254,11 @@ func deploymentNeedsFullReplacement(ctx context.Context, desired, existing *unst
 	if hasStaleUserConfigVolume(&desiredDep, &existingDep) {
 		return "stale user-config volume detected"
 	}
+	// The API server rejects Recreate while rollingUpdate parameters are set,
+	// and SSA cannot remove the parameters the API server defaulted.
+	if desiredDep.Spec.Strategy.Type == appsv1.RecreateDeploymentStrategyType && existingDep.Spec.Strategy.RollingUpdate != nil {
+		return "switching to the Recreate strategy"
+	}
 	return ""
 }
 
@@ -3,6 +3,7 @@ package deploy
 import (
 	"context"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,11 +17,14 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/kustomize/api/resmap"
 	kresource "sigs.k8s.io/kustomize/api/resource"
 	"sigs.k8s.io/kustomize/kyaml/filesys"
@@ -129,6 +133,192 @@ spec:
 		require.Equal(t, "10Gi", storage, "storage size should be updated to the default")
 	})
 
+	t.Run("should set the PVC access modes and storage class", func(t *testing.T) {
+		fsys := filesys.MakeFsInMemory()
+		require.NoError(t, fsys.MkdirAll(manifestBasePath))
+		require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "kustomization.yaml"), []byte(`
+apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+  - pvc.yaml
+`)))
+		require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "pvc.yaml"), []byte(`
+apiVersion: v1
+kind: PersistentVolumeClaim
+metadata:
+  name: pvc
+spec:
+  accessModes:
+    - ReadWriteOnce
+  resources:
+    requests:
+      storage: 5Gi
+`)))
+
+		owner := &ogxiov1beta1.OGXServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "test-render-ns"},
+			Spec: ogxiov1beta1.OGXServerSpec{
+				Distribution: ogxiov1beta1.DistributionSpec{Image: "test-image:latest"},
+				Workload: &ogxiov1beta1.WorkloadSpec{Storage: &ogxiov1beta1.PVCStorageSpec{
+					AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+					StorageClassName: ptr("nfs-client"),
+				}},
+			},
+		}
+
+		resMap, err := RenderManifest(fsys, manifestBasePath, owner)
+		require.NoError(t, err)
+		require.Equal(t, 1, (*resMap).Size())
+
+		finalMap, err := (*resMap).Resources()[0].Map()
+		require.NoError(t, err)
+		accessModes, found, err := unstructured.NestedStringSlice(finalMap, "spec", "accessModes")
+		require.NoError(t, err)
+		require.True(t, found, "accessModes field should exist")
+		assert.Equal(t, []string{"ReadWriteMany"}, accessModes)
+		storageClassName, found, err := unstructured.NestedString(finalMap, "spec", "storageClassName")
+		require.NoError(t, err)
+		require.True(t, found, "storageClassName field should exist")
+		assert.Equal(t, "nfs-client", storageClassName)
+	})
+
+	t.Run("should set the Deployment revision history limit", func(t *testing.T) {
+		fsys := filesys.MakeFsInMemory()
+		require.NoError(t, fsys.MkdirAll(manifestBasePath))
+		require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "kustomization.yaml"), []byte(`
+apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+  - deployment.yaml
+`)))
+		require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "deployment.yaml"), []byte(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: deployment
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers: []
+`)))
+
+		for _, tt := range []struct {
+			name  string
+			limit *int32
+			want  int32
+		}{
+			{name: "default", want: ogxiov1beta1.DefaultRevisionHistoryLimit},
+			{name: "custom", limit: ptr(int32(1)), want: 1},
+			{name: "zero", limit: ptr(int32(0)), want: 0},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				owner := &ogxiov1beta1.OGXServer{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "test-render-ns"},
+					Spec: ogxiov1beta1.OGXServerSpec{
+						Distribution: ogxiov1beta1.DistributionSpec{Image: "test-image:latest"},
+						Workload:     &ogxiov1beta1.WorkloadSpec{RevisionHistoryLimit: tt.limit},
+					},
+				}
+
+				resMap, err := RenderManifest(fsys, manifestBasePath, owner)
+				require.NoError(t, err)
+				require.Equal(t, 1, (*resMap).Size())
+
+				finalMap, err := (*resMap).Resources()[0].Map()
+				require.NoError(t, err)
+				limit, found, err := unstructured.NestedFieldNoCopy(finalMap, "spec", "revisionHistoryLimit")
+				require.NoError(t, err)
+				require.True(t, found, "revisionHistoryLimit field should exist")
+				assert.EqualValues(t, tt.want, limit)
+			})
+		}
+	})
+
+	t.Run("should default replicas and ports from an empty spec", func(t *testing.T) {
+		fsys := filesys.MakeFsInMemory()
+		require.NoError(t, fsys.MkdirAll(manifestBasePath))
+		require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "kustomization.yaml"), []byte(`
+apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+  - deployment.yaml
+  - service.yaml
+  - networkpolicy.yaml
+`)))
+		require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "deployment.yaml"), []byte(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: deployment
+spec:
+  template:
+    spec:
+      containers: []
+`)))
+		require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "service.yaml"), []byte(`
+apiVersion: v1
+kind: Service
+metadata:
+  name: service
+spec:
+  ports:
+  - name: http
+`)))
+		require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "networkpolicy.yaml"), []byte(`
+apiVersion: networking.k8s.io/v1
+kind: NetworkPolicy
+metadata:
+  name: network-policy
+spec:
+  podSelector:
+    matchLabels: {}
+  ingress: []
+`)))
+
+		render := func(t *testing.T, workload *ogxiov1beta1.WorkloadSpec) map[string]map[string]any {
+			t.Helper()
+			owner := &ogxiov1beta1.OGXServer{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "test-render-ns"},
+				Spec:       ogxiov1beta1.OGXServerSpec{Workload: workload},
+			}
+			resMap, err := RenderManifest(fsys, manifestBasePath, owner)
+			require.NoError(t, err)
+			byKind := map[string]map[string]any{}
+			for _, res := range (*resMap).Resources() {
+				m, err := res.Map()
+				require.NoError(t, err)
+				byKind[res.GetKind()] = m
+			}
+			return byKind
+		}
+		nested := func(t *testing.T, obj map[string]any, fields ...string) any {
+			t.Helper()
+			value, found, err := unstructured.NestedFieldNoCopy(obj, fields...)
+			require.NoError(t, err)
+			require.True(t, found, "%v should exist", fields)
+			return value
+		}
+
+		byKind := render(t, nil)
+		assert.EqualValues(t, 1, nested(t, byKind["Deployment"], "spec", "replicas"))
+		ports, ok := nested(t, byKind["Service"], "spec", "ports").([]any)
+		require.True(t, ok)
+		require.Len(t, ports, 1)
+		assert.EqualValues(t, ogxiov1beta1.DefaultServerPort, ports[0].(map[string]any)["port"])
+		assert.EqualValues(t, ogxiov1beta1.DefaultServerPort, ports[0].(map[string]any)["targetPort"])
+		ingress, ok := nested(t, byKind["NetworkPolicy"], "spec", "ingress").([]any)
+		require.True(t, ok)
+		require.NotEmpty(t, ingress)
+		policyPorts, ok := ingress[0].(map[string]any)["ports"].([]any)
+		require.True(t, ok)
+		require.NotEmpty(t, policyPorts)
+		assert.EqualValues(t, ogxiov1beta1.DefaultServerPort, policyPorts[0].(map[string]any)["port"])
+
+		byKind = render(t, &ogxiov1beta1.WorkloadSpec{Replicas: ptr(int32(0))})
+		assert.EqualValues(t, 0, nested(t, byKind["Deployment"], "spec", "replicas"), "an explicit 0 is kept")
+	})
+
 	t.Run("should fall back to the default directory if kustomization.yaml is missing", func(t *testing.T) {
 		// given a filesystem where the manifests are in a 'default' subdirectory
 		fsys := filesys.MakeFsInMemory()
@@ -253,6 +443,46 @@ func TestApplyResources(t *testing.T) {
 		require.Equal(t, "updated", updatedService.Labels["state"], "service label should be updated")
 	})
 
+	t.Run("does not patch unchanged resources", func(t *testing.T) {
+		// given
+		ctx, testNs, owner := setupApplyResourcesTest(t, "unchanged-owner")
+
+		existingSvc := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-service",
+				Namespace: testNs,
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(owner, owner.GroupVersionKind()),
+				},
+			},
+			Spec: corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "web", Protocol: corev1.ProtocolTCP, Port: 80, TargetPort: intstr.FromInt(80)}}},
+		}
+		require.NoError(t, k8sClient.Create(ctx, existingSvc))
+
+		desiredSvc := newTestResource(t, "v1", "Service", "my-service", testNs, map[string]any{
+			"ports": []any{
+				map[string]any{"name": "web", "port": 80, "targetPort": 8080},
+			},
+		})
+		desiredSvc.SetLabels(map[string]string{"state": "applied"})
+		resMap := resmap.New()
+		require.NoError(t, resMap.Append(desiredSvc))
+
+		// the first apply takes ownership of the desired fields
+		require.NoError(t, ApplyResources(ctx, k8sClient, scheme.Scheme, owner, &resMap))
+		applied := &corev1.Service{}
+		serviceKey := types.NamespacedName{Name: "my-service", Namespace: testNs}
+		require.NoError(t, k8sClient.Get(ctx, serviceKey, applied))
+
+		// when
+		require.NoError(t, ApplyResources(ctx, k8sClient, scheme.Scheme, owner, &resMap))
+
+		// then
+		unchanged := &corev1.Service{}
+		require.NoError(t, k8sClient.Get(ctx, serviceKey, unchanged))
+		require.Equal(t, applied.ResourceVersion, unchanged.ResourceVersion, "an unchanged service should not be patched")
+	})
+
 	t.Run("skips owner", func(t *testing.T) {
 		// given
 		ctx, testNs, owner := setupApplyResourcesTest(t, "skip-owner")
@@ -496,6 +726,110 @@ func TestApplyResources_PVCImmutability(t *testing.T) {
 	require.Equal(t, expStorageSize, storageRequest.String(), "PVC storage spec should remain unchanged")
 }
 
+func TestPatchResource_SkipsUnchanged(t *testing.T) {
+	owner := &ogxiov1beta1.OGXServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "owner", Namespace: "default", UID: "owner-uid"},
+	}
+	desired := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ServiceAccount",
+		"metadata": map[string]any{
+			"name":      "owner-sa",
+			"namespace": "default",
+			"labels":    map[string]any{"app": "ogx"},
+		},
+	}}
+	newExisting := func() *unstructured.Unstructured {
+		existing := desired.DeepCopy()
+		existing.SetResourceVersion("7")
+		existing.SetOwnerReferences([]metav1.OwnerReference{{
+			APIVersion: "ogx.io/v1beta1", Kind: "OGXServer", Name: owner.Name, UID: owner.UID,
+		}})
+		existing.SetManagedFields([]metav1.ManagedFieldsEntry{{
+			Manager:   fieldManager,
+			Operation: metav1.ManagedFieldsOperationApply,
+			FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{"f:app":{}}}}`)},
+		}})
+		return existing
+	}
+
+	patches := 0
+	var patchOpts client.PatchOptions
+	cli := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Patch: func(_ context.Context, _ client.WithWatch, _ client.Object, _ client.Patch, opts ...client.PatchOption) error {
+			patches++
+			patchOpts.ApplyOptions(opts)
+			return nil
+		},
+	}).Build()
+
+	require.NoError(t, patchResource(t.Context(), cli, desired.DeepCopy(), newExisting(), owner))
+	assert.Zero(t, patches, "an unchanged resource should not be patched")
+
+	changed := desired.DeepCopy()
+	changed.SetLabels(map[string]string{"app": "ogx", "tier": "server"})
+	require.NoError(t, patchResource(t.Context(), cli, changed, newExisting(), owner))
+	assert.Equal(t, 1, patches, "a changed resource should be patched")
+	// The field manager is operator-wide, so that same-named instances in
+	// different namespaces do not show up as different owners.
+	assert.Equal(t, "ogx-operator", patchOpts.FieldManager)
+	require.NotNil(t, patchOpts.Force)
+	assert.True(t, *patchOpts.Force, "the apply should force ownership")
+}
+
+func TestPatchResource_RestoresServiceSelector(t *testing.T) {
+	owner := &ogxiov1beta1.OGXServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "owner", Namespace: "default", UID: "owner-uid"},
+	}
+	expectedSelector := map[string]string{
+		ogxiov1beta1.DefaultLabelKey: ogxiov1beta1.DefaultLabelValue,
+		"app.kubernetes.io/instance": owner.Name,
+	}
+	existing := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "owner-service",
+			Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "ogx.io/v1beta1", Kind: "OGXServer", Name: owner.Name, UID: owner.UID,
+			}},
+		},
+		Spec: corev1.ServiceSpec{Selector: map[string]string{
+			ogxiov1beta1.DefaultLabelKey: ogxiov1beta1.DefaultLabelValue,
+			"app.kubernetes.io/instance": owner.Name,
+			"tier":                       "edited-by-hand",
+		}},
+	}
+	cli := fake.NewClientBuilder().WithObjects(existing).WithInterceptorFuncs(interceptor.Funcs{
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			if patch.Type() == types.ApplyPatchType {
+				return nil
+			}
+			return c.Patch(ctx, obj, patch, opts...)
+		},
+	}).Build()
+
+	existingObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(existing)
+	require.NoError(t, err)
+	desired := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata":   map[string]any{"name": existing.Name, "namespace": existing.Namespace},
+		"spec": map[string]any{"selector": map[string]any{
+			ogxiov1beta1.DefaultLabelKey: ogxiov1beta1.DefaultLabelValue,
+			"app.kubernetes.io/instance": owner.Name,
+		}},
+	}}
+	found := &unstructured.Unstructured{Object: existingObj}
+	found.SetKind("Service")
+	found.SetAPIVersion("v1")
+
+	require.NoError(t, patchResource(t.Context(), cli, desired, found, owner))
+
+	restored := &corev1.Service{}
+	require.NoError(t, cli.Get(t.Context(), client.ObjectKeyFromObject(existing), restored))
+	assert.Equal(t, expectedSelector, restored.Spec.Selector, "keys the operator does not set should be removed")
+}
+
 // TestFilterExcludeKinds tests the filtering functionality.
 func TestFilterExcludeKinds(t *testing.T) {
 	t.Run("excludes specified kinds", func(t *testing.T) {
@@ -548,6 +882,126 @@ func TestFilterExcludeKinds(t *testing.T) {
 	})
 }
 
+func TestRenderForInstance(t *testing.T) {
+	// The manifests the operator ships, relative to this package.
+	const operatorManifests = "../../controllers/manifests/base"
+
+	newInstance := func() *ogxiov1beta1.OGXServer {
+		return &ogxiov1beta1.OGXServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "preview", Namespace: "preview-ns"},
+			Spec: ogxiov1beta1.OGXServerSpec{
+				Distribution: ogxiov1beta1.DistributionSpec{Image: "test-image:latest"},
+			},
+		}
+	}
+	kinds := func(objects []unstructured.Unstructured) []string {
+		var result []string
+		for _, obj := range objects {
+			result = append(result, obj.GetKind())
+		}
+		return result
+	}
+
+	testCases := []struct {
+		name      string
+		instance  func() *ogxiov1beta1.OGXServer
+		opts      ExcludeOptions
+		wantKinds []string
+	}{
+		{
+			name:      "default server",
+			instance:  newInstance,
+			wantKinds: []string{"ServiceAccount", "RoleBinding", "Service", "Deployment", "NetworkPolicy"},
+		},
+		{
+			name: "server with storage and autoscaling",
+			instance: func() *ogxiov1beta1.OGXServer {
+				instance := newInstance()
+				instance.Spec.Workload = &ogxiov1beta1.WorkloadSpec{
+					Storage:     &ogxiov1beta1.PVCStorageSpec{},
+					Autoscaling: &ogxiov1beta1.AutoscalingSpec{MaxReplicas: 3},
+				}
+				return instance
+			},
+			wantKinds: []string{
+				"ServiceAccount", "RoleBinding", "Service", "PersistentVolumeClaim", "Deployment",
+				"HorizontalPodAutoscaler", "NetworkPolicy",
+			},
+		},
+		{
+			name: "nothing excluded",
+			instance: func() *ogxiov1beta1.OGXServer {
+				instance := newInstance()
+				instance.Spec.Workload = &ogxiov1beta1.WorkloadSpec{
+					Storage:             &ogxiov1beta1.PVCStorageSpec{},
+					Autoscaling:         &ogxiov1beta1.AutoscalingSpec{MaxReplicas: 3},
+					PodDisruptionBudget: &ogxiov1beta1.PodDisruptionBudgetSpec{},
+				}
+				return instance
+			},
+			wantKinds: []string{
+				"ServiceAccount", "RoleBinding", "Service", "PersistentVolumeClaim", "Deployment",
+				"HorizontalPodAutoscaler", "PodDisruptionBudget", "NetworkPolicy",
+			},
+		},
+		{
+			name:     "adopted PVC, held rollout and namespace-only RBAC",
+			instance: newInstance,
+			opts: ExcludeOptions{
+				PVCName:                       "legacy-pvc",
+				RolloutHeld:                   true,
+				DisableClusterScopedResources: true,
+			},
+			wantKinds: []string{"ServiceAccount", "Service", "NetworkPolicy"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			objects, err := RenderForInstance(filesys.MakeFsOnDisk(), operatorManifests, tc.instance(), nil, tc.opts)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tc.wantKinds, kinds(objects))
+			for _, obj := range objects {
+				assert.Equal(t, "preview-ns", obj.GetNamespace(), "%s should be in the instance namespace", obj.GetKind())
+			}
+		})
+	}
+
+	t.Run("applies the manifest context", func(t *testing.T) {
+		manifestCtx := &ManifestContext{
+			ConfigMapHash: "abc",
+			PodSpec: map[string]any{
+				"containers": []any{map[string]any{"name": "ogx", "image": "test-image:latest"}},
+			},
+		}
+		objects, err := RenderForInstance(filesys.MakeFsOnDisk(), operatorManifests, newInstance(), manifestCtx, ExcludeOptions{})
+		require.NoError(t, err)
+
+		var deployment *unstructured.Unstructured
+		for i := range objects {
+			if objects[i].GetKind() == "Deployment" {
+				deployment = &objects[i]
+			}
+		}
+		require.NotNil(t, deployment)
+		assert.Equal(t, "preview", deployment.GetName())
+		hash, found, err := unstructured.NestedString(deployment.Object, "spec", "template", "metadata", "annotations", "configmap.hash/user-config")
+		require.NoError(t, err)
+		require.True(t, found)
+		assert.Equal(t, "abc", hash)
+		containers, found, err := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "containers")
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Len(t, containers, 1)
+		assert.Equal(t, "test-image:latest", containers[0].(map[string]any)["image"])
+	})
+
+	t.Run("reports render errors", func(t *testing.T) {
+		_, err := RenderForInstance(filesys.MakeFsInMemory(), manifestBasePath, newInstance(), nil, ExcludeOptions{})
+		require.Error(t, err)
+	})
+}
+
 func TestSetDefaultPort(t *testing.T) {
 	// arrange
 	// instance with no custom port and service with empty port values
@@ -640,7 +1094,82 @@ func TestRemoveDeploymentReplicas(t *testing.T) {
 	require.False(t, hasReplicas, "replicas should be removed from all deployments when autoscaling is enabled")
 }
 
+func TestAddAdditionalServicePorts(t *testing.T) {
+	t.Parallel()
+
+	instance := &ogxiov1beta1.OGXServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "example", Namespace: "llama"},
+		Spec: ogxiov1beta1.OGXServerSpec{
+			Distribution: ogxiov1beta1.DistributionSpec{Image: "test-image:latest"},
+			Network: &ogxiov1beta1.NetworkSpec{
+				Port:            9000,
+				AdditionalPorts: []corev1.ContainerPort{{Name: "metrics", ContainerPort: 9090}},
+			},
+		},
+	}
+
+	resMap := resmap.New()
+	require.NoError(t, resMap.Append(newTestResource(t, "v1", "Service", "service", "llama", map[string]any{
+		"ports": []any{map[string]any{"name": "http", "protocol": "TCP"}},
+	})))
+
+	require.NoError(t, applyPlugins(&resMap, instance))
+
+	data, err := resMap.Resources()[0].Map()
+	require.NoError(t, err)
+	var service corev1.Service
+	require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(data, &service))
+	assert.Equal(t, []corev1.ServicePort{
+		{Name: "http", Protocol: corev1.ProtocolTCP, Port: 9000, TargetPort: intstr.FromInt32(9000)},
+		{Name: "metrics", Protocol: corev1.ProtocolTCP, Port: 9090, TargetPort: intstr.FromInt32(9090)},
+	}, service.Spec.Ports)
+}
+
 // TestHasLegacyCABundleVolumes tests the detection of legacy CA bundle volumes.
+func TestAddConfigMapAnnotations(t *testing.T) {
+	newDeployment := func() map[string]any {
+		return map[string]any{
+			"spec": map[string]any{
+				"template": map[string]any{"spec": map[string]any{}},
+			},
+		}
+	}
+	templateAnnotations := func(t *testing.T, data map[string]any) map[string]any {
+		t.Helper()
+		template := data["spec"].(map[string]any)["template"].(map[string]any)
+		annotations, ok := template["metadata"].(map[string]any)["annotations"].(map[string]any)
+		require.True(t, ok, "pod template should have annotations")
+		return annotations
+	}
+
+	t.Run("copies the requested restart time to the pod template", func(t *testing.T) {
+		data := newDeployment()
+		require.NoError(t, addConfigMapAnnotations(data, &ManifestContext{
+			ConfigMapHash: "abc",
+			RestartedAt:   "2025-01-01T00:00:00Z",
+		}))
+
+		annotations := templateAnnotations(t, data)
+		assert.Equal(t, "abc", annotations["configmap.hash/user-config"])
+		assert.Equal(t, "2025-01-01T00:00:00Z", annotations[ogxiov1beta1.RestartedAtAnnotation])
+	})
+
+	t.Run("changing the restart time changes the pod template", func(t *testing.T) {
+		first, second := newDeployment(), newDeployment()
+		require.NoError(t, addConfigMapAnnotations(first, &ManifestContext{RestartedAt: "2025-01-01T00:00:00Z"}))
+		require.NoError(t, addConfigMapAnnotations(second, &ManifestContext{RestartedAt: "2025-01-02T00:00:00Z"}))
+
+		assert.NotEqual(t, templateAnnotations(t, first), templateAnnotations(t, second))
+	})
+
+	t.Run("adds no restart annotation when none is requested", func(t *testing.T) {
+		data := newDeployment()
+		require.NoError(t, addConfigMapAnnotations(data, &ManifestContext{}))
+
+		assert.NotContains(t, templateAnnotations(t, data), ogxiov1beta1.RestartedAtAnnotation)
+	})
+}
+
 func TestHasLegacyCABundleVolumes(t *testing.T) {
 	ctx := t.Context()
 
@@ -1090,6 +1619,27 @@ func TestGetFieldMappings_RecreateStrategyWithStorage(t *testing.T) {
 		require.True(t, found, "should include Recreate strategy mapping when storage is configured")
 	})
 
+	t.Run("does not include strategy for ReadWriteMany storage", func(t *testing.T) {
+		owner := &ogxiov1beta1.OGXServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec: ogxiov1beta1.OGXServerSpec{
+				Distribution: ogxiov1beta1.DistributionSpec{Image: "test-image:latest"},
+				Workload: &ogxiov1beta1.WorkloadSpec{
+					Replicas: ptr(int32(2)),
+					Storage: &ogxiov1beta1.PVCStorageSpec{
+						AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+					},
+				},
+			},
+		}
+
+		for _, m := range getFieldMappings(owner) {
+			if m.TargetField == "/spec/strategy/type" {
+				t.Fatal("shared storage should keep the default rolling update strategy")
+			}
+		}
+	})
+
 	t.Run("does not include strategy when storage is nil", func(t *testing.T) {
 		owner := &ogxiov1beta1.OGXServer{
 			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
@@ -1109,6 +1659,641 @@ func TestGetFieldMappings_RecreateStrategyWithStorage(t *testing.T) {
 	})
 }
 
+func TestGetFieldMappings_RolloutHealthGate(t *testing.T) {
+	newOwner := func(workload *ogxiov1beta1.WorkloadSpec) *ogxiov1beta1.OGXServer {
+		return &ogxiov1beta1.OGXServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec: ogxiov1beta1.OGXServerSpec{
+				Distribution: ogxiov1beta1.DistributionSpec{Image: "test-image:latest"},
+				Workload:     workload,
+			},
+		}
+	}
+	strategyMappings := func(owner *ogxiov1beta1.OGXServer) map[string]any {
+		found := make(map[string]any)
+		for _, m := range getFieldMappings(owner) {
+			if m.TargetKind == "Deployment" && strings.HasPrefix(m.TargetField, "/spec/strategy/") {
+				found[m.TargetField] = m.SourceValue
+			}
+		}
+		return found
+	}
+
+	t.Run("keeps old pods until new pods are ready", func(t *testing.T) {
+		owner := newOwner(&ogxiov1beta1.WorkloadSpec{RolloutHealthGate: &ogxiov1beta1.RolloutHealthGateSpec{}})
+
+		assert.Equal(t, map[string]any{
+			"/spec/strategy/type":                         "RollingUpdate",
+			"/spec/strategy/rollingUpdate/maxUnavailable": 0,
+		}, strategyMappings(owner))
+	})
+
+	t.Run("storage keeps the Recreate strategy", func(t *testing.T) {
+		owner := newOwner(&ogxiov1beta1.WorkloadSpec{
+			Storage:           &ogxiov1beta1.PVCStorageSpec{},
+			RolloutHealthGate: &ogxiov1beta1.RolloutHealthGateSpec{},
+		})
+
+		assert.Equal(t, map[string]any{"/spec/strategy/type": "Recreate"}, strategyMappings(owner))
+	})
+}
+
+func TestGetFieldMappings_DeploymentStrategy(t *testing.T) {
+	surge := intstr.FromString("50%")
+	one := intstr.FromInt32(1)
+
+	tests := []struct {
+		name     string
+		workload *ogxiov1beta1.WorkloadSpec
+		want     map[string]any
+	}{
+		{
+			name:     "no strategy keeps the Kubernetes default",
+			workload: &ogxiov1beta1.WorkloadSpec{},
+			want:     map[string]any{},
+		},
+		{
+			name: "Recreate",
+			workload: &ogxiov1beta1.WorkloadSpec{DeploymentStrategy: &ogxiov1beta1.DeploymentStrategySpec{
+				Type: ogxiov1beta1.DeploymentStrategyRecreate,
+			}},
+			want: map[string]any{"/spec/strategy/type": "Recreate"},
+		},
+		{
+			name: "RollingUpdate with surge and unavailability",
+			workload: &ogxiov1beta1.WorkloadSpec{DeploymentStrategy: &ogxiov1beta1.DeploymentStrategySpec{
+				Type:           ogxiov1beta1.DeploymentStrategyRollingUpdate,
+				MaxSurge:       &surge,
+				MaxUnavailable: &one,
+			}},
+			want: map[string]any{
+				"/spec/strategy/type":                         "RollingUpdate",
+				"/spec/strategy/rollingUpdate/maxSurge":       "50%",
+				"/spec/strategy/rollingUpdate/maxUnavailable": 1,
+			},
+		},
+		{
+			name: "explicit RollingUpdate overrides the storage default",
+			workload: &ogxiov1beta1.WorkloadSpec{
+				Storage:            &ogxiov1beta1.PVCStorageSpec{},
+				DeploymentStrategy: &ogxiov1beta1.DeploymentStrategySpec{},
+			},
+			want: map[string]any{"/spec/strategy/type": "RollingUpdate"},
+		},
+		{
+			name: "explicit maxUnavailable wins over the rollout health gate",
+			workload: &ogxiov1beta1.WorkloadSpec{
+				DeploymentStrategy: &ogxiov1beta1.DeploymentStrategySpec{MaxUnavailable: &one},
+				RolloutHealthGate:  &ogxiov1beta1.RolloutHealthGateSpec{},
+			},
+			want: map[string]any{
+				"/spec/strategy/type":                         "RollingUpdate",
+				"/spec/strategy/rollingUpdate/maxUnavailable": 1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner := &ogxiov1beta1.OGXServer{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+				Spec: ogxiov1beta1.OGXServerSpec{
+					Distribution: ogxiov1beta1.DistributionSpec{Image: "test-image:latest"},
+					Workload:     tt.workload,
+				},
+			}
+
+			got := make(map[string]any)
+			for _, m := range getFieldMappings(owner) {
+				if m.TargetKind == deploymentKind && strings.HasPrefix(m.TargetField, "/spec/strategy/") {
+					got[m.TargetField] = m.SourceValue
+				}
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetEffectiveStrategyType(t *testing.T) {
+	newOwner := func(workload *ogxiov1beta1.WorkloadSpec) *ogxiov1beta1.OGXServer {
+		return &ogxiov1beta1.OGXServer{Spec: ogxiov1beta1.OGXServerSpec{Workload: workload}}
+	}
+
+	rwx := &ogxiov1beta1.WorkloadSpec{
+		Storage: &ogxiov1beta1.PVCStorageSpec{AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany}},
+	}
+
+	assert.Equal(t, ogxiov1beta1.DeploymentStrategyRollingUpdate, GetEffectiveStrategyType(newOwner(nil), nil))
+	assert.Equal(t, ogxiov1beta1.DeploymentStrategyRecreate,
+		GetEffectiveStrategyType(newOwner(&ogxiov1beta1.WorkloadSpec{Storage: &ogxiov1beta1.PVCStorageSpec{}}), nil))
+	assert.Equal(t, ogxiov1beta1.DeploymentStrategyRollingUpdate, GetEffectiveStrategyType(newOwner(rwx), nil))
+	assert.Equal(t, ogxiov1beta1.DeploymentStrategyRecreate,
+		GetEffectiveStrategyType(newOwner(rwx), []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}),
+		"the existing PVC's access modes win over storage.accessModes")
+	assert.Equal(t, ogxiov1beta1.DeploymentStrategyRollingUpdate,
+		GetEffectiveStrategyType(newOwner(&ogxiov1beta1.WorkloadSpec{Storage: &ogxiov1beta1.PVCStorageSpec{}}),
+			[]corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany}))
+}
+
+func TestRenderManifestWithContext_PVCAccessModes(t *testing.T) {
+	fsys := filesys.MakeFsInMemory()
+	require.NoError(t, fsys.MkdirAll(manifestBasePath))
+	require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "kustomization.yaml"), []byte(`
+apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+  - deployment.yaml
+`)))
+	require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "deployment.yaml"), []byte(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: deployment
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers: []
+`)))
+
+	renderStrategy := func(t *testing.T, storage *ogxiov1beta1.PVCStorageSpec,
+		pvcAccessModes []corev1.PersistentVolumeAccessMode) (map[string]any, bool) {
+		t.Helper()
+		owner := &ogxiov1beta1.OGXServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "test-render-ns"},
+			Spec: ogxiov1beta1.OGXServerSpec{
+				Distribution: ogxiov1beta1.DistributionSpec{Image: "test-image:latest"},
+				Workload:     &ogxiov1beta1.WorkloadSpec{Storage: storage},
+			},
+		}
+		resMap, err := RenderManifestWithContext(fsys, manifestBasePath, owner,
+			&ManifestContext{PVCAccessModes: pvcAccessModes})
+		require.NoError(t, err)
+		rendered, err := (*resMap).Resources()[0].Map()
+		require.NoError(t, err)
+		strategy, found, err := unstructured.NestedMap(rendered, "spec", "strategy")
+		require.NoError(t, err)
+		return strategy, found
+	}
+
+	t.Run("RWO PVC selects Recreate despite RWX in the spec", func(t *testing.T) {
+		strategy, found := renderStrategy(t,
+			&ogxiov1beta1.PVCStorageSpec{AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany}},
+			[]corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce})
+		require.True(t, found, "strategy should be rendered")
+		assert.Equal(t, map[string]any{"type": "Recreate"}, strategy)
+	})
+
+	t.Run("RWX PVC keeps the default despite RWO in the spec", func(t *testing.T) {
+		_, found := renderStrategy(t, &ogxiov1beta1.PVCStorageSpec{},
+			[]corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany})
+		assert.False(t, found, "shared PVC should keep the Kubernetes default strategy")
+	})
+
+	t.Run("spec access modes apply before the PVC exists", func(t *testing.T) {
+		strategy, found := renderStrategy(t, &ogxiov1beta1.PVCStorageSpec{}, nil)
+		require.True(t, found, "strategy should be rendered")
+		assert.Equal(t, map[string]any{"type": "Recreate"}, strategy)
+	})
+}
+
+func TestRenderManifest_RecreateStrategy(t *testing.T) {
+	fsys := filesys.MakeFsInMemory()
+	require.NoError(t, fsys.MkdirAll(manifestBasePath))
+	require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "kustomization.yaml"), []byte(`
+apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+  - deployment.yaml
+`)))
+	require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "deployment.yaml"), []byte(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: deployment
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers: []
+`)))
+
+	owner := &ogxiov1beta1.OGXServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "test-render-ns"},
+		Spec: ogxiov1beta1.OGXServerSpec{
+			Distribution: ogxiov1beta1.DistributionSpec{Image: "test-image:latest"},
+			Workload: &ogxiov1beta1.WorkloadSpec{DeploymentStrategy: &ogxiov1beta1.DeploymentStrategySpec{
+				Type: ogxiov1beta1.DeploymentStrategyRecreate,
+			}},
+		},
+	}
+
+	resMap, err := RenderManifest(fsys, manifestBasePath, owner)
+	require.NoError(t, err)
+	require.Equal(t, 1, (*resMap).Size())
+
+	rendered, err := (*resMap).Resources()[0].Map()
+	require.NoError(t, err)
+	strategy, found, err := unstructured.NestedMap(rendered, "spec", "strategy")
+	require.NoError(t, err)
+	require.True(t, found, "strategy should be rendered")
+	assert.Equal(t, map[string]any{"type": "Recreate"}, strategy,
+		"Recreate must not carry rollingUpdate parameters")
+}
+
+func TestRenderManifest_CommonMetadata(t *testing.T) {
+	fsys := filesys.MakeFsInMemory()
+	require.NoError(t, fsys.MkdirAll(manifestBasePath))
+	require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "kustomization.yaml"), []byte(`
+apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+  - pvc.yaml
+  - service.yaml
+  - deployment.yaml
+labels:
+- includeSelectors: false
+  pairs:
+    app.kubernetes.io/managed-by: ogx-operator
+`)))
+	require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "pvc.yaml"), []byte(`
+apiVersion: v1
+kind: PersistentVolumeClaim
+metadata:
+  name: pvc
+spec:
+  accessModes: ["ReadWriteOnce"]
+  resources:
+    requests:
+      storage: 1Gi
+`)))
+	require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "service.yaml"), []byte(`
+apiVersion: v1
+kind: Service
+metadata:
+  name: service
+spec:
+  ports:
+  - port: 8321
+`)))
+	require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "deployment.yaml"), []byte(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: deployment
+spec:
+  replicas: 1
+  template:
+    metadata:
+      labels:
+        app: ogx
+    spec:
+      containers: []
+`)))
+
+	owner := &ogxiov1beta1.OGXServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "test-render-ns"},
+		Spec: ogxiov1beta1.OGXServerSpec{
+			Distribution: ogxiov1beta1.DistributionSpec{Image: "test-image:latest"},
+			Workload: &ogxiov1beta1.WorkloadSpec{
+				CommonLabels: map[string]string{
+					"cost-center":                  "1234",
+					"app.kubernetes.io/managed-by": "someone-else",
+					"app":                          "other",
+				},
+				CommonAnnotations: map[string]string{"prometheus.io/scrape": "true"},
+			},
+		},
+	}
+
+	resMap, err := RenderManifest(fsys, manifestBasePath, owner)
+	require.NoError(t, err)
+	require.Equal(t, 3, (*resMap).Size())
+
+	for _, res := range (*resMap).Resources() {
+		assert.Equal(t, "1234", res.GetLabels()["cost-center"], "%s should carry the common label", res.GetKind())
+		assert.Equal(t, "ogx-operator", res.GetLabels()["app.kubernetes.io/managed-by"],
+			"%s should keep the operator-managed label", res.GetKind())
+		assert.Equal(t, "true", res.GetAnnotations()["prometheus.io/scrape"], "%s should carry the common annotation", res.GetKind())
+	}
+
+	var rendered map[string]any
+	for _, res := range (*resMap).Resources() {
+		if res.GetKind() == deploymentKind {
+			rendered, err = res.Map()
+			require.NoError(t, err)
+		}
+	}
+	require.NotNil(t, rendered, "deployment should be rendered")
+	podLabels, _, err := unstructured.NestedStringMap(rendered, "spec", "template", "metadata", "labels")
+	require.NoError(t, err)
+	assert.Equal(t, "ogx", podLabels["app"], "pod selector labels must not be overridden")
+	assert.Equal(t, "1234", podLabels["cost-center"])
+	assert.Equal(t, "test-instance", podLabels["app.kubernetes.io/instance"])
+	podAnnotations, _, err := unstructured.NestedStringMap(rendered, "spec", "template", "metadata", "annotations")
+	require.NoError(t, err)
+	assert.Equal(t, "true", podAnnotations["prometheus.io/scrape"])
+}
+
+func TestRenderManifest_DeploymentAnnotations(t *testing.T) {
+	fsys := filesys.MakeFsInMemory()
+	require.NoError(t, fsys.MkdirAll(manifestBasePath))
+	require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "kustomization.yaml"), []byte(`
+apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+  - service.yaml
+  - deployment.yaml
+`)))
+	require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "service.yaml"), []byte(`
+apiVersion: v1
+kind: Service
+metadata:
+  name: service
+spec:
+  ports:
+  - port: 8321
+`)))
+	require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "deployment.yaml"), []byte(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: deployment
+spec:
+  replicas: 1
+  template:
+    metadata:
+      labels:
+        app: ogx
+    spec:
+      containers: []
+`)))
+
+	owner := &ogxiov1beta1.OGXServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "test-render-ns"},
+		Spec: ogxiov1beta1.OGXServerSpec{
+			Distribution: ogxiov1beta1.DistributionSpec{Image: "test-image:latest"},
+			Workload: &ogxiov1beta1.WorkloadSpec{
+				CommonAnnotations: map[string]string{"argocd.argoproj.io/sync-wave": "0", "team": "ml"},
+				DeploymentAnnotations: map[string]string{
+					"argocd.argoproj.io/sync-wave": "5",
+					"fluxcd.io/ignore":             "false",
+				},
+			},
+		},
+	}
+
+	resMap, err := RenderManifestWithContext(fsys, manifestBasePath, owner, &ManifestContext{ConfigMapHash: "abc"})
+	require.NoError(t, err)
+
+	for _, res := range (*resMap).Resources() {
+		switch res.GetKind() {
+		case deploymentKind:
+			assert.Equal(t, map[string]string{
+				"argocd.argoproj.io/sync-wave": "5",
+				"fluxcd.io/ignore":             "false",
+				"team":                         "ml",
+			}, res.GetAnnotations(), "deployment annotations take precedence over common annotations")
+
+			data, err := res.Map()
+			require.NoError(t, err)
+			podAnnotations, _, err := unstructured.NestedStringMap(data, "spec", "template", "metadata", "annotations")
+			require.NoError(t, err)
+			assert.Equal(t, map[string]string{
+				"configmap.hash/user-config":   "abc",
+				"argocd.argoproj.io/sync-wave": "0",
+				"team":                         "ml",
+			}, podAnnotations, "pods only carry the common annotations and the restart hashes")
+		case "Service":
+			assert.Equal(t, map[string]string{"argocd.argoproj.io/sync-wave": "0", "team": "ml"}, res.GetAnnotations(),
+				"other resources must not carry the deployment annotations")
+		}
+	}
+}
+
+func TestRenderManifest_ServiceType(t *testing.T) {
+	fsys := filesys.MakeFsInMemory()
+	require.NoError(t, fsys.MkdirAll(manifestBasePath))
+	require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "kustomization.yaml"), []byte(`
+apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+  - service.yaml
+`)))
+	require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "service.yaml"), []byte(`
+apiVersion: v1
+kind: Service
+metadata:
+  name: service
+spec:
+  type: ClusterIP
+  ports:
+  - port: 8321
+`)))
+
+	tests := []struct {
+		name            string
+		network         *ogxiov1beta1.NetworkSpec
+		wantType        string
+		wantAnnotations map[string]string
+	}{
+		{
+			name:            "defaults to ClusterIP",
+			wantType:        "ClusterIP",
+			wantAnnotations: map[string]string{"team": "ml"},
+		},
+		{
+			name:            "NodePort",
+			network:         &ogxiov1beta1.NetworkSpec{ServiceType: corev1.ServiceTypeNodePort},
+			wantType:        "NodePort",
+			wantAnnotations: map[string]string{"team": "ml"},
+		},
+		{
+			name: "LoadBalancer with annotations",
+			network: &ogxiov1beta1.NetworkSpec{
+				ServiceType: corev1.ServiceTypeLoadBalancer,
+				LoadBalancerAnnotations: map[string]string{
+					"service.beta.kubernetes.io/aws-load-balancer-internal": "true",
+					"team": "inference",
+				},
+			},
+			wantType: "LoadBalancer",
+			wantAnnotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-internal": "true",
+				"team": "inference",
+			},
+		},
+		{
+			name: "load balancer annotations ignored for other types",
+			network: &ogxiov1beta1.NetworkSpec{
+				ServiceType:             corev1.ServiceTypeNodePort,
+				LoadBalancerAnnotations: map[string]string{"service.beta.kubernetes.io/aws-load-balancer-internal": "true"},
+			},
+			wantType:        "NodePort",
+			wantAnnotations: map[string]string{"team": "ml"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			owner := &ogxiov1beta1.OGXServer{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "test-render-ns"},
+				Spec: ogxiov1beta1.OGXServerSpec{
+					Distribution: ogxiov1beta1.DistributionSpec{Image: "test-image:latest"},
+					Network:      tc.network,
+					Workload: &ogxiov1beta1.WorkloadSpec{
+						CommonAnnotations: map[string]string{"team": "ml"},
+					},
+				},
+			}
+
+			resMap, err := RenderManifestWithContext(fsys, manifestBasePath, owner, &ManifestContext{})
+			require.NoError(t, err)
+
+			resources := (*resMap).Resources()
+			require.Len(t, resources, 1)
+			data, err := resources[0].Map()
+			require.NoError(t, err)
+			serviceType, _, err := unstructured.NestedString(data, "spec", "type")
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantType, serviceType)
+			assert.Equal(t, tc.wantAnnotations, resources[0].GetAnnotations(),
+				"load balancer annotations take precedence over common annotations")
+		})
+	}
+}
+
+func TestRenderManifest_StorageMetadata(t *testing.T) {
+	fsys := filesys.MakeFsInMemory()
+	require.NoError(t, fsys.MkdirAll(manifestBasePath))
+	require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "kustomization.yaml"), []byte(`
+apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+  - pvc.yaml
+  - service.yaml
+labels:
+- includeSelectors: false
+  pairs:
+    app.kubernetes.io/managed-by: ogx-operator
+`)))
+	require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "pvc.yaml"), []byte(`
+apiVersion: v1
+kind: PersistentVolumeClaim
+metadata:
+  name: pvc
+spec:
+  accessModes: ["ReadWriteOnce"]
+  resources:
+    requests:
+      storage: 1Gi
+`)))
+	require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "service.yaml"), []byte(`
+apiVersion: v1
+kind: Service
+metadata:
+  name: service
+spec:
+  ports:
+  - port: 8321
+`)))
+
+	owner := &ogxiov1beta1.OGXServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "test-render-ns"},
+		Spec: ogxiov1beta1.OGXServerSpec{
+			Distribution: ogxiov1beta1.DistributionSpec{Image: "test-image:latest"},
+			Workload: &ogxiov1beta1.WorkloadSpec{
+				CommonLabels: map[string]string{"backup": "weekly"},
+				Storage: &ogxiov1beta1.PVCStorageSpec{
+					Labels: map[string]string{
+						"backup":                       "daily",
+						"app.kubernetes.io/managed-by": "someone-else",
+					},
+					Annotations: map[string]string{"backup.example.com/policy": "keep-7"},
+				},
+			},
+		},
+	}
+
+	resMap, err := RenderManifest(fsys, manifestBasePath, owner)
+	require.NoError(t, err)
+	require.Equal(t, 2, (*resMap).Size())
+
+	for _, res := range (*resMap).Resources() {
+		switch res.GetKind() {
+		case persistentVolumeClaimKind:
+			assert.Equal(t, "daily", res.GetLabels()["backup"], "storage labels should take precedence over common labels")
+			assert.Equal(t, "ogx-operator", res.GetLabels()["app.kubernetes.io/managed-by"],
+				"operator-managed labels must not be overridden")
+			assert.Equal(t, "keep-7", res.GetAnnotations()["backup.example.com/policy"])
+		default:
+			assert.Equal(t, "weekly", res.GetLabels()["backup"], "%s should only carry the common label", res.GetKind())
+			assert.NotContains(t, res.GetAnnotations(), "backup.example.com/policy")
+		}
+	}
+}
+
+// TestRecreateStrategyUpgrade tests that an existing rolling-update Deployment
+// can switch to Recreate, e.g. to avoid RWO PVC multi-attach deadlocks.
+func TestRecreateStrategyUpgrade(t *testing.T) {
+	ctx, testNs, owner := setupApplyResourcesTest(t, "recreate-upgrade")
+
+	// The API server defaults the RollingUpdate strategy and its parameters.
+	existingDeployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-deployment",
+			Namespace: testNs,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(owner, owner.GroupVersionKind()),
+			},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr(int32(1)),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "test"},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"app": "test"},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "main", Image: "test:v1"}},
+				},
+			},
+		},
+	}
+	require.NoError(t, k8sClient.Create(ctx, existingDeployment))
+	require.NotNil(t, existingDeployment.Spec.Strategy.RollingUpdate, "API server should default rollingUpdate")
+
+	desiredDeployment := newTestResource(t, "apps/v1", "Deployment", "test-deployment", testNs, map[string]any{
+		"replicas": int32(1),
+		"selector": map[string]any{
+			"matchLabels": map[string]any{"app": "test"},
+		},
+		"strategy": map[string]any{"type": "Recreate"},
+		"template": map[string]any{
+			"metadata": map[string]any{
+				"labels": map[string]any{"app": "test"},
+			},
+			"spec": map[string]any{
+				"containers": []any{
+					map[string]any{"name": "main", "image": "test:v1"},
+				},
+			},
+		},
+	})
+
+	resMap := resmap.New()
+	require.NoError(t, resMap.Append(desiredDeployment))
+
+	require.NoError(t, ApplyResources(ctx, k8sClient, scheme.Scheme, owner, &resMap))
+
+	updated := &appsv1.Deployment{}
+	require.NoError(t, k8sClient.Get(ctx, types.NamespacedName{Name: "test-deployment", Namespace: testNs}, updated))
+	require.Equal(t, appsv1.RecreateDeploymentStrategyType, updated.Spec.Strategy.Type)
+	require.Nil(t, updated.Spec.Strategy.RollingUpdate)
+}
+
 // resourceToUnstructured converts a kustomize resource to an unstructured object.
 func resourceToUnstructured(t *testing.T, res *kresource.Resource) (*unstructured.Unstructured, error) {
 	t.Helper()
@@ -0,0 +1,125 @@
+package plugins
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/kustomize/api/resmap"
+	"sigs.k8s.io/kustomize/api/resource"
+)
+
+// CommonMetadataConfig holds configuration for the common metadata plugin.
+type CommonMetadataConfig struct {
+	// Labels to add to every resource and pod template.
+	Labels map[string]string
+	// Annotations to add to every resource and pod template.
+	Annotations map[string]string
+	// Kinds limits the plugin to resources of these kinds. Empty means all kinds.
+	Kinds []string
+	// SkipPodTemplate leaves pod templates unchanged, so that the metadata is
+	// only set on the resources themselves.
+	SkipPodTemplate bool
+}
+
+// CreateCommonMetadataPlugin creates a transformer plugin that adds labels and
+// annotations to resources and their pod templates. Keys a resource already
+// sets are kept, so operator-managed metadata cannot be overridden.
+func CreateCommonMetadataPlugin(config CommonMetadataConfig) (*commonMetadataTransformer, error) {
+	for key, value := range config.Labels {
+		errs := append(k8svalidation.IsQualifiedName(key), k8svalidation.IsValidLabelValue(value)...)
+		if len(errs) > 0 {
+			return nil, fmt.Errorf("failed to validate common label %q: %s", key, strings.Join(errs, ", "))
+		}
+	}
+	for key := range config.Annotations {
+		if errs := k8svalidation.IsQualifiedName(strings.ToLower(key)); len(errs) > 0 {
+			return nil, fmt.Errorf("failed to validate common annotation %q: %s", key, strings.Join(errs, ", "))
+		}
+	}
+	return &commonMetadataTransformer{config: config}, nil
+}
+
+type commonMetadataTransformer struct {
+	config CommonMetadataConfig
+}
+
+// Transform implements the TransformerPlugin interface.
+func (t *commonMetadataTransformer) Transform(m resmap.ResMap) error {
+	if len(t.config.Labels) == 0 && len(t.config.Annotations) == 0 {
+		return nil
+	}
+
+	for _, res := range m.Resources() {
+		if len(t.config.Kinds) > 0 && !slices.Contains(t.config.Kinds, res.GetKind()) {
+			continue
+		}
+		if len(t.config.Labels) > 0 {
+			if err := res.SetLabels(mergeMissing(res.GetLabels(), t.config.Labels)); err != nil {
+				return fmt.Errorf("failed to set labels for resource %s/%s: %w", res.GetKind(), res.GetName(), err)
+			}
+		}
+		if len(t.config.Annotations) > 0 {
+			if err := res.SetAnnotations(mergeMissing(res.GetAnnotations(), t.config.Annotations)); err != nil {
+				return fmt.Errorf("failed to set annotations for resource %s/%s: %w", res.GetKind(), res.GetName(), err)
+			}
+		}
+		if t.config.SkipPodTemplate {
+			continue
+		}
+		if err := t.setPodTemplateMetadata(res); err != nil {
+			return fmt.Errorf("failed to set pod template metadata for resource %s/%s: %w", res.GetKind(), res.GetName(), err)
+		}
+	}
+	return nil
+}
+
+// Config implements the TransformerPlugin interface.
+// This method is empty because the plugin's configuration is provided directly via `CreateCommonMetadataPlugin`.
+func (t *commonMetadataTransformer) Config(h *resmap.PluginHelpers, _ []byte) error {
+	return nil
+}
+
+// setPodTemplateMetadata adds the common metadata to the pod template of
+// workload resources. Resources without a pod template are left unchanged.
+func (t *commonMetadataTransformer) setPodTemplateMetadata(res *resource.Resource) error {
+	data, err := res.Map()
+	if err != nil {
+		return fmt.Errorf("failed to get resource map: %w", err)
+	}
+	if _, found, _ := unstructured.NestedMap(data, "spec", "template"); !found {
+		return nil
+	}
+
+	for field, values := range map[string]map[string]string{
+		"labels":      t.config.Labels,
+		"annotations": t.config.Annotations,
+	} {
+		if len(values) == 0 {
+			continue
+		}
+		existing, _, err := unstructured.NestedStringMap(data, "spec", "template", "metadata", field)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", field, err)
+		}
+		if err := unstructured.SetNestedStringMap(data, mergeMissing(existing, values), "spec", "template", "metadata", field); err != nil {
+			return fmt.Errorf("failed to set %s: %w", field, err)
+		}
+	}
+
+	return updateResource(res, data)
+}
+
+// mergeMissing returns existing with the entries of additions whose keys it does not set.
+func mergeMissing(existing, additions map[string]string) map[string]string {
+	merged := make(map[string]string, len(existing)+len(additions))
+	for key, value := range additions {
+		merged[key] = value
+	}
+	for key, value := range existing {
+		merged[key] = value
+	}
+	return merged
+}
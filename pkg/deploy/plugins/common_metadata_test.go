@@ -0,0 +1,125 @@
+package plugins
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/kustomize/api/resmap"
+)
+
+func TestCommonMetadataPlugin(t *testing.T) {
+	t.Run("adds metadata to resources and pod templates without overriding", func(t *testing.T) {
+		resMap := resmap.New()
+		dep := newTestResource(t, "apps/v1", "Deployment", "my-app", "", nil)
+		require.NoError(t, dep.SetLabels(map[string]string{"app.kubernetes.io/managed-by": "ogx-operator"}))
+		svc := newTestResource(t, "v1", "Service", "my-service", "", nil)
+		require.NoError(t, resMap.Append(dep))
+		require.NoError(t, resMap.Append(svc))
+
+		plugin, err := CreateCommonMetadataPlugin(CommonMetadataConfig{
+			Labels: map[string]string{
+				"cost-center":                  "42",
+				"app.kubernetes.io/managed-by": "someone-else",
+				"app":                          "other",
+			},
+			Annotations: map[string]string{"prometheus.io/scrape": "true"},
+		})
+		require.NoError(t, err)
+		require.NoError(t, plugin.Transform(resMap))
+
+		for _, res := range resMap.Resources() {
+			assert.Equal(t, "42", res.GetLabels()["cost-center"], "%s should carry the common label", res.GetKind())
+			assert.Equal(t, "true", res.GetAnnotations()["prometheus.io/scrape"], "%s should carry the common annotation", res.GetKind())
+		}
+
+		transformed, err := resMap.GetById(dep.CurId())
+		require.NoError(t, err)
+		assert.Equal(t, "ogx-operator", transformed.GetLabels()["app.kubernetes.io/managed-by"],
+			"operator-managed labels must not be overridden")
+
+		data, err := transformed.Map()
+		require.NoError(t, err)
+		podLabels, _, err := unstructured.NestedStringMap(data, "spec", "template", "metadata", "labels")
+		require.NoError(t, err)
+		assert.Equal(t, "my-app", podLabels["app"], "selector labels must not be overridden")
+		assert.Equal(t, "42", podLabels["cost-center"])
+		podAnnotations, _, err := unstructured.NestedStringMap(data, "spec", "template", "metadata", "annotations")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"prometheus.io/scrape": "true"}, podAnnotations)
+	})
+
+	t.Run("leaves resources unchanged without metadata", func(t *testing.T) {
+		resMap := resmap.New()
+		svc := newTestResource(t, "v1", "Service", "my-service", "", nil)
+		require.NoError(t, resMap.Append(svc))
+		before, err := svc.AsYAML()
+		require.NoError(t, err)
+
+		plugin, err := CreateCommonMetadataPlugin(CommonMetadataConfig{})
+		require.NoError(t, err)
+		require.NoError(t, plugin.Transform(resMap))
+
+		after, err := resMap.Resources()[0].AsYAML()
+		require.NoError(t, err)
+		assert.Equal(t, string(before), string(after))
+	})
+
+	t.Run("only changes resources of the configured kinds", func(t *testing.T) {
+		resMap := resmap.New()
+		pvc := newTestResource(t, "v1", "PersistentVolumeClaim", "my-pvc", "", nil)
+		svc := newTestResource(t, "v1", "Service", "my-service", "", nil)
+		require.NoError(t, resMap.Append(pvc))
+		require.NoError(t, resMap.Append(svc))
+
+		plugin, err := CreateCommonMetadataPlugin(CommonMetadataConfig{
+			Labels:      map[string]string{"backup": "daily"},
+			Annotations: map[string]string{"backup.example.com/policy": "keep-7"},
+			Kinds:       []string{"PersistentVolumeClaim"},
+		})
+		require.NoError(t, err)
+		require.NoError(t, plugin.Transform(resMap))
+
+		transformedPVC, err := resMap.GetById(pvc.CurId())
+		require.NoError(t, err)
+		assert.Equal(t, "daily", transformedPVC.GetLabels()["backup"])
+		assert.Equal(t, "keep-7", transformedPVC.GetAnnotations()["backup.example.com/policy"])
+
+		transformedSvc, err := resMap.GetById(svc.CurId())
+		require.NoError(t, err)
+		assert.NotContains(t, transformedSvc.GetLabels(), "backup")
+		assert.NotContains(t, transformedSvc.GetAnnotations(), "backup.example.com/policy")
+	})
+
+	t.Run("leaves pod templates unchanged when asked to", func(t *testing.T) {
+		resMap := resmap.New()
+		dep := newTestResource(t, "apps/v1", "Deployment", "my-app", "", nil)
+		require.NoError(t, resMap.Append(dep))
+
+		plugin, err := CreateCommonMetadataPlugin(CommonMetadataConfig{
+			Annotations:     map[string]string{"argocd.argoproj.io/sync-wave": "2"},
+			Kinds:           []string{"Deployment"},
+			SkipPodTemplate: true,
+		})
+		require.NoError(t, err)
+		require.NoError(t, plugin.Transform(resMap))
+
+		transformed, err := resMap.GetById(dep.CurId())
+		require.NoError(t, err)
+		assert.Equal(t, "2", transformed.GetAnnotations()["argocd.argoproj.io/sync-wave"])
+		data, err := transformed.Map()
+		require.NoError(t, err)
+		_, found, err := unstructured.NestedStringMap(data, "spec", "template", "metadata", "annotations")
+		require.NoError(t, err)
+		assert.False(t, found, "pod template annotations must not be set")
+	})
+
+	t.Run("rejects invalid labels and annotations", func(t *testing.T) {
+		_, err := CreateCommonMetadataPlugin(CommonMetadataConfig{Labels: map[string]string{"team": "not a valid value"}})
+		require.ErrorContains(t, err, `common label "team"`)
+
+		_, err = CreateCommonMetadataPlugin(CommonMetadataConfig{Annotations: map[string]string{"bad key!": "x"}})
+		require.ErrorContains(t, err, `common annotation "bad key!"`)
+	})
+}
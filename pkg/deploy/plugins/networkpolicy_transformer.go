@@ -22,6 +22,7 @@ import (
 	"fmt"
 
 	ogxiov1beta1 "github.com/ogx-ai/ogx-k8s-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	"sigs.k8s.io/kustomize/api/resmap"
 	"sigs.k8s.io/kustomize/api/resource"
@@ -35,6 +36,10 @@ const (
 	// Allow traffic from OpenShift router namespaces.
 	openShiftIngressPolicyGroupLabelKey   = "network.openshift.io/policy-group"
 	openShiftIngressPolicyGroupLabelValue = "ingress"
+	// Allow DNS lookups to the cluster DNS pods once egress is enforced.
+	kubeDNSLabelKey   = "k8s-app"
+	kubeDNSLabelValue = "kube-dns"
+	dnsPort           = 53
 )
 
 // NetworkPolicyTransformerConfig holds the configuration for the NetworkPolicy transformer.
@@ -100,33 +105,81 @@ func (t *networkPolicyTransformer) transformNetworkPolicy(res *resource.Resource
 	return updateResource(res, data)
 }
 
-// applyNetworkPolicySpec sets ingress/egress from the CR when explicitly provided; otherwise uses defaults.
+// applyNetworkPolicySpec sets the default ingress rule followed by the ingress
+// rules from the CR, and the policy types and egress rules from the CR. When
+// egress is enforced, a kube-dns egress rule precedes the egress rules from
+// the CR so that name resolution keeps working.
 func (t *networkPolicyTransformer) applyNetworkPolicySpec(spec map[string]any) error {
-	np := t.config.NetworkSpec
-	if np != nil && np.Policy != nil && len(np.Policy.Ingress) > 0 {
-		ingress, err := networkPolicyRulesToAnySlice(np.Policy.Ingress)
-		if err != nil {
-			return fmt.Errorf("failed to convert NetworkPolicy ingress rules: %w", err)
-		}
+	ingress := t.buildIngressRules()
+
+	var policy *ogxiov1beta1.NetworkPolicySpec
+	if t.config.NetworkSpec != nil {
+		policy = t.config.NetworkSpec.Policy
+	}
+	if policy == nil {
 		spec["ingress"] = ingress
-		policyTypes := []any{"Ingress"}
-		if len(np.Policy.Egress) > 0 {
-			egress, err := networkPolicyEgressRulesToAnySlice(np.Policy.Egress)
-			if err != nil {
-				return fmt.Errorf("failed to convert NetworkPolicy egress rules: %w", err)
-			}
-			spec["egress"] = egress
-			policyTypes = append(policyTypes, "Egress")
-		}
-		spec["policyTypes"] = policyTypes
 		return nil
 	}
 
-	ingressRules := t.buildIngressRules()
-	spec["ingress"] = ingressRules
+	additionalIngress, err := networkPolicyRulesToAnySlice(policy.Ingress)
+	if err != nil {
+		return fmt.Errorf("failed to convert NetworkPolicy ingress rules: %w", err)
+	}
+	spec["ingress"] = append(ingress, additionalIngress...)
+
+	policyTypes := effectivePolicyTypes(policy)
+	enforcesEgress := false
+	types := make([]any, 0, len(policyTypes))
+	for _, policyType := range policyTypes {
+		types = append(types, string(policyType))
+		enforcesEgress = enforcesEgress || policyType == networkingv1.PolicyTypeEgress
+	}
+	spec["policyTypes"] = types
+
+	if enforcesEgress {
+		egress, err := networkPolicyEgressRulesToAnySlice(policy.Egress)
+		if err != nil {
+			return fmt.Errorf("failed to convert NetworkPolicy egress rules: %w", err)
+		}
+		spec["egress"] = append([]any{buildDNSEgressRule()}, egress...)
+	}
 	return nil
 }
 
+// effectivePolicyTypes returns the policy types from the CR, or Ingress plus
+// Egress when egress rules are provided, following Kubernetes semantics.
+func effectivePolicyTypes(policy *ogxiov1beta1.NetworkPolicySpec) []networkingv1.PolicyType {
+	if len(policy.PolicyTypes) > 0 {
+		return policy.PolicyTypes
+	}
+	policyTypes := []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}
+	if len(policy.Egress) > 0 {
+		policyTypes = append(policyTypes, networkingv1.PolicyTypeEgress)
+	}
+	return policyTypes
+}
+
+// buildDNSEgressRule builds the egress rule allowing DNS lookups to the
+// kube-dns pods in any namespace.
+func buildDNSEgressRule() map[string]any {
+	return map[string]any{
+		"to": []any{
+			map[string]any{
+				"namespaceSelector": map[string]any{},
+				"podSelector": map[string]any{
+					"matchLabels": map[string]any{
+						kubeDNSLabelKey: kubeDNSLabelValue,
+					},
+				},
+			},
+		},
+		"ports": []any{
+			map[string]any{"protocol": "UDP", "port": dnsPort},
+			map[string]any{"protocol": "TCP", "port": dnsPort},
+		},
+	}
+}
+
 func networkPolicyRulesToAnySlice(rules []networkingv1.NetworkPolicyIngressRule) ([]any, error) {
 	b, err := json.Marshal(rules)
 	if err != nil {
@@ -179,6 +232,18 @@ func (t *networkPolicyTransformer) buildIngressRules() []any {
 			"port":     t.config.ServicePort,
 		},
 	}
+	if t.config.NetworkSpec != nil {
+		for _, port := range t.config.NetworkSpec.AdditionalPorts {
+			protocol := port.Protocol
+			if protocol == "" {
+				protocol = corev1.ProtocolTCP
+			}
+			portRule = append(portRule, map[string]any{
+				"protocol": string(protocol),
+				"port":     port.ContainerPort,
+			})
+		}
+	}
 
 	return []any{
 		map[string]any{
@@ -191,6 +256,7 @@ func (t *networkPolicyTransformer) buildIngressRules() []any {
 func (t *networkPolicyTransformer) buildPeers() []any {
 	peers := t.buildDefaultPeers()
 	peers = append(peers, t.buildRouterPeers()...)
+	peers = append(peers, t.buildAllowedNamespacePeers()...)
 	return peers
 }
 
@@ -232,6 +298,30 @@ func (t *networkPolicyTransformer) buildRouterPeers() []any {
 	}
 }
 
+// buildAllowedNamespacePeers builds NetworkPolicy peers for the namespaces in
+// spec.network.policy.allowNamespaces. AllNamespacesSelector matches every namespace.
+func (t *networkPolicyTransformer) buildAllowedNamespacePeers() []any {
+	if t.config.NetworkSpec == nil || t.config.NetworkSpec.Policy == nil {
+		return nil
+	}
+
+	var peers []any
+	for _, namespace := range t.config.NetworkSpec.Policy.AllowNamespaces {
+		if namespace == AllNamespacesSelector {
+			peers = append(peers, map[string]any{"namespaceSelector": map[string]any{}})
+			continue
+		}
+		peers = append(peers, map[string]any{
+			"namespaceSelector": map[string]any{
+				"matchLabels": map[string]any{
+					"kubernetes.io/metadata.name": namespace,
+				},
+			},
+		})
+	}
+	return peers
+}
+
 // Config implements the resmap.TransformerPlugin interface.
 func (t *networkPolicyTransformer) Config(_ *resmap.PluginHelpers, _ []byte) error {
 	return nil
@@ -25,6 +25,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/kustomize/api/resmap"
 	"sigs.k8s.io/kustomize/api/resource"
@@ -80,7 +81,8 @@ func TestNetworkPolicyTransformer_Default(t *testing.T) {
 	assert.Contains(t, yamlStr, "port: 8321")
 }
 
-// TestNetworkPolicyTransformer_ExplicitIngressFromCR uses v1beta1 NetworkPolicySpec.Ingress verbatim.
+// TestNetworkPolicyTransformer_ExplicitIngressFromCR appends v1beta1 NetworkPolicySpec.Ingress
+// to the default ingress rule.
 func TestNetworkPolicyTransformer_ExplicitIngressFromCR(t *testing.T) {
 	rf := resource.NewFactory(nil)
 	res, err := rf.FromBytes([]byte(networkPolicyTestYAML))
@@ -93,7 +95,9 @@ func TestNetworkPolicyTransformer_ExplicitIngressFromCR(t *testing.T) {
 	ingress := []networkingv1.NetworkPolicyIngressRule{
 		{
 			From: []networkingv1.NetworkPolicyPeer{
-				{NamespaceSelector: &metav1.LabelSelector{}},
+				{NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"kubernetes.io/metadata.name": "gateway-ns"},
+				}},
 			},
 			Ports: []networkingv1.NetworkPolicyPort{
 				{
@@ -118,12 +122,134 @@ func TestNetworkPolicyTransformer_ExplicitIngressFromCR(t *testing.T) {
 	err = transformer.Transform(rm)
 	require.NoError(t, err)
 
-	transformedRes := rm.Resources()[0]
-	yamlBytes, err := transformedRes.AsYAML()
+	data, err := rm.Resources()[0].Map()
 	require.NoError(t, err)
-	yamlStr := string(yamlBytes)
+	var policy networkingv1.NetworkPolicy
+	require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(data, &policy))
+
+	require.Len(t, policy.Spec.Ingress, 2, "the CR rule should be appended to the default rule")
+	assert.Contains(t, policy.Spec.Ingress[0].From, networkingv1.NetworkPolicyPeer{
+		NamespaceSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"kubernetes.io/metadata.name": "operator-ns"},
+		},
+	}, "the default operator-namespace rule should be preserved")
+	assert.Equal(t, ingress[0], policy.Spec.Ingress[1])
+	assert.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}, policy.Spec.PolicyTypes)
+}
+
+// TestNetworkPolicyTransformer_AllowNamespaces adds the allowed namespaces to
+// the default ingress rule, next to the operator namespace.
+func TestNetworkPolicyTransformer_AllowNamespaces(t *testing.T) {
+	rf := resource.NewFactory(nil)
+	res, err := rf.FromBytes([]byte(networkPolicyTestYAML))
+	require.NoError(t, err)
+
+	rm := resmap.New()
+	require.NoError(t, rm.Append(res))
+
+	transformer := CreateNetworkPolicyTransformer(NetworkPolicyTransformerConfig{
+		InstanceName:      "test-instance",
+		ServicePort:       8321,
+		OperatorNamespace: "operator-ns",
+		NetworkSpec: &ogxiov1beta1.NetworkSpec{
+			Policy: &ogxiov1beta1.NetworkPolicySpec{
+				AllowNamespaces: []string{"monitoring", AllNamespacesSelector},
+			},
+		},
+	})
+
+	err = transformer.Transform(rm)
+	require.NoError(t, err)
+
+	data, err := rm.Resources()[0].Map()
+	require.NoError(t, err)
+	var policy networkingv1.NetworkPolicy
+	require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(data, &policy))
+
+	require.Len(t, policy.Spec.Ingress, 1, "allowed namespaces should join the default rule")
+	assert.Equal(t, []networkingv1.NetworkPolicyPeer{
+		{PodSelector: &metav1.LabelSelector{}},
+		{NamespaceSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"kubernetes.io/metadata.name": "operator-ns"},
+		}},
+		{NamespaceSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"network.openshift.io/policy-group": "ingress"},
+		}},
+		{NamespaceSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"kubernetes.io/metadata.name": "monitoring"},
+		}},
+		{NamespaceSelector: &metav1.LabelSelector{}},
+	}, policy.Spec.Ingress[0].From)
+	require.Len(t, policy.Spec.Ingress[0].Ports, 1)
+	assert.Equal(t, intstr.FromInt32(8321), *policy.Spec.Ingress[0].Ports[0].Port)
+}
+
+// TestNetworkPolicyTransformer_EgressWithoutIngress applies CR egress rules
+// even when no additional ingress rules are provided.
+func TestNetworkPolicyTransformer_EgressWithoutIngress(t *testing.T) {
+	rf := resource.NewFactory(nil)
+	res, err := rf.FromBytes([]byte(networkPolicyTestYAML))
+	require.NoError(t, err)
+
+	rm := resmap.New()
+	require.NoError(t, rm.Append(res))
+
+	egress := []networkingv1.NetworkPolicyEgressRule{
+		{To: []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{}}}},
+	}
+	transformer := CreateNetworkPolicyTransformer(NetworkPolicyTransformerConfig{
+		InstanceName:      "test-instance",
+		ServicePort:       8321,
+		OperatorNamespace: "operator-ns",
+		NetworkSpec: &ogxiov1beta1.NetworkSpec{
+			Policy: &ogxiov1beta1.NetworkPolicySpec{Egress: egress},
+		},
+	})
+	require.NoError(t, transformer.Transform(rm))
 
-	assert.Contains(t, yamlStr, "namespaceSelector: {}")
+	data, err := rm.Resources()[0].Map()
+	require.NoError(t, err)
+	var policy networkingv1.NetworkPolicy
+	require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(data, &policy))
+
+	assert.Len(t, policy.Spec.Ingress, 1, "only the default ingress rule should be present")
+	require.Len(t, policy.Spec.Egress, 2)
+	assert.Equal(t, kubeDNSLabelValue, policy.Spec.Egress[0].To[0].PodSelector.MatchLabels[kubeDNSLabelKey])
+	assert.Equal(t, egress, policy.Spec.Egress[1:])
+	assert.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress}, policy.Spec.PolicyTypes)
+}
+
+// TestNetworkPolicyTransformer_PolicyTypes honors the policy types from the CR
+// and injects the kube-dns egress rule when egress is enforced without rules.
+func TestNetworkPolicyTransformer_PolicyTypes(t *testing.T) {
+	rf := resource.NewFactory(nil)
+	res, err := rf.FromBytes([]byte(networkPolicyTestYAML))
+	require.NoError(t, err)
+
+	rm := resmap.New()
+	require.NoError(t, rm.Append(res))
+
+	transformer := CreateNetworkPolicyTransformer(NetworkPolicyTransformerConfig{
+		InstanceName:      "test-instance",
+		ServicePort:       8321,
+		OperatorNamespace: "operator-ns",
+		NetworkSpec: &ogxiov1beta1.NetworkSpec{
+			Policy: &ogxiov1beta1.NetworkPolicySpec{PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress}},
+		},
+	})
+	require.NoError(t, transformer.Transform(rm))
+
+	data, err := rm.Resources()[0].Map()
+	require.NoError(t, err)
+	var policy networkingv1.NetworkPolicy
+	require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(data, &policy))
+
+	assert.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeEgress}, policy.Spec.PolicyTypes)
+	require.Len(t, policy.Spec.Egress, 1, "only the kube-dns rule should be present")
+	require.Len(t, policy.Spec.Egress[0].Ports, 2)
+	assert.Equal(t, corev1.ProtocolUDP, *policy.Spec.Egress[0].Ports[0].Protocol)
+	assert.Equal(t, intstr.FromInt32(53), *policy.Spec.Egress[0].Ports[0].Port)
+	assert.Equal(t, corev1.ProtocolTCP, *policy.Spec.Egress[0].Ports[1].Protocol)
 }
 
 func TestNetworkPolicyTransformer_CustomPort(t *testing.T) {
@@ -154,6 +280,41 @@ func TestNetworkPolicyTransformer_CustomPort(t *testing.T) {
 	assert.Contains(t, yamlStr, "port: 9000")
 }
 
+func TestNetworkPolicyTransformer_AdditionalPorts(t *testing.T) {
+	rf := resource.NewFactory(nil)
+	res, err := rf.FromBytes([]byte(networkPolicyTestYAML))
+	require.NoError(t, err)
+
+	rm := resmap.New()
+	require.NoError(t, rm.Append(res))
+
+	transformer := CreateNetworkPolicyTransformer(NetworkPolicyTransformerConfig{
+		InstanceName:      "test-instance",
+		ServicePort:       ogxiov1beta1.DefaultServerPort,
+		OperatorNamespace: "operator-ns",
+		NetworkSpec: &ogxiov1beta1.NetworkSpec{
+			AdditionalPorts: []corev1.ContainerPort{
+				{Name: "metrics", ContainerPort: 9090},
+				{Name: "statsd", ContainerPort: 9125, Protocol: corev1.ProtocolUDP},
+			},
+		},
+	})
+	require.NoError(t, transformer.Transform(rm))
+
+	data, err := rm.Resources()[0].Map()
+	require.NoError(t, err)
+	var policy networkingv1.NetworkPolicy
+	require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(data, &policy))
+
+	require.Len(t, policy.Spec.Ingress, 1)
+	tcp, udp := corev1.ProtocolTCP, corev1.ProtocolUDP
+	assert.Equal(t, []networkingv1.NetworkPolicyPort{
+		{Protocol: &tcp, Port: &intstr.IntOrString{Type: intstr.Int, IntVal: ogxiov1beta1.DefaultServerPort}},
+		{Protocol: &tcp, Port: &intstr.IntOrString{Type: intstr.Int, IntVal: 9090}},
+		{Protocol: &udp, Port: &intstr.IntOrString{Type: intstr.Int, IntVal: 9125}},
+	}, policy.Spec.Ingress[0].Ports, "additional ports share the default ingress rule")
+}
+
 func TestNetworkPolicyTransformer_RouterPeersWhenNetworkSpecProvided(t *testing.T) {
 	rf := resource.NewFactory(nil)
 	res, err := rf.FromBytes([]byte(networkPolicyTestYAML))
@@ -16,6 +16,7 @@ func GetOperatorNamespace() (string, error) {
 	return string(data), err
 }
 
+// GetServicePort returns the server port, defaulting to DefaultServerPort.
 func GetServicePort(instance *ogxiov1beta1.OGXServer) int32 {
 	if instance.Spec.Network != nil && instance.Spec.Network.Port != 0 {
 		return instance.Spec.Network.Port
@@ -28,9 +29,19 @@ func GetServiceName(instance *ogxiov1beta1.OGXServer) string {
 }
 
 // GetEffectiveReplicas returns the desired replica count, defaulting to 1.
+// An explicit 0 is kept so the server can be scaled to zero.
 func GetEffectiveReplicas(instance *ogxiov1beta1.OGXServer) int32 {
 	if instance.Spec.Workload != nil && instance.Spec.Workload.Replicas != nil {
 		return *instance.Spec.Workload.Replicas
 	}
 	return 1
 }
+
+// NeedsPodDisruptionBudget reports whether a PodDisruptionBudget is managed
+// for instance: when one is configured or more than one replica runs.
+func NeedsPodDisruptionBudget(instance *ogxiov1beta1.OGXServer) bool {
+	if instance.Spec.Workload != nil && instance.Spec.Workload.PodDisruptionBudget != nil {
+		return true
+	}
+	return GetEffectiveReplicas(instance) > 1
+}